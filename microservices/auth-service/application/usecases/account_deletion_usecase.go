@@ -0,0 +1,171 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	accountErasureBatchSize = 10
+	// Permintaan hapus akun harus dari sesi yang baru saja login ulang
+	deleteAccountMaxAuthAge = 10 * time.Minute
+)
+
+// AccountDeletionUseCase menjadwalkan penghapusan akun atas permintaan user dan, lewat Run,
+// menghapus permanen akun yang masa tenggangnya sudah habis:
+//  1. semua sesi dan refresh token dicabut
+//  2. referensi user di audit log diganti pseudonym (rantai hash tetap utuh)
+//  3. baris users dianonimkan dan data pribadi di tabel lain dihapus
+//  4. kunci enkripsi user dimusnahkan sehingga data terenkripsi tidak bisa dibaca lagi
+//  5. event user.erased dikirim agar service lain menghapus data miliknya
+//
+// Langkah 1 (Redis) dan 2 (lewat redactionDB, transaksi sendiri) tidak ikut transaksi
+// langkah 3-5. Jika pseudonimisasi sudah commit lalu transaksi langkah 3-5 gagal, akun
+// dicoba ulang pada batch berikutnya: Pseudonymize idempoten karena referensi user yang
+// sudah diganti pseudonym tidak cocok lagi, dan langkah 3-5 belum pernah berlaku.
+type AccountDeletionUseCase struct {
+	authUC  *AuthUseCase
+	erasure repositories.ErasureRepository
+	audits  repositories.AuditRepository
+	keyring *UserKeyring
+	logger  *zap.Logger
+}
+
+func NewAccountDeletionUseCase(authUC *AuthUseCase, erasure repositories.ErasureRepository, audits repositories.AuditRepository, keyring *UserKeyring, logger *zap.Logger) *AccountDeletionUseCase {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &AccountDeletionUseCase{authUC: authUC, erasure: erasure, audits: audits, keyring: keyring, logger: logger}
+}
+
+// DeleteAccount menjadwalkan penghapusan setelah AccountDeletionGracePeriod. Permintaan ulang
+// selama masih terjadwal mengembalikan jadwal yang sama.
+func (uc *AccountDeletionUseCase) DeleteAccount(ctx context.Context, userID string, authTime time.Time) (time.Time, error) {
+	if authTime.IsZero() || time.Since(authTime) > deleteAccountMaxAuthAge {
+		return time.Time{}, entities.ErrStepUpRequired
+	}
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil || user.Status == entities.StatusDeleted {
+		return time.Time{}, entities.ErrUserNotFound
+	}
+	// Service account dihapus admin lewat ServiceAccountService
+	if user.IsServiceAccount() {
+		return time.Time{}, entities.ErrUnauthorized
+	}
+	if user.DeletionScheduledAt != nil {
+		return *user.DeletionScheduledAt, nil
+	}
+
+	scheduledAt := time.Now().UTC().Add(entities.AccountDeletionGracePeriod).Truncate(time.Second)
+	if err := uc.authUC.userRepo.SetDeletionSchedule(ctx, userID, &scheduledAt); err != nil {
+		return time.Time{}, err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditDeletionRequested, userID, map[string]string{
+		"scheduled_at": scheduledAt.Format(time.RFC3339),
+	})
+
+	if uc.authUC.notifier != nil {
+		if err := uc.authUC.notifier.Notify(ctx, &entities.Notification{
+			Kind:      entities.NotificationAccountDeletion,
+			UserID:    user.ID,
+			Recipient: user.Email,
+			Data:      map[string]string{"scheduled_at": scheduledAt.Format("2006-01-02 15:04 UTC")},
+		}); err != nil {
+			uc.logger.Warn("failed to queue account deletion email", zap.String("user_id", user.ID), zap.Error(err))
+		}
+	}
+	return scheduledAt, nil
+}
+
+// CancelAccountDeletion membatalkan jadwal selama akun belum dihapus
+func (uc *AccountDeletionUseCase) CancelAccountDeletion(ctx context.Context, userID string) error {
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil || user.Status == entities.StatusDeleted {
+		return entities.ErrUserNotFound
+	}
+	if user.DeletionScheduledAt == nil {
+		return entities.ErrDeletionNotScheduled
+	}
+	if err := uc.authUC.userRepo.SetDeletionSchedule(ctx, userID, nil); err != nil {
+		return err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditDeletionCancelled, userID, nil)
+	return nil
+}
+
+// Run memanggil ProcessDue lewat runPeriodically sampai ctx dibatalkan
+func (uc *AccountDeletionUseCase) Run(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, uc.logger, "account erasure failed", func(ctx context.Context) (bool, error) {
+		n, err := uc.ProcessDue(ctx)
+		return n == accountErasureBatchSize, err
+	})
+}
+
+// ProcessDue menghapus akun yang jadwalnya sudah lewat, mengembalikan jumlah akun yang dihapus.
+// Akun yang gagal tetap terjadwal dan dicoba lagi pada putaran berikutnya.
+func (uc *AccountDeletionUseCase) ProcessDue(ctx context.Context) (int, error) {
+	ids, err := uc.erasure.ListDue(ctx, time.Now().UTC(), accountErasureBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	erased := 0
+	for _, id := range ids {
+		if err := uc.erase(ctx, id); err != nil {
+			uc.logger.Error("failed to erase account", zap.String("user_id", id), zap.Error(err))
+			continue
+		}
+		erased++
+	}
+	return erased, nil
+}
+
+func (uc *AccountDeletionUseCase) erase(ctx context.Context, userID string) error {
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return entities.ErrUserNotFound
+	}
+
+	// Redis di luar transaksi: dicabut lebih dulu agar tidak ada sesi yang masih hidup
+	// untuk akun yang sudah dianonimkan
+	if err := uc.authUC.revokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	// Audit log dipseudonimkan lewat koneksi terpisah (role audit_redactor), sebelum baris
+	// user dihapus agar percobaan ulang setelah kegagalan masih menemukan event-nya
+	pseudonym := uc.authUC.jwtAuth.Pseudonym(userID)
+	redacted, err := uc.audits.Pseudonymize(ctx, userID, user.Email, pseudonym)
+	if err != nil {
+		return err
+	}
+
+	erasedAt := time.Now().UTC()
+	err = uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		if err := uc.erasure.EraseUser(ctx, userID, user.Email, erasedAt); err != nil {
+			return err
+		}
+		return uc.keyring.Destroy(ctx, userID)
+	}, newDomainEvent(entities.EventUserErased, userID, entities.UserErasedPayload{
+		UserID:    userID,
+		Pseudonym: pseudonym,
+		ErasedAt:  erasedAt,
+	}))
+	if err != nil {
+		return err
+	}
+
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    entities.AuditAccountErased,
+		SubjectID: pseudonym,
+		Metadata:  map[string]string{"redacted_events": strconv.Itoa(redacted)},
+	})
+	return nil
+}
@@ -0,0 +1,266 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// memoryUserKeyRepository menyimpan kunci enkripsi user di memori. Seperti Postgres, kunci
+// tidak dibuat ulang untuk user yang kuncinya sudah dimusnahkan (akun dihapus).
+type memoryUserKeyRepository struct {
+	keys      map[string][]byte
+	destroyed map[string]bool
+}
+
+func newMemoryUserKeyRepository() *memoryUserKeyRepository {
+	return &memoryUserKeyRepository{keys: make(map[string][]byte), destroyed: make(map[string]bool)}
+}
+
+func (r *memoryUserKeyRepository) FindKey(ctx context.Context, userID string) ([]byte, error) {
+	return r.keys[userID], nil
+}
+
+func (r *memoryUserKeyRepository) EnsureKey(ctx context.Context, userID string) ([]byte, error) {
+	if r.destroyed[userID] {
+		return nil, entities.ErrUserKeyDestroyed
+	}
+	if key, ok := r.keys[userID]; ok {
+		return key, nil
+	}
+	key, err := auth.GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+	r.keys[userID] = key
+	return key, nil
+}
+
+func (r *memoryUserKeyRepository) DestroyKey(ctx context.Context, userID string) error {
+	delete(r.keys, userID)
+	r.destroyed[userID] = true
+	return nil
+}
+
+// memoryErasureRepository menganonimkan user yang disimpan di memori
+type memoryErasureRepository struct {
+	users map[string]*entities.User
+}
+
+func (r *memoryErasureRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	var ids []string
+	for id, u := range r.users {
+		if u.DeletionScheduledAt != nil && !u.DeletionScheduledAt.After(now) && len(ids) < limit {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *memoryErasureRepository) EraseUser(ctx context.Context, userID, email string, erasedAt time.Time) error {
+	u, ok := r.users[userID]
+	if !ok {
+		return entities.ErrUserNotFound
+	}
+	*u = entities.User{
+		ID:              u.ID,
+		Role:            u.Role,
+		CreatedAt:       u.CreatedAt,
+		Status:          entities.StatusDeleted,
+		StatusReason:    "erased",
+		StatusChangedAt: &erasedAt,
+	}
+	return nil
+}
+
+type accountDeletionFixture struct {
+	uc         *usecases.AccountDeletionUseCase
+	userRepo   *MockUserRepository
+	tokenRepo  *MockTokenRepository
+	outboxRepo *MockOutboxRepository
+	notifier   *MockNotifier
+	auditRepo  *memoryAuditRepository
+	auditUC    *usecases.AuditUseCase
+	keys       *memoryUserKeyRepository
+	keyring    *usecases.UserKeyring
+	user       *entities.User
+}
+
+func newAccountDeletionFixture(t *testing.T) *accountDeletionFixture {
+	f := &accountDeletionFixture{
+		userRepo:   new(MockUserRepository),
+		tokenRepo:  new(MockTokenRepository),
+		outboxRepo: new(MockOutboxRepository),
+		notifier:   new(MockNotifier),
+		auditRepo:  &memoryAuditRepository{},
+		keys:       newMemoryUserKeyRepository(),
+		user: &entities.User{
+			ID: "user-123", Email: "User@Example.com", PasswordHash: "hash", Role: entities.ClientRole,
+			Status: entities.StatusActive, Phone: "+6281234567890",
+		},
+	}
+	f.auditUC = usecases.NewAuditUseCase(f.auditRepo)
+	f.keyring = usecases.NewUserKeyring(f.keys)
+	authUC := usecases.NewAuthUseCase(f.userRepo, f.tokenRepo, "test-secret", nil,
+		usecases.WithAuditLogger(f.auditUC),
+		usecases.WithNotifier(f.notifier),
+		usecases.WithOutbox(usecases.NewOutbox(&fakeTransactor{}, f.outboxRepo)),
+	)
+	erasure := &memoryErasureRepository{users: map[string]*entities.User{f.user.ID: f.user}}
+	f.uc = usecases.NewAccountDeletionUseCase(authUC, erasure, f.auditRepo, f.keyring, nil)
+
+	f.userRepo.On("FindByID", mock.Anything, f.user.ID).Return(f.user, nil)
+	f.userRepo.On("SetDeletionSchedule", mock.Anything, f.user.ID, mock.Anything).Run(func(args mock.Arguments) {
+		f.user.DeletionScheduledAt = args.Get(2).(*time.Time)
+	}).Return(nil)
+	return f
+}
+
+func TestAccountDeletionUseCase_ScheduleAndCancel(t *testing.T) {
+	f := newAccountDeletionFixture(t)
+	ctx := context.Background()
+	f.notifier.On("Notify", mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.Kind == entities.NotificationAccountDeletion && n.Recipient == f.user.Email && n.Data["scheduled_at"] != ""
+	})).Return(nil).Once()
+
+	// 1. Tanpa login ulang baru-baru ini ditolak
+	_, err := f.uc.DeleteAccount(ctx, f.user.ID, time.Now().Add(-time.Hour))
+	assert.Equal(t, entities.ErrStepUpRequired, err)
+	assert.Nil(t, f.user.DeletionScheduledAt)
+
+	// 2. Dijadwalkan setelah masa tenggang, permintaan ulang mengembalikan jadwal yang sama
+	scheduledAt, err := f.uc.DeleteAccount(ctx, f.user.ID, time.Now())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(entities.AccountDeletionGracePeriod), scheduledAt, time.Minute)
+	again, err := f.uc.DeleteAccount(ctx, f.user.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, scheduledAt, again)
+	f.notifier.AssertExpectations(t)
+
+	// 3. Belum jatuh tempo: tidak ada yang dihapus
+	n, err := f.uc.ProcessDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	// 4. Dibatalkan, pembatalan kedua ditolak
+	require.NoError(t, f.uc.CancelAccountDeletion(ctx, f.user.ID))
+	assert.Nil(t, f.user.DeletionScheduledAt)
+	assert.Equal(t, entities.ErrDeletionNotScheduled, f.uc.CancelAccountDeletion(ctx, f.user.ID))
+
+	actions := make([]entities.AuditAction, len(f.auditRepo.events))
+	for i, e := range f.auditRepo.events {
+		actions[i] = e.Action
+	}
+	assert.Equal(t, []entities.AuditAction{entities.AuditDeletionRequested, entities.AuditDeletionCancelled}, actions)
+}
+
+func TestAccountDeletionUseCase_ServiceAccountRejected(t *testing.T) {
+	f := newAccountDeletionFixture(t)
+	f.user.Role = entities.ServiceRole
+
+	_, err := f.uc.DeleteAccount(context.Background(), f.user.ID, time.Now())
+	assert.Equal(t, entities.ErrUnauthorized, err)
+	f.userRepo.AssertNotCalled(t, "SetDeletionSchedule", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAccountDeletionUseCase_ProcessDue_ErasesAccount(t *testing.T) {
+	f := newAccountDeletionFixture(t)
+	f.tokenRepo.On("RevokeAllUserTokens", mock.Anything, f.user.ID).Return(nil)
+	f.outboxRepo.On("Enqueue", mock.Anything, mock.Anything).Return(nil)
+
+	ctx := entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{IP: "203.0.113.7", UserAgent: "app/1.0"})
+	sealed, err := f.keyring.Seal(ctx, f.user.ID, []byte("catatan pribadi"))
+	require.NoError(t, err)
+
+	// Riwayat audit: aksi user sendiri, aksi admin terhadap user, login gagal dengan email,
+	// dan event milik user lain yang tidak boleh berubah
+	adminCtx := entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{IP: "198.51.100.1", ActorID: "admin-1"})
+	require.NoError(t, f.auditUC.Record(ctx, &entities.AuditEvent{Action: entities.AuditLoginSucceeded, ActorID: f.user.ID, SubjectID: f.user.ID}))
+	require.NoError(t, f.auditUC.Record(adminCtx, &entities.AuditEvent{Action: entities.AuditForceLogout, SubjectID: f.user.ID}))
+	require.NoError(t, f.auditUC.Record(ctx, &entities.AuditEvent{Action: entities.AuditLoginFailed, Metadata: map[string]string{"email": "user@example.com", "reason": "unknown_user"}}))
+	require.NoError(t, f.auditUC.Record(ctx, &entities.AuditEvent{Action: entities.AuditLogout, ActorID: "user-456", SubjectID: "user-456"}))
+
+	past := time.Now().Add(-time.Minute)
+	f.user.DeletionScheduledAt = &past
+
+	n, err := f.uc.ProcessDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// Sesi dicabut dan baris user dianonimkan
+	f.tokenRepo.AssertCalled(t, "RevokeAllUserTokens", mock.Anything, f.user.ID)
+	assert.Equal(t, entities.StatusDeleted, f.user.Status)
+	assert.Empty(t, f.user.Email)
+	assert.Empty(t, f.user.Phone)
+	assert.Empty(t, f.user.PasswordHash)
+	assert.Nil(t, f.user.DeletionScheduledAt)
+
+	// Kunci dimusnahkan: data terenkripsi tidak bisa dibuka lagi
+	_, err = f.keyring.Open(ctx, f.user.ID, sealed)
+	assert.Equal(t, entities.ErrUserKeyDestroyed, err)
+	// dan kunci baru tidak dibuat untuk akun yang sudah dihapus
+	_, err = f.keyring.Seal(ctx, f.user.ID, []byte("data baru"))
+	assert.Equal(t, entities.ErrUserKeyDestroyed, err)
+	assert.Nil(t, f.keys.keys[f.user.ID])
+
+	// Audit log: referensi user diganti pseudonym, data request admin tetap, rantai tetap valid
+	pseudonym := auth.NewJWTAuth("test-secret").Pseudonym(f.user.ID)
+	events := f.auditRepo.events
+	require.Len(t, events, 5)
+	assert.Equal(t, pseudonym, events[0].ActorID)
+	assert.Equal(t, pseudonym, events[0].SubjectID)
+	assert.Empty(t, events[0].IP)
+	assert.Equal(t, "admin-1", events[1].ActorID)
+	assert.Equal(t, pseudonym, events[1].SubjectID)
+	assert.Equal(t, "198.51.100.1", events[1].IP)
+	assert.Equal(t, pseudonym, events[2].Metadata["email"])
+	assert.False(t, events[3].Redacted)
+	assert.Equal(t, "user-456", events[3].ActorID)
+	for _, e := range events {
+		assert.NotEqual(t, f.user.ID, e.ActorID)
+		assert.NotEqual(t, f.user.ID, e.SubjectID)
+	}
+	assert.Equal(t, entities.AuditAccountErased, events[4].Action)
+	assert.Equal(t, pseudonym, events[4].SubjectID)
+
+	_, brokenSeq, err := f.auditUC.Verify(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, brokenSeq)
+	// Event redacted tetap diverifikasi penuh: field yang masih punya nonce tidak bisa diubah,
+	// dan field yang sudah dipseudonimkan hanya boleh berisi pseudonym atau kosong
+	ip := events[1].IP
+	events[1].IP = "192.0.2.1"
+	_, brokenSeq, err = f.auditUC.Verify(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, events[1].Seq, brokenSeq)
+	events[1].IP = ip
+	events[0].ActorID = "user-456"
+	_, brokenSeq, err = f.auditUC.Verify(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, events[0].Seq, brokenSeq)
+	events[0].ActorID = pseudonym
+
+	// Service lain menerima user.erased
+	f.outboxRepo.AssertNumberOfCalls(t, "Enqueue", 1)
+	enqueued := f.outboxRepo.Calls[0].Arguments.Get(1).([]*entities.DomainEvent)
+	require.Len(t, enqueued, 1)
+	assert.Equal(t, entities.EventUserErased, enqueued[0].Type)
+	var payload entities.UserErasedPayload
+	require.NoError(t, json.Unmarshal(enqueued[0].Payload, &payload))
+	assert.Equal(t, f.user.ID, payload.UserID)
+	assert.Equal(t, pseudonym, payload.Pseudonym)
+
+	// Tidak diproses ulang
+	n, err = f.uc.ProcessDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
@@ -0,0 +1,264 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 200
+	passwordResetTTL   = 24 * time.Hour
+)
+
+var errResetNotifierMissing = errors.New("password reset notifier not configured")
+
+// AdminUseCase berisi operasi administrasi user untuk staf support/admin
+type AdminUseCase struct {
+	userRepo  repositories.UserRepository
+	tokenRepo repositories.TokenRepository
+	resetRepo repositories.PasswordResetRepository
+	roleRepo  repositories.RoleRepository
+	publisher services.RevocationPublisher
+	outbox    *Outbox
+	audit     services.AuditLogger
+	notifier  services.Notifier
+	resetURL  string
+}
+
+// AdminOption untuk dependency opsional AdminUseCase
+type AdminOption func(*AdminUseCase)
+
+// WithAdminOutbox mengaktifkan domain event UserSuspended
+func WithAdminOutbox(outbox *Outbox) AdminOption {
+	return func(uc *AdminUseCase) { uc.outbox = outbox }
+}
+
+// WithAdminAuditLogger mencatat setiap aksi admin ke audit log, actor diambil dari context
+func WithAdminAuditLogger(audit services.AuditLogger) AdminOption {
+	return func(uc *AdminUseCase) { uc.audit = audit }
+}
+
+// WithAdminNotifier mengirim link reset password ke email user saat ForcePasswordReset
+// (wajib untuk ForcePasswordReset). resetURL adalah halaman aplikasi yang menerima token sebagai query "token".
+func WithAdminNotifier(notifier services.Notifier, resetURL string) AdminOption {
+	return func(uc *AdminUseCase) {
+		uc.notifier = notifier
+		uc.resetURL = resetURL
+	}
+}
+
+// publisher boleh nil jika tidak ada service yang memvalidasi JWT secara lokal
+func NewAdminUseCase(userRepo repositories.UserRepository, tokenRepo repositories.TokenRepository, resetRepo repositories.PasswordResetRepository, roleRepo repositories.RoleRepository, publisher services.RevocationPublisher, opts ...AdminOption) *AdminUseCase {
+	uc := &AdminUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		resetRepo: resetRepo,
+		roleRepo:  roleRepo,
+		publisher: publisher,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+func (uc *AdminUseCase) SearchUsers(ctx context.Context, filter entities.UserFilter) ([]*entities.User, int, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSearchLimit
+	}
+	if filter.Limit > maxSearchLimit {
+		filter.Limit = maxSearchLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	// Token prefix email hanya disimpan mulai panjang minimum
+	if filter.EmailPrefix != "" && len([]rune(auth.NormalizeIndexValue(filter.EmailPrefix))) < auth.PrefixIndexMinLength {
+		return nil, 0, entities.ErrEmailPrefixTooShort
+	}
+	return uc.userRepo.SearchUsers(ctx, filter)
+}
+
+func (uc *AdminUseCase) GetUser(ctx context.Context, userID string) (*entities.User, error) {
+	return uc.findUser(ctx, userID)
+}
+
+// SetUserStatus mengubah status akun, semua sesi dicabut jika status baru bukan active
+func (uc *AdminUseCase) SetUserStatus(ctx context.Context, userID string, status entities.UserStatus, reason string) error {
+	if !status.IsValid() {
+		return entities.ErrInvalidStatusTransition
+	}
+
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.Status.CanTransitionTo(status) {
+		return entities.ErrInvalidStatusTransition
+	}
+
+	change := entities.StatusChange{Status: status, Reason: reason, ChangedAt: time.Now().UTC()}
+	var events []*entities.DomainEvent
+	if status == entities.StatusSuspended {
+		events = append(events, newDomainEvent(entities.EventUserSuspended, userID, entities.UserSuspendedPayload{
+			UserID: userID,
+			Reason: reason,
+		}))
+	}
+	if err := uc.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.userRepo.SetStatus(ctx, userID, change)
+	}, events...); err != nil {
+		return err
+	}
+	uc.auditAction(ctx, entities.AuditUserStatusChanged, userID, map[string]string{
+		"from":   string(user.Status),
+		"to":     string(status),
+		"reason": reason,
+	})
+	if status != entities.StatusActive {
+		if err := uc.tokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+			return err
+		}
+		publishRevocation(ctx, uc.publisher, userRevocation(entities.RevocationUserSuspended, userID))
+	}
+	return nil
+}
+
+// DisableUser men-suspend akun dan mencabut semua sesi aktif
+func (uc *AdminUseCase) DisableUser(ctx context.Context, userID, reason string) error {
+	return uc.SetUserStatus(ctx, userID, entities.StatusSuspended, reason)
+}
+
+func (uc *AdminUseCase) EnableUser(ctx context.Context, userID string) error {
+	return uc.SetUserStatus(ctx, userID, entities.StatusActive, "")
+}
+
+// ForceLogout mencabut semua refresh token dan access token milik user
+func (uc *AdminUseCase) ForceLogout(ctx context.Context, userID string) error {
+	if _, err := uc.findUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := uc.revokeSessions(ctx, userID); err != nil {
+		return err
+	}
+	uc.auditAction(ctx, entities.AuditForceLogout, userID, nil)
+	return nil
+}
+
+// ForcePasswordReset mewajibkan user mengganti password. Token reset sekali pakai hanya dikirim
+// ke email pemilik akun lewat antrean notifikasi dan tidak pernah dikembalikan ke admin,
+// sehingga admin tidak bisa mengambil alih akun. Mengembalikan waktu kedaluwarsa token.
+func (uc *AdminUseCase) ForcePasswordReset(ctx context.Context, userID string) (time.Time, error) {
+	if uc.notifier == nil {
+		return time.Time{}, errResetNotifierMissing
+	}
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Service account tidak memakai password
+	if user.IsServiceAccount() || user.Email == "" {
+		return time.Time{}, entities.ErrInvalidRole
+	}
+
+	token, hash, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := uc.resetRepo.StoreResetToken(ctx, hash, userID, passwordResetTTL); err != nil {
+		return time.Time{}, err
+	}
+	// Antrean lebih dulu: jika gagal, user belum dipaksa reset tanpa pernah menerima link
+	if err := uc.notifyPasswordReset(ctx, user, token); err != nil {
+		return time.Time{}, err
+	}
+	if err := uc.userRepo.SetPasswordResetRequired(ctx, userID, true); err != nil {
+		return time.Time{}, err
+	}
+	if err := uc.revokeSessions(ctx, userID); err != nil {
+		return time.Time{}, err
+	}
+	uc.auditAction(ctx, entities.AuditForcePasswordReset, userID, nil)
+	return time.Now().UTC().Add(passwordResetTTL), nil
+}
+
+// notifyPasswordReset memakai bahasa default karena request berasal dari admin, bukan dari user
+func (uc *AdminUseCase) notifyPasswordReset(ctx context.Context, user *entities.User, token string) error {
+	return uc.notifier.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationPasswordReset,
+		UserID:    user.ID,
+		Recipient: user.Email,
+		Locale:    entities.DefaultLocale,
+		Data: map[string]string{
+			"link":             linkWithToken(uc.resetURL, token),
+			"expires_in_hours": strconv.Itoa(int(passwordResetTTL.Hours())),
+		},
+	})
+}
+
+// SetRole mengganti role utama user, service account dikelola lewat ServiceAccountService
+func (uc *AdminUseCase) SetRole(ctx context.Context, userID string, role entities.Role) error {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if role == entities.ServiceRole || user.IsServiceAccount() {
+		return entities.ErrInvalidRole
+	}
+
+	def, err := uc.roleRepo.FindRole(ctx, role)
+	if err != nil {
+		return err
+	}
+	if def == nil {
+		return entities.ErrRoleNotFound
+	}
+	if err := uc.userRepo.UpdateRole(ctx, userID, role); err != nil {
+		return err
+	}
+	uc.auditAction(ctx, entities.AuditRoleChanged, userID, map[string]string{
+		"from": string(user.Role),
+		"to":   string(role),
+	})
+
+	// Access token lama membawa role lama, paksa refresh
+	if err := uc.tokenRepo.InvalidateAccessTokens(ctx, userID); err != nil {
+		return err
+	}
+	publishRevocation(ctx, uc.publisher, userRevocation(entities.RevocationRoleChanged, userID))
+	return nil
+}
+
+func (uc *AdminUseCase) auditAction(ctx context.Context, action entities.AuditAction, subjectID string, metadata map[string]string) {
+	recordAudit(ctx, uc.audit, &entities.AuditEvent{
+		Action:    action,
+		SubjectID: subjectID,
+		Metadata:  metadata,
+	})
+}
+
+func (uc *AdminUseCase) revokeSessions(ctx context.Context, userID string) error {
+	if err := uc.tokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+		return err
+	}
+	publishRevocation(ctx, uc.publisher, userRevocation(entities.RevocationSessionsRevoked, userID))
+	return nil
+}
+
+func (uc *AdminUseCase) findUser(ctx context.Context, userID string) (*entities.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	return user, nil
+}
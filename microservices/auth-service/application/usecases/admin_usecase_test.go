@@ -0,0 +1,227 @@
+package usecases_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type MockPasswordResetRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordResetRepository) StoreResetToken(ctx context.Context, tokenHash, userID string, ttl time.Duration) error {
+	args := m.Called(ctx, tokenHash, userID, ttl)
+	return args.Error(0)
+}
+
+func (m *MockPasswordResetRepository) ConsumeResetToken(ctx context.Context, tokenHash string) (string, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.String(0), args.Error(1)
+}
+
+type MockRevocationPublisher struct {
+	mock.Mock
+}
+
+func (m *MockRevocationPublisher) PublishRevocation(ctx context.Context, event *entities.RevocationEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func TestAdminUseCase_SearchUsers_ClampsLimit(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, new(MockTokenRepository), new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	users := []*entities.User{{ID: "user-123", Email: "ana@example.com", Role: entities.ClientRole}}
+	mockUserRepo.On("SearchUsers", mock.Anything, entities.UserFilter{Email: "ana@example.com", Limit: 200}).Return(users, 1, nil)
+
+	result, total, err := uc.SearchUsers(context.Background(), entities.UserFilter{Email: "ana@example.com", Limit: 5000, Offset: -1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, users, result)
+}
+
+func TestAdminUseCase_SearchUsers_EmailPrefix(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, new(MockTokenRepository), new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	// Token prefix hanya tersedia mulai 3 karakter
+	_, _, err := uc.SearchUsers(context.Background(), entities.UserFilter{EmailPrefix: " An "})
+	assert.Equal(t, entities.ErrEmailPrefixTooShort, err)
+	mockUserRepo.AssertNotCalled(t, "SearchUsers", mock.Anything, mock.Anything)
+
+	mockUserRepo.On("SearchUsers", mock.Anything, entities.UserFilter{EmailPrefix: "Ana@", Limit: 50}).Return([]*entities.User{}, 0, nil)
+	_, _, err = uc.SearchUsers(context.Background(), entities.UserFilter{EmailPrefix: "Ana@"})
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestAdminUseCase_DisableUser_RevokesSessions(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockPublisher := new(MockRevocationPublisher)
+	uc := usecases.NewAdminUseCase(mockUserRepo, mockTokenRepo, new(MockPasswordResetRepository), new(MockRoleRepository), mockPublisher)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole, Status: entities.StatusActive}, nil)
+	mockUserRepo.On("SetStatus", mock.Anything, "user-123", mock.MatchedBy(func(c entities.StatusChange) bool {
+		return c.Status == entities.StatusSuspended && c.Reason == "fraud"
+	})).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, "user-123").Return(nil)
+	mockPublisher.On("PublishRevocation", mock.Anything, mock.MatchedBy(func(e *entities.RevocationEvent) bool {
+		return e.Type == entities.RevocationUserSuspended && e.UserID == "user-123" && e.IssuedBefore != nil && e.ID != ""
+	})).Return(nil)
+
+	err := uc.DisableUser(context.Background(), "user-123", "fraud")
+
+	assert.NoError(t, err)
+	mockTokenRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestAdminUseCase_DisableUser_NotFound(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, new(MockTokenRepository), new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "missing").Return(nil, nil)
+
+	err := uc.DisableUser(context.Background(), "missing", "fraud")
+
+	assert.Equal(t, entities.ErrUserNotFound, err)
+	mockUserRepo.AssertNotCalled(t, "SetStatus")
+}
+
+func TestAdminUseCase_ForcePasswordReset_ThenResetPassword(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockResetRepo := new(MockPasswordResetRepository)
+	mockNotifier := new(MockNotifier)
+	adminUC := usecases.NewAdminUseCase(mockUserRepo, mockTokenRepo, mockResetRepo, new(MockRoleRepository), nil,
+		usecases.WithAdminNotifier(mockNotifier, "https://app.example.com/reset"))
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithPasswordResetRepository(mockResetRepo))
+
+	var storedHash, token string
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Email: "user@example.com", Role: entities.ClientRole}, nil)
+	// Token hanya sampai ke email pemilik akun
+	mockNotifier.On("Notify", mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.Kind == entities.NotificationPasswordReset && n.Recipient == "user@example.com"
+	})).Run(func(args mock.Arguments) {
+		link, _ := url.Parse(args.Get(1).(*entities.Notification).Data["link"])
+		token = link.Query().Get("token")
+	}).Return(nil)
+	mockResetRepo.On("StoreResetToken", mock.Anything, mock.AnythingOfType("string"), "user-123", 24*time.Hour).
+		Run(func(args mock.Arguments) { storedHash = args.String(1) }).Return(nil)
+	mockUserRepo.On("SetPasswordResetRequired", mock.Anything, "user-123", true).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, "user-123").Return(nil)
+
+	expiresAt, err := adminUC.ForcePasswordReset(context.Background(), "user-123")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.True(t, expiresAt.After(time.Now()))
+	// Hanya hash yang disimpan
+	assert.NotEqual(t, token, storedHash)
+	assert.Equal(t, auth.HashOpaqueToken(token), storedHash)
+
+	mockResetRepo.On("ConsumeResetToken", mock.Anything, storedHash).Return("user-123", nil)
+	mockUserRepo.On("UpdatePassword", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(nil)
+	mockUserRepo.On("SetPasswordResetRequired", mock.Anything, "user-123", false).Return(nil)
+
+	err = authUC.ResetPassword(context.Background(), token, "new-password123")
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestAdminUseCase_ForcePasswordReset_RequiresNotifier(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, new(MockTokenRepository), new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	_, err := uc.ForcePasswordReset(context.Background(), "user-123")
+
+	assert.Error(t, err)
+	mockUserRepo.AssertNotCalled(t, "SetPasswordResetRequired", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAuthUseCase_ResetPassword_InvalidToken(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockResetRepo := new(MockPasswordResetRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil, usecases.WithPasswordResetRepository(mockResetRepo))
+
+	mockResetRepo.On("ConsumeResetToken", mock.Anything, mock.AnythingOfType("string")).Return("", nil)
+
+	err := authUC.ResetPassword(context.Background(), "bogus", "new-password123")
+
+	assert.Equal(t, entities.ErrInvalidToken, err)
+	mockUserRepo.AssertNotCalled(t, "UpdatePassword")
+}
+
+func TestAdminUseCase_SetRole_ServiceRoleNotAllowed(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, new(MockTokenRepository), new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole}, nil)
+
+	err := uc.SetRole(context.Background(), "user-123", entities.ServiceRole)
+
+	assert.Equal(t, entities.ErrInvalidRole, err)
+	mockUserRepo.AssertNotCalled(t, "UpdateRole")
+}
+
+func TestAdminUseCase_SetRole_Success(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRoleRepo := new(MockRoleRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockPublisher := new(MockRevocationPublisher)
+	uc := usecases.NewAdminUseCase(mockUserRepo, mockTokenRepo, new(MockPasswordResetRepository), mockRoleRepo, mockPublisher)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole}, nil)
+	mockRoleRepo.On("FindRole", mock.Anything, entities.SupportRole).Return(&entities.RoleDefinition{Name: entities.SupportRole}, nil)
+	mockUserRepo.On("UpdateRole", mock.Anything, "user-123", entities.SupportRole).Return(nil)
+	mockTokenRepo.On("InvalidateAccessTokens", mock.Anything, "user-123").Return(nil)
+	mockPublisher.On("PublishRevocation", mock.Anything, mock.MatchedBy(func(e *entities.RevocationEvent) bool {
+		return e.Type == entities.RevocationRoleChanged && e.UserID == "user-123"
+	})).Return(nil)
+
+	err := uc.SetRole(context.Background(), "user-123", entities.SupportRole)
+
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+	mockTokenRepo.AssertNotCalled(t, "RevokeAllUserTokens")
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestAdminUseCase_SetUserStatus_DeletedIsFinal(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, new(MockTokenRepository), new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole, Status: entities.StatusDeleted}, nil)
+
+	err := uc.SetUserStatus(context.Background(), "user-123", entities.StatusActive, "")
+
+	assert.Equal(t, entities.ErrInvalidStatusTransition, err)
+	mockUserRepo.AssertNotCalled(t, "SetStatus")
+}
+
+func TestAdminUseCase_EnableUser_KeepsSessions(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	uc := usecases.NewAdminUseCase(mockUserRepo, mockTokenRepo, new(MockPasswordResetRepository), new(MockRoleRepository), nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole, Status: entities.StatusSuspended}, nil)
+	mockUserRepo.On("SetStatus", mock.Anything, "user-123", mock.MatchedBy(func(c entities.StatusChange) bool {
+		return c.Status == entities.StatusActive
+	})).Return(nil)
+
+	err := uc.EnableUser(context.Background(), "user-123")
+
+	assert.NoError(t, err)
+	mockTokenRepo.AssertNotCalled(t, "RevokeAllUserTokens")
+}
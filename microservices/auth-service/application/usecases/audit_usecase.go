@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 500
+	auditExportBatch  = 500
+)
+
+// AuditUseCase menulis dan membaca audit log keamanan, sekaligus implementasi services.AuditLogger
+type AuditUseCase struct {
+	repo repositories.AuditRepository
+}
+
+func NewAuditUseCase(repo repositories.AuditRepository) *AuditUseCase {
+	return &AuditUseCase{repo: repo}
+}
+
+// Record melengkapi event dengan metadata request dari context lalu menambahkannya ke rantai
+func (uc *AuditUseCase) Record(ctx context.Context, event *entities.AuditEvent) error {
+	info := entities.RequestInfoFromContext(ctx)
+	if event.ActorID == "" {
+		event.ActorID = info.ActorID
+	}
+	event.IP = info.IP
+	event.UserAgent = info.UserAgent
+	event.AppVersion = info.AppVersion
+	event.DeviceID = info.DeviceID
+	event.RequestID = info.RequestID
+	event.OccurredAt = time.Now().UTC()
+	return uc.repo.Append(ctx, event)
+}
+
+func (uc *AuditUseCase) Query(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, int, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+	if filter.Limit > maxAuditLimit {
+		filter.Limit = maxAuditLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return uc.repo.Query(ctx, filter)
+}
+
+// Export memanggil fn untuk setiap event yang cocok dengan filter, urut dari yang terlama
+func (uc *AuditUseCase) Export(ctx context.Context, filter entities.AuditFilter, fn func(*entities.AuditEvent) error) error {
+	filter.Limit = auditExportBatch
+	for {
+		events, err := uc.repo.ListAfter(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+			filter.AfterSeq = e.Seq
+		}
+		if len(events) < auditExportBatch {
+			return nil
+		}
+	}
+}
+
+// Verify memeriksa seluruh hash chain dari awal. Mengembalikan jumlah event yang diperiksa
+// dan Seq event pertama yang tidak valid (0 jika utuh).
+func (uc *AuditUseCase) Verify(ctx context.Context) (int, int64, error) {
+	var checked int
+	var prevHash string
+	var brokenSeq int64
+	err := uc.Export(ctx, entities.AuditFilter{}, func(e *entities.AuditEvent) error {
+		checked++
+		if seq := entities.VerifyAuditChain(prevHash, []*entities.AuditEvent{e}); seq != 0 {
+			brokenSeq = seq
+			return errAuditChainBroken
+		}
+		prevHash = e.Hash
+		return nil
+	})
+	if err != nil && err != errAuditChainBroken {
+		return 0, 0, err
+	}
+	return checked, brokenSeq, nil
+}
+
+// errAuditChainBroken menghentikan Export lebih awal saat Verify menemukan kerusakan
+var errAuditChainBroken = errors.New("audit chain broken")
+
+// recordAudit : kegagalan audit tidak menggagalkan aksi utama, hanya di-log
+func recordAudit(ctx context.Context, logger services.AuditLogger, event *entities.AuditEvent) {
+	if logger == nil {
+		return
+	}
+	if err := logger.Record(ctx, event); err != nil {
+		zap.L().Error("failed to record audit event",
+			zap.String("action", string(event.Action)),
+			zap.String("subject_id", event.SubjectID),
+			zap.Error(err),
+		)
+	}
+}
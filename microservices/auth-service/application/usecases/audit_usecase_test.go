@@ -0,0 +1,146 @@
+package usecases_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+)
+
+type MockAuditLogger struct {
+	mock.Mock
+}
+
+func (m *MockAuditLogger) Record(ctx context.Context, event *entities.AuditEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// memoryAuditRepository menyimpan rantai audit di memori dengan aturan hash yang sama seperti Postgres
+type memoryAuditRepository struct {
+	events []*entities.AuditEvent
+}
+
+func (r *memoryAuditRepository) Append(ctx context.Context, event *entities.AuditEvent) error {
+	if n := len(r.events); n > 0 {
+		event.PrevHash = r.events[n-1].Hash
+	}
+	if err := event.Commit(); err != nil {
+		return err
+	}
+	event.Hash = event.ComputeHash()
+	event.Seq = int64(len(r.events) + 1)
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memoryAuditRepository) Query(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, int, error) {
+	return r.events, len(r.events), nil
+}
+
+func (r *memoryAuditRepository) ListAfter(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, error) {
+	var result []*entities.AuditEvent
+	for _, e := range r.events {
+		if e.Seq > filter.AfterSeq && len(result) < filter.Limit {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// Pseudonymize mengikuti query Postgres: ID dan email diganti, data request pelaku dikosongkan
+// dan nonce field yang diubah dihapus
+func (r *memoryAuditRepository) Pseudonymize(ctx context.Context, userID, email, pseudonym string) (int, error) {
+	matches := func(v string) bool { return v == userID || (email != "" && strings.EqualFold(v, email)) }
+	n := 0
+	for _, e := range r.events {
+		inMetadata := false
+		for _, v := range e.Metadata {
+			inMetadata = inMetadata || matches(v)
+		}
+		if e.Commitments == nil || (e.ActorID != userID && e.SubjectID != userID && !inMetadata) {
+			continue
+		}
+		if e.ActorID == userID {
+			e.ActorID = pseudonym
+			e.IP, e.UserAgent, e.AppVersion, e.DeviceID, e.RequestID = "", "", "", "", ""
+			for _, name := range []string{"actor_id", "ip", "user_agent", "app_version", "device_id", "request_id"} {
+				delete(e.Nonces, name)
+			}
+		}
+		if e.SubjectID == userID {
+			e.SubjectID = pseudonym
+			delete(e.Nonces, "subject_id")
+		}
+		for k, v := range e.Metadata {
+			if matches(v) {
+				e.Metadata[k] = pseudonym
+				delete(e.Nonces, "metadata."+k)
+			}
+		}
+		e.Redacted = true
+		n++
+	}
+	return n, nil
+}
+
+func TestAuditUseCase_Record_FillsRequestInfo(t *testing.T) {
+	repo := &memoryAuditRepository{}
+	uc := usecases.NewAuditUseCase(repo)
+
+	ctx := entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{
+		IP:        "203.0.113.7",
+		UserAgent: "mobile/1.0",
+		RequestID: "req-1",
+		ActorID:   "admin-1",
+	})
+	err := uc.Record(ctx, &entities.AuditEvent{Action: entities.AuditForceLogout, SubjectID: "user-123"})
+
+	assert.NoError(t, err)
+	event := repo.events[0]
+	assert.Equal(t, "admin-1", event.ActorID)
+	assert.Equal(t, "203.0.113.7", event.IP)
+	assert.Equal(t, "mobile/1.0", event.UserAgent)
+	assert.Equal(t, "req-1", event.RequestID)
+	assert.False(t, event.OccurredAt.IsZero())
+}
+
+func TestAuditUseCase_Verify_DetectsTampering(t *testing.T) {
+	repo := &memoryAuditRepository{}
+	uc := usecases.NewAuditUseCase(repo)
+
+	for _, action := range []entities.AuditAction{entities.AuditRegister, entities.AuditLoginSucceeded, entities.AuditLogout} {
+		assert.NoError(t, uc.Record(context.Background(), &entities.AuditEvent{Action: action, SubjectID: "user-123"}))
+	}
+
+	checked, brokenSeq, err := uc.Verify(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, checked)
+	assert.Equal(t, int64(0), brokenSeq)
+
+	// Mengubah isi event kedua memutus rantai tepat di event tersebut
+	repo.events[1].SubjectID = "user-456"
+
+	_, brokenSeq, err = uc.Verify(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), brokenSeq)
+}
+
+func TestAuditUseCase_Verify_DetectsDeletion(t *testing.T) {
+	repo := &memoryAuditRepository{}
+	uc := usecases.NewAuditUseCase(repo)
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, uc.Record(context.Background(), &entities.AuditEvent{Action: entities.AuditLoginFailed}))
+	}
+	repo.events = append(repo.events[:1], repo.events[2:]...)
+
+	_, brokenSeq, err := uc.Verify(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), brokenSeq)
+}
@@ -5,7 +5,9 @@ import (
 	"log"
 	"microservices/auth-service/domain/entities"
 	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
 	"microservices/auth-service/infrastructure/auth"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -14,19 +16,122 @@ import (
 type AuthUseCase struct {
 	userRepo  repositories.UserRepository
 	tokenRepo repositories.TokenRepository
+	roleRepo  repositories.RoleRepository
+	resetRepo repositories.PasswordResetRepository
+	credRepo  repositories.CredentialRepository
+	publisher services.RevocationPublisher
+	outbox    *Outbox
+	audit     services.AuditLogger
+	risk      *LoginRiskAssessor
+	otp       *OTPUseCase
+	notifier  services.Notifier
+	guardians repositories.GuardianRepository
+	orgs      repositories.OrganizationRepository
+	consents  repositories.ConsentRepository
 	jwtAuth   *auth.JWTAuth
 	logger    *zap.Logger
 }
-func NewAuthUseCase(userRepo repositories.UserRepository, tokenRepo repositories.TokenRepository, jwtSecret string, logger *zap.Logger) *AuthUseCase {
-	return &AuthUseCase{
+
+// Option untuk dependency opsional AuthUseCase
+type Option func(*AuthUseCase)
+
+// WithRoleRepository mengaktifkan claim permission berdasarkan role user
+func WithRoleRepository(roleRepo repositories.RoleRepository) Option {
+	return func(uc *AuthUseCase) { uc.roleRepo = roleRepo }
+}
+
+// WithPasswordResetRepository mengaktifkan ResetPassword
+func WithPasswordResetRepository(resetRepo repositories.PasswordResetRepository) Option {
+	return func(uc *AuthUseCase) { uc.resetRepo = resetRepo }
+}
+
+// WithCredentialRepository mengaktifkan verifikasi lisensi: psikolog tanpa credential
+// yang valid mendapat role claim psychologist_pending
+func WithCredentialRepository(credRepo repositories.CredentialRepository) Option {
+	return func(uc *AuthUseCase) { uc.credRepo = credRepo }
+}
+
+// WithRevocationPublisher menyiarkan logout/revocation ke service yang memvalidasi JWT secara lokal
+func WithRevocationPublisher(publisher services.RevocationPublisher) Option {
+	return func(uc *AuthUseCase) { uc.publisher = publisher }
+}
+
+// WithOutbox mengaktifkan domain event (UserRegistered, UserLoggedIn, PasswordChanged)
+func WithOutbox(outbox *Outbox) Option {
+	return func(uc *AuthUseCase) { uc.outbox = outbox }
+}
+
+// WithAuditLogger mencatat register, login, refresh, logout dan perubahan password ke audit log
+func WithAuditLogger(audit services.AuditLogger) Option {
+	return func(uc *AuthUseCase) { uc.audit = audit }
+}
+
+// WithLoginRiskAssessor mengaktifkan deteksi device/lokasi baru, notifikasi login
+// dan step-up untuk login berisiko tinggi
+func WithLoginRiskAssessor(risk *LoginRiskAssessor) Option {
+	return func(uc *AuthUseCase) { uc.risk = risk }
+}
+
+// WithOTP mengaktifkan MFA lewat kode SMS/email dan verifikasi email: user baru dibuat
+// pending_verification sampai kode dari email dimasukkan lewat VerifyEmail. Tanpa OTP,
+// user baru langsung aktif, sedangkan user dengan MFA aktif dan login berisiko tinggi
+// ditolak dengan ErrStepUpRequired.
+func WithOTP(otp *OTPUseCase) Option {
+	return func(uc *AuthUseCase) { uc.otp = otp }
+}
+
+// WithNotifier mengirim email ke user untuk login dari device atau lokasi baru
+func WithNotifier(notifier services.Notifier) Option {
+	return func(uc *AuthUseCase) { uc.notifier = notifier }
+}
+
+// WithGuardianRepository menambahkan claim guardian_of (akun anak yang dikelola) dan minor ke access token
+func WithGuardianRepository(guardians repositories.GuardianRepository) Option {
+	return func(uc *AuthUseCase) { uc.guardians = guardians }
+}
+
+// WithOrganizationRepository mengaktifkan SwitchOrganization dan claim org_id/org_role
+func WithOrganizationRepository(orgs repositories.OrganizationRepository) Option {
+	return func(uc *AuthUseCase) { uc.orgs = orgs }
+}
+
+// WithConsentRepository menahan login sampai user menyetujui versi terbaru dokumen wajib
+func WithConsentRepository(consents repositories.ConsentRepository) Option {
+	return func(uc *AuthUseCase) { uc.consents = consents }
+}
+
+func NewAuthUseCase(userRepo repositories.UserRepository, tokenRepo repositories.TokenRepository, jwtSecret string, logger *zap.Logger, opts ...Option) *AuthUseCase {
+	uc := &AuthUseCase{
 		userRepo:  userRepo,
 		tokenRepo: tokenRepo,
 		jwtAuth:   auth.NewJWTAuth(jwtSecret),
 		logger:    logger,
 	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
 func (uc *AuthUseCase) Register(ctx context.Context, email, password string, role entities.Role) (*entities.User, error) {
+	user, err := uc.newUser(ctx, email, password, role)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.createUser(ctx, user, nil); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// newUser memvalidasi input registrasi dan menyiapkan user yang belum disimpan. Jika OTP
+// tersedia, user menunggu verifikasi email (pending_verification).
+func (uc *AuthUseCase) newUser(ctx context.Context, email, password string, role entities.Role) (*entities.User, error) {
+	// Role seperti admin/support tidak boleh dipilih sendiri
+	if !role.IsSelfAssignable() {
+		return nil, entities.ErrInvalidRole
+	}
+
 	// Validasi email unik
 	if existing, _ := uc.userRepo.FindByEmail(ctx, email); existing != nil {
 		return nil, entities.ErrEmailExists
@@ -38,32 +143,340 @@ func (uc *AuthUseCase) Register(ctx context.Context, email, password string, rol
 		return nil, err
 	}
 
-	user := &entities.User{
+	status := entities.StatusActive
+	if uc.otp != nil {
+		status = entities.StatusPendingVerification
+	}
+	return &entities.User{
 		ID:           auth.GenerateUUID(),
 		Email:        email,
 		PasswordHash: hashedPassword,
 		Role:         role,
+		Status:       status,
 		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// RequiresEmailVerification : email user harus diverifikasi sebelum akun bisa aktif
+func (uc *AuthUseCase) RequiresEmailVerification(user *entities.User) bool {
+	return uc.otp != nil && user.AwaitingEmailVerification()
+}
+
+// SendEmailVerification mengirim kode verifikasi ke email user yang belum diverifikasi dan
+// mengembalikan ID challenge untuk VerifyEmail
+func (uc *AuthUseCase) SendEmailVerification(ctx context.Context, user *entities.User) (string, error) {
+	if !uc.RequiresEmailVerification(user) {
+		return "", entities.ErrAccountPendingVerification
+	}
+	challenge := &entities.OTPChallenge{
+		UserID:      user.ID,
+		Purpose:     entities.OTPPurposeEmailVerification,
+		Channel:     entities.OTPChannelEmail,
+		Destination: user.Email,
 	}
+	if err := uc.otp.Issue(ctx, challenge); err != nil {
+		return "", err
+	}
+	return challenge.ID, nil
+}
 
-	if err := uc.userRepo.CreateUser(ctx, user); err != nil {
-		return nil, err
+// VerifyEmail menandai email terverifikasi dengan kode dari SendEmailVerification dan
+// mengaktifkan akun pending_verification. Akun yang masih menunggu persetujuan wali tetap
+// menunggu; setelah aktif user login seperti biasa.
+func (uc *AuthUseCase) VerifyEmail(ctx context.Context, challengeID, code string) error {
+	if uc.otp == nil {
+		return entities.ErrInvalidOTP
+	}
+	challenge, err := uc.otp.Verify(ctx, challengeID, code, entities.OTPPurposeEmailVerification)
+	if err != nil {
+		return err
 	}
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil || user == nil {
+		return entities.ErrInvalidOTP
+	}
+	// Email bisa saja berubah setelah kode dikirim
+	if user.Email != challenge.Destination {
+		return entities.ErrInvalidOTP
+	}
+	if err := uc.userRepo.MarkEmailVerified(ctx, user.ID, time.Now().UTC()); err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditEmailVerified, user.ID, map[string]string{"status": string(user.Status)})
+	return nil
+}
 
-	return user, nil
+// createUser menyimpan user beserta event UserRegistered. also (opsional) dijalankan
+// dalam transaksi yang sama, e.g. untuk membuat data pendamping user; events ikut dicatat bersamanya.
+func (uc *AuthUseCase) createUser(ctx context.Context, user *entities.User, also func(ctx context.Context) error, events ...*entities.DomainEvent) error {
+	registered := newDomainEvent(entities.EventUserRegistered, user.ID, entities.UserRegisteredPayload{
+		UserID: user.ID,
+		Role:   user.Role,
+	})
+	if err := uc.outbox.record(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.CreateUser(ctx, user); err != nil {
+			return err
+		}
+		if also != nil {
+			return also(ctx)
+		}
+		return nil
+	}, append([]*entities.DomainEvent{registered}, events...)...); err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditRegister, user.ID, map[string]string{
+		"role":   string(user.Role),
+		"status": string(user.Status),
+	})
+	return nil
 }
 
 func (uc *AuthUseCase) Login(ctx context.Context, email, password string) (string, string, error) {
 	user, err := uc.userRepo.FindByEmail(ctx, email)
-	if err != nil {
+	if err != nil || user == nil {
+		uc.auditSelf(ctx, entities.AuditLoginFailed, "", map[string]string{"email": email, "reason": "unknown_user"})
 		return "", "", entities.ErrInvalidCredentials
 	}
 
 	if !auth.Argon2Verify(password, user.PasswordHash) {
+		uc.auditSelf(ctx, entities.AuditLoginFailed, user.ID, map[string]string{"reason": "bad_password"})
 		return "", "", entities.ErrInvalidCredentials
 	}
 
-	accessToken, refreshToken, err := uc.jwtAuth.GenerateTokens(user.ID, string(user.Role))
+	// Status akun dicek setelah password valid agar tidak membocorkan keberadaan akun.
+	// Akun yang belum memverifikasi email langsung dikirimi kode baru.
+	if uc.RequiresEmailVerification(user) {
+		uc.auditSelf(ctx, entities.AuditLoginFailed, user.ID, map[string]string{"reason": "status_" + string(user.Status)})
+		challengeID, err := uc.SendEmailVerification(ctx, user)
+		if err != nil {
+			return "", "", err
+		}
+		return "", "", &entities.EmailVerificationRequiredError{ChallengeID: challengeID}
+	}
+	if err := user.StatusError(); err != nil {
+		uc.auditSelf(ctx, entities.AuditLoginFailed, user.ID, map[string]string{"reason": "status_" + string(user.Status)})
+		return "", "", err
+	}
+	if user.PasswordResetRequired {
+		uc.auditSelf(ctx, entities.AuditLoginFailed, user.ID, map[string]string{"reason": "password_reset_required"})
+		return "", "", entities.ErrPasswordResetRequired
+	}
+
+	return uc.completeLogin(ctx, user, entities.AuthMethodPassword, "password")
+}
+
+// completeLogin dipanggil setelah faktor pertama lolos. Akun yang belum aktif (termasuk yang
+// emailnya belum diverifikasi) ditolak apa pun metode loginnya. Jika user mengaktifkan MFA atau
+// login berisiko tinggi, token belum diterbitkan: kode dikirim dan *MFARequiredError dikembalikan.
+// Persetujuan dokumen wajib baru diperiksa setelah semua faktor lolos, sehingga consent token
+// tidak pernah diberikan kepada pemegang faktor pertama saja.
+func (uc *AuthUseCase) completeLogin(ctx context.Context, user *entities.User, firstFactor entities.AuthMethod, loginMethod string) (string, string, error) {
+	if err := user.StatusError(); err != nil {
+		return "", "", err
+	}
+
+	risk, err := uc.assessLogin(ctx, user)
+	stepUp := err == entities.ErrStepUpRequired
+	if err != nil && !stepUp {
+		return "", "", err
+	}
+
+	if user.MFAEnabled() || stepUp {
+		if uc.otp == nil {
+			return "", "", entities.ErrStepUpRequired
+		}
+		return "", "", uc.startMFA(ctx, user, firstFactor, loginMethod)
+	}
+	if err := uc.requireConsents(ctx, user); err != nil {
+		return "", "", err
+	}
+
+	accessToken, refreshToken, err := uc.issueTokens(ctx, user, entities.NewAuthContext(firstFactor))
+	if err != nil {
+		return "", "", err
+	}
+	uc.recordLogin(ctx, user, loginMethod, risk)
+	return accessToken, refreshToken, nil
+}
+
+// mfaChannel : channel MFA pilihan user, atau email untuk step-up user yang belum mengaktifkan MFA
+func mfaChannel(user *entities.User) entities.OTPChannel {
+	if user.MFAEnabled() {
+		return user.MFAChannel
+	}
+	return entities.OTPChannelEmail
+}
+
+func (uc *AuthUseCase) startMFA(ctx context.Context, user *entities.User, firstFactor entities.AuthMethod, loginMethod string) error {
+	channel := mfaChannel(user)
+	destination, err := user.OTPDestination(channel)
+	if err != nil {
+		return err
+	}
+	challenge := &entities.OTPChallenge{
+		UserID:      user.ID,
+		Purpose:     entities.OTPPurposeLogin,
+		Channel:     channel,
+		Destination: destination,
+		Methods:     []entities.AuthMethod{firstFactor},
+		LoginMethod: loginMethod,
+	}
+	if err := uc.otp.Issue(ctx, challenge); err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditMFAChallengeSent, user.ID, map[string]string{
+		"purpose": string(challenge.Purpose),
+		"channel": string(channel),
+	})
+	return &entities.MFARequiredError{ChallengeID: challenge.ID, Channel: channel}
+}
+
+// VerifyMFA menyelesaikan login yang tertahan di completeLogin dengan kode OTP. Dokumen wajib
+// yang belum disetujui menghasilkan *ConsentRequiredError; setelah menyetujuinya user login ulang.
+func (uc *AuthUseCase) VerifyMFA(ctx context.Context, challengeID, code string) (string, string, error) {
+	if uc.otp == nil {
+		return "", "", entities.ErrInvalidOTP
+	}
+	challenge, err := uc.otp.Verify(ctx, challengeID, code, entities.OTPPurposeLogin)
+	if err != nil {
+		uc.auditSelf(ctx, entities.AuditMFAFailed, "", map[string]string{"challenge_id": challengeID, "reason": err.Error()})
+		return "", "", err
+	}
+
+	// Status dicek ulang, akun bisa saja dinonaktifkan selama kode belum dimasukkan
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil || user == nil {
+		return "", "", entities.ErrInvalidOTP
+	}
+	if err := user.StatusError(); err != nil {
+		return "", "", err
+	}
+	if err := uc.requireConsents(ctx, user); err != nil {
+		return "", "", err
+	}
+
+	// Risiko dinilai ulang hanya untuk dicatat; step-up sudah terpenuhi oleh kode ini
+	var risk *entities.LoginRisk
+	if uc.risk != nil {
+		if risk, err = uc.risk.Assess(ctx, user.ID); err != nil {
+			zap.L().Warn("failed to assess login risk", zap.String("user_id", user.ID), zap.Error(err))
+			risk = nil
+		}
+	}
+
+	methods := append(challenge.Methods, challenge.Channel.AuthMethod(), entities.AuthMethodMFA)
+	accessToken, refreshToken, err := uc.issueTokens(ctx, user, entities.NewAuthContext(methods...))
+	if err != nil {
+		return "", "", err
+	}
+	uc.recordLogin(ctx, user, challenge.LoginMethod, risk)
+	return accessToken, refreshToken, nil
+}
+
+// auditSelf mencatat aksi yang dilakukan user terhadap akunnya sendiri
+func (uc *AuthUseCase) auditSelf(ctx context.Context, action entities.AuditAction, userID string, metadata map[string]string) {
+	recordAudit(ctx, uc.audit, &entities.AuditEvent{
+		Action:    action,
+		ActorID:   userID,
+		SubjectID: userID,
+		Metadata:  metadata,
+	})
+}
+
+// assessLogin menilai risiko login. Kegagalan penilaian (GeoIP, database) tidak memblokir
+// login; hanya login berisiko tinggi dengan kebijakan step-up yang ditolak.
+func (uc *AuthUseCase) assessLogin(ctx context.Context, user *entities.User) (*entities.LoginRisk, error) {
+	if uc.risk == nil {
+		return nil, nil
+	}
+	risk, err := uc.risk.Assess(ctx, user.ID)
+	if err != nil {
+		zap.L().Warn("failed to assess login risk", zap.String("user_id", user.ID), zap.Error(err))
+		return nil, nil
+	}
+	if uc.risk.RequiresStepUp(risk) {
+		uc.auditSelf(ctx, entities.AuditLoginFailed, user.ID, map[string]string{
+			"reason":     "step_up_required",
+			"risk_score": strconv.Itoa(risk.Score),
+		})
+		uc.alertLogin(ctx, user, risk, true)
+		return nil, entities.ErrStepUpRequired
+	}
+	return risk, nil
+}
+
+// alertLogin mengirim notifikasi jika login datang dari device atau lokasi baru
+func (uc *AuthUseCase) alertLogin(ctx context.Context, user *entities.User, risk *entities.LoginRisk, blocked bool) {
+	if len(risk.Reasons) == 0 {
+		return
+	}
+	info := entities.RequestInfoFromContext(ctx)
+	payload := entities.LoginAlertPayload{
+		UserID:    user.ID,
+		Reasons:   risk.Reasons,
+		RiskScore: risk.Score,
+		IP:        info.IP,
+		UserAgent: info.UserAgent,
+		Blocked:   blocked,
+	}
+	if risk.Location != nil {
+		payload.Country = risk.Location.Country
+		payload.City = risk.Location.City
+	}
+	if err := uc.outbox.enqueue(ctx, newDomainEvent(entities.EventLoginAlert, user.ID, payload)); err != nil {
+		zap.L().Warn("failed to enqueue login alert", zap.String("user_id", user.ID), zap.Error(err))
+	}
+
+	if uc.notifier == nil || user.Email == "" {
+		return
+	}
+	if err := uc.notifier.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationLoginAlert,
+		UserID:    user.ID,
+		Recipient: user.Email,
+		Data: map[string]string{
+			"ip":         payload.IP,
+			"country":    payload.Country,
+			"city":       payload.City,
+			"user_agent": payload.UserAgent,
+			"blocked":    strconv.FormatBool(blocked),
+		},
+	}); err != nil {
+		zap.L().Warn("failed to queue login alert email", zap.String("user_id", user.ID), zap.Error(err))
+	}
+}
+
+// recordLogin : event login dan audit tidak boleh menggagalkan login, kegagalan hanya di-log
+func (uc *AuthUseCase) recordLogin(ctx context.Context, user *entities.User, method string, risk *entities.LoginRisk) {
+	userID := user.ID
+	metadata := map[string]string{"method": method}
+	if risk != nil {
+		metadata["risk_score"] = strconv.Itoa(risk.Score)
+		if err := uc.risk.Record(ctx, userID, risk); err != nil {
+			zap.L().Warn("failed to record login history", zap.String("user_id", userID), zap.Error(err))
+		}
+		uc.alertLogin(ctx, user, risk, false)
+	}
+	uc.auditSelf(ctx, entities.AuditLoginSucceeded, userID, metadata)
+
+	event := newDomainEvent(entities.EventUserLoggedIn, userID, entities.UserLoggedInPayload{
+		UserID: userID,
+		Method: method,
+	})
+	if err := uc.outbox.enqueue(ctx, event); err != nil {
+		zap.L().Warn("failed to enqueue login event", zap.String("user_id", userID), zap.Error(err))
+	}
+}
+
+// issueTokens membuat pasangan access/refresh token dan menyimpan refresh token
+func (uc *AuthUseCase) issueTokens(ctx context.Context, user *entities.User, authCtx entities.AuthContext) (string, string, error) {
+	role, opts, err := uc.tokenOptions(ctx, user)
+	if err != nil {
+		return "", "", err
+	}
+	opts = append(opts, auth.WithAuthContext(authCtx))
+
+	accessToken, refreshToken, err := uc.jwtAuth.GenerateTokens(user.ID, string(role), opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -73,7 +486,7 @@ func (uc *AuthUseCase) Login(ctx context.Context, email, password string) (strin
 	if err != nil {
 		log.Printf("failed to validate refresh token: %v", err)
 	} else {
-		if err := uc.tokenRepo.StoreToken(ctx, refreshClaims.ID, user.ID); err != nil {
+		if err := uc.tokenRepo.StoreToken(ctx, entities.NewSession(refreshClaims.ID, user.ID, entities.RequestInfoFromContext(ctx), authCtx)); err != nil {
 			log.Printf("failed to store refresh token: %v", err)
 		}
 	}
@@ -81,7 +494,123 @@ func (uc *AuthUseCase) Login(ctx context.Context, email, password string) (strin
 	return accessToken, refreshToken, nil
 }
 
+// tokenOptions menyusun role utama serta claim roles dan permission untuk access token
+func (uc *AuthUseCase) tokenOptions(ctx context.Context, user *entities.User) (entities.Role, []auth.TokenOption, error) {
+	primary := user.Role
+	roles := user.Roles
+	if len(roles) == 0 {
+		roles = []entities.Role{user.Role}
+	}
+
+	if user.HasRole(entities.PsychologistRole) {
+		verified, err := uc.isVerifiedPsychologist(ctx, user.ID)
+		if err != nil {
+			return "", nil, err
+		}
+		if !verified {
+			primary, roles = withoutPsychologistRole(primary, roles)
+		}
+	}
+
+	roleNames := make([]string, len(roles))
+	for i, r := range roles {
+		roleNames[i] = string(r)
+	}
+	opts := []auth.TokenOption{auth.WithRoles(roleNames)}
+
+	if uc.roleRepo != nil {
+		perms, err := uc.roleRepo.GetPermissions(ctx, roles)
+		if err != nil {
+			return "", nil, err
+		}
+		permNames := make([]string, len(perms))
+		for i, p := range perms {
+			permNames[i] = string(p)
+		}
+		opts = append(opts, auth.WithPermissions(permNames))
+	}
+
+	if user.IsMinorAt(time.Now()) {
+		opts = append(opts, auth.WithMinor())
+	}
+	if uc.guardians != nil {
+		wards, err := uc.guardians.ListActiveByGuardian(ctx, user.ID)
+		if err != nil {
+			return "", nil, err
+		}
+		var ids []string
+		for _, w := range wards {
+			// Perwalian berakhir saat anak mencapai usia dewasa, sama seperti GuardianUseCase.ListWards
+			minor, err := uc.userRepo.FindByID(ctx, w.MinorID)
+			if err != nil {
+				return "", nil, err
+			}
+			if minor != nil && minor.IsMinorAt(time.Now()) {
+				ids = append(ids, w.MinorID)
+			}
+		}
+		if len(ids) > 0 {
+			opts = append(opts, auth.WithGuardianOf(ids))
+		}
+	}
+
+	return primary, opts, nil
+}
+
+func (uc *AuthUseCase) isVerifiedPsychologist(ctx context.Context, userID string) (bool, error) {
+	if uc.credRepo == nil {
+		return true, nil
+	}
+
+	creds, err := uc.credRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now().UTC()
+	for _, c := range creds {
+		if c.IsValid(now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// withoutPsychologistRole mengganti role psychologist dengan psychologist_pending
+func withoutPsychologistRole(primary entities.Role, roles []entities.Role) (entities.Role, []entities.Role) {
+	if primary == entities.PsychologistRole {
+		primary = entities.PendingPsychologistRole
+	}
+	filtered := make([]entities.Role, 0, len(roles))
+	for _, r := range roles {
+		if r == entities.PsychologistRole {
+			r = entities.PendingPsychologistRole
+		}
+		filtered = append(filtered, r)
+	}
+	return primary, filtered
+}
+
 func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (string, string, error) {
+	return uc.rotateSession(ctx, refreshToken, "", nil)
+}
+
+// SwitchOrganization merotasi refresh token milik userID menjadi sesi untuk organisasi orgID,
+// orgID kosong kembali ke konteks pribadi. auth_time sesi lama tetap dipakai.
+func (uc *AuthUseCase) SwitchOrganization(ctx context.Context, userID, refreshToken, orgID string) (string, string, error) {
+	if orgID != "" && uc.orgs == nil {
+		return "", "", entities.ErrOrganizationNotFound
+	}
+	accessToken, newRefreshToken, err := uc.rotateSession(ctx, refreshToken, userID, &orgID)
+	if err != nil {
+		return "", "", err
+	}
+	uc.auditSelf(ctx, entities.AuditOrgSwitched, userID, map[string]string{"org_id": orgID})
+	return accessToken, newRefreshToken, nil
+}
+
+// rotateSession menukar refresh token dengan pasangan token baru. ownerID (opsional) memastikan
+// token milik user yang sedang login; switchOrg (opsional) mengganti organisasi aktif sesi.
+func (uc *AuthUseCase) rotateSession(ctx context.Context, refreshToken, ownerID string, switchOrg *string) (string, string, error) {
 	claims, err := uc.jwtAuth.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return "", "", entities.ErrInvalidToken
@@ -92,6 +621,9 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (s
 	if err != nil {
 		return "", "", entities.ErrInvalidToken
 	}
+	if ownerID != "" && userID != ownerID {
+		return "", "", entities.ErrInvalidToken
+	}
 
 	// Periksa apakah token revoked
 	if uc.tokenRepo.IsTokenRevoked(ctx, claims.ID) {
@@ -103,9 +635,53 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (s
 	if err != nil || user == nil {
 		return "", "", entities.ErrUserNotFound
 	}
+	// Akun yang disuspend/dinonaktifkan tidak boleh memperpanjang sesi
+	if err := user.StatusError(); err != nil {
+		uc.revokeRefreshToken(ctx, claims.ID)
+		return "", "", err
+	}
+	// Dokumen wajib yang terbit setelah login juga menahan refresh. Refresh token tidak dicabut:
+	// setelah menyetujui dengan consent token, sesi yang sama bisa diperpanjang lagi.
+	if err := uc.requireConsents(ctx, user); err != nil {
+		return "", "", err
+	}
+
+	role, opts, err := uc.tokenOptions(ctx, user)
+	if err != nil {
+		return "", "", err
+	}
+
+	// auth_time tetap waktu login awal, refresh bukan autentikasi ulang
+	var authCtx entities.AuthContext
+	var orgID string
+	if session, err := uc.tokenRepo.GetSession(ctx, claims.ID); err != nil {
+		uc.logger.Error("failed to load session", zap.Error(err))
+	} else if session != nil {
+		authCtx = session.Auth
+		orgID = session.OrgID
+	}
+	opts = append(opts, auth.WithAuthContext(authCtx))
+
+	// Keanggotaan diperiksa ulang setiap rotasi: member yang dikeluarkan kehilangan sesi tenant-nya
+	if switchOrg != nil {
+		orgID = *switchOrg
+	}
+	if orgID != "" {
+		membership, err := uc.membership(ctx, user.ID, orgID)
+		if err != nil {
+			return "", "", err
+		}
+		if membership == nil {
+			if switchOrg == nil {
+				uc.revokeRefreshToken(ctx, claims.ID)
+			}
+			return "", "", entities.ErrNotOrgMember
+		}
+		opts = append(opts, auth.WithOrganization(orgID, string(membership.Role)))
+	}
 
 	// Generate new tokens
-	newAccessToken, newRefreshToken, err := uc.jwtAuth.RotateTokens(user.ID, string(user.Role))
+	newAccessToken, newRefreshToken, err := uc.jwtAuth.RotateTokens(user.ID, string(role), opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -117,24 +693,257 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (s
 	}
 
 	// Revoke old refresh token
-	if err := uc.tokenRepo.RevokeToken(ctx, claims.ID); err != nil {
-		uc.logger.Error("failed to revoke token", zap.Error(err))
-	}
+	uc.revokeRefreshToken(ctx, claims.ID)
 
 	// Store new refresh token
-	if err := uc.tokenRepo.StoreToken(ctx, newRefreshClaims.ID, user.ID); err != nil {
+	session := entities.NewSession(newRefreshClaims.ID, user.ID, entities.RequestInfoFromContext(ctx), authCtx)
+	session.OrgID = orgID
+	if err := uc.tokenRepo.StoreToken(ctx, session); err != nil {
 		uc.logger.Error("failed to store new refresh token", zap.Error(err))
 		return "", "", err
 	}
+	uc.auditSelf(ctx, entities.AuditTokenRefreshed, user.ID, nil)
 
 	return newAccessToken, newRefreshToken, nil
 }
 
+func (uc *AuthUseCase) revokeRefreshToken(ctx context.Context, tokenID string) {
+	if err := uc.tokenRepo.RevokeToken(ctx, tokenID); err != nil {
+		uc.logger.Error("failed to revoke token", zap.Error(err))
+	}
+}
+
+// membership mengembalikan nil jika user bukan member orgID
+func (uc *AuthUseCase) membership(ctx context.Context, userID, orgID string) (*entities.Membership, error) {
+	if uc.orgs == nil {
+		return nil, nil
+	}
+	return uc.orgs.FindMember(entities.ContextWithTenant(ctx, orgID), userID)
+}
+
 func (uc *AuthUseCase) Logout(ctx context.Context, refreshToken string) error {
 	claims, err := uc.jwtAuth.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return entities.ErrInvalidToken
 	}
 
-	return uc.tokenRepo.RevokeToken(ctx, claims.ID)
+	// Refresh token tidak membawa uid, pemiliknya dicari untuk audit log
+	userID, _ := uc.tokenRepo.GetUserIDByTokenID(ctx, claims.ID)
+	if err := uc.tokenRepo.RevokeToken(ctx, claims.ID); err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditLogout, userID, nil)
+	return nil
+}
+
+// ResetPassword mengganti password memakai token dari ForcePasswordReset, semua sesi lama dicabut
+func (uc *AuthUseCase) ResetPassword(ctx context.Context, resetToken, newPassword string) error {
+	if uc.resetRepo == nil {
+		return entities.ErrInvalidToken
+	}
+
+	userID, err := uc.resetRepo.ConsumeResetToken(ctx, auth.HashOpaqueToken(resetToken))
+	if err != nil {
+		return err
+	}
+	if userID == "" {
+		return entities.ErrInvalidToken
+	}
+
+	hashedPassword, err := auth.Argon2Hash(newPassword)
+	if err != nil {
+		return err
+	}
+	changed := newDomainEvent(entities.EventPasswordChanged, userID, entities.PasswordChangedPayload{UserID: userID, Reset: true})
+	if err := uc.outbox.record(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+			return err
+		}
+		return uc.userRepo.SetPasswordResetRequired(ctx, userID, false)
+	}, changed); err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditPasswordReset, userID, nil)
+	return uc.revokeUserSessions(ctx, userID)
+}
+
+// revokeUserSessions mencabut semua sesi user dan memberi tahu service lain
+func (uc *AuthUseCase) revokeUserSessions(ctx context.Context, userID string) error {
+	if err := uc.tokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+		return err
+	}
+	publishRevocation(ctx, uc.publisher, userRevocation(entities.RevocationSessionsRevoked, userID))
+	return nil
+}
+
+// ValidateAccessToken memvalidasi signature/expiry lalu memeriksa denylist dan watermark user.
+// Error selain ErrInvalidToken berarti pemeriksaan revocation gagal (Redis tidak tersedia).
+func (uc *AuthUseCase) ValidateAccessToken(ctx context.Context, accessToken string) (*auth.CustomClaims, error) {
+	claims, err := uc.jwtAuth.ValidateToken(accessToken)
+	if err != nil {
+		return nil, entities.ErrInvalidToken
+	}
+
+	revoked, err := uc.tokenRepo.IsAccessTokenRevoked(ctx, claims.ID, claims.UserID, claims.IssuedAtTime())
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, entities.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// RevokeAccessToken mematikan satu access token sebelum expired (logout)
+func (uc *AuthUseCase) RevokeAccessToken(ctx context.Context, claims *auth.CustomClaims) error {
+	if claims.ExpiresAt == nil {
+		return entities.ErrInvalidToken
+	}
+	if err := uc.tokenRepo.DenyAccessToken(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return err
+	}
+
+	expiresAt := claims.ExpiresAt.Time
+	publishRevocation(ctx, uc.publisher, &entities.RevocationEvent{
+		Type:      entities.RevocationUserLogout,
+		UserID:    claims.UserID,
+		TokenID:   claims.ID,
+		ExpiresAt: &expiresAt,
+	})
+	return nil
+}
+
+// ChangePassword mengganti password user yang sedang login, semua sesi (termasuk access token) dicabut
+func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return entities.ErrUserNotFound
+	}
+	if !auth.Argon2Verify(currentPassword, user.PasswordHash) {
+		return entities.ErrInvalidCredentials
+	}
+
+	hashedPassword, err := auth.Argon2Hash(newPassword)
+	if err != nil {
+		return err
+	}
+	changed := newDomainEvent(entities.EventPasswordChanged, userID, entities.PasswordChangedPayload{UserID: userID})
+	if err := uc.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.userRepo.UpdatePassword(ctx, userID, hashedPassword)
+	}, changed); err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditPasswordChanged, userID, nil)
+	return uc.revokeUserSessions(ctx, userID)
+}
+
+// Reauthenticate memverifikasi ulang password user yang sedang login dan menerbitkan
+// access token berumur pendek dengan auth_time baru, untuk operasi yang mensyaratkan
+// autentikasi baru-baru ini (e.g. membuka catatan sesi). Refresh token tidak diterbitkan.
+// orgID adalah organisasi aktif sesi (claim org_id token pemanggil), kosong jika tidak ada.
+func (uc *AuthUseCase) Reauthenticate(ctx context.Context, userID, orgID, password string) (string, time.Duration, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return "", 0, entities.ErrUserNotFound
+	}
+	if err := user.StatusError(); err != nil {
+		return "", 0, err
+	}
+	// User yang hanya login lewat identity provider tidak punya password
+	if user.PasswordHash == "" || !auth.Argon2Verify(password, user.PasswordHash) {
+		uc.auditSelf(ctx, entities.AuditReauthenticateFailed, userID, map[string]string{"method": string(entities.AuthMethodPassword)})
+		return "", 0, entities.ErrInvalidCredentials
+	}
+
+	return uc.issueElevatedToken(ctx, user, orgID, entities.NewAuthContext(entities.AuthMethodPassword))
+}
+
+// SendStepUpCode mengirim kode untuk ReauthenticateWithOTP ke channel MFA user (atau email)
+func (uc *AuthUseCase) SendStepUpCode(ctx context.Context, userID string) (string, entities.OTPChannel, error) {
+	if uc.otp == nil {
+		return "", "", entities.ErrInvalidOTPChannel
+	}
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return "", "", entities.ErrUserNotFound
+	}
+	if err := user.StatusError(); err != nil {
+		return "", "", err
+	}
+	channel := mfaChannel(user)
+	destination, err := user.OTPDestination(channel)
+	if err != nil {
+		return "", "", err
+	}
+	challenge := &entities.OTPChallenge{
+		UserID:      user.ID,
+		Purpose:     entities.OTPPurposeStepUp,
+		Channel:     channel,
+		Destination: destination,
+	}
+	if err := uc.otp.Issue(ctx, challenge); err != nil {
+		return "", "", err
+	}
+	uc.auditSelf(ctx, entities.AuditMFAChallengeSent, user.ID, map[string]string{
+		"purpose": string(challenge.Purpose),
+		"channel": string(channel),
+	})
+	return challenge.ID, channel, nil
+}
+
+// ReauthenticateWithOTP seperti Reauthenticate tetapi memakai kode dari SendStepUpCode.
+// User sudah memegang sesi (faktor pertama), sehingga token yang diterbitkan berstatus MFA.
+func (uc *AuthUseCase) ReauthenticateWithOTP(ctx context.Context, userID, orgID, challengeID, code string) (string, time.Duration, error) {
+	if uc.otp == nil {
+		return "", 0, entities.ErrInvalidOTP
+	}
+	challenge, err := uc.otp.Verify(ctx, challengeID, code, entities.OTPPurposeStepUp)
+	if err == nil && challenge.UserID != userID {
+		err = entities.ErrInvalidOTP
+	}
+	if err != nil {
+		uc.auditSelf(ctx, entities.AuditReauthenticateFailed, userID, map[string]string{"method": string(entities.AuthMethodOTP)})
+		return "", 0, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return "", 0, entities.ErrUserNotFound
+	}
+	if err := user.StatusError(); err != nil {
+		return "", 0, err
+	}
+	return uc.issueElevatedToken(ctx, user, orgID, entities.NewAuthContext(challenge.Channel.AuthMethod(), entities.AuthMethodMFA))
+}
+
+// issueElevatedToken mempertahankan organisasi aktif sesi; keanggotaan diperiksa ulang
+// seperti pada rotateSession sehingga member yang dikeluarkan tidak mendapat org_role lagi
+func (uc *AuthUseCase) issueElevatedToken(ctx context.Context, user *entities.User, orgID string, authCtx entities.AuthContext) (string, time.Duration, error) {
+	role, opts, err := uc.tokenOptions(ctx, user)
+	if err != nil {
+		return "", 0, err
+	}
+	opts = append(opts, auth.WithAuthContext(authCtx), auth.WithLifetime(auth.ElevatedTokenExpiry))
+	if orgID != "" {
+		membership, err := uc.membership(ctx, user.ID, orgID)
+		if err != nil {
+			return "", 0, err
+		}
+		if membership == nil {
+			return "", 0, entities.ErrNotOrgMember
+		}
+		opts = append(opts, auth.WithOrganization(orgID, string(membership.Role)))
+	}
+
+	token, err := uc.jwtAuth.GenerateAccessToken(user.ID, string(role), opts...)
+	if err != nil {
+		return "", 0, err
+	}
+	uc.auditSelf(ctx, entities.AuditReauthenticated, user.ID, map[string]string{"acr": authCtx.ACR()})
+	return token, auth.ElevatedTokenExpiry, nil
+}
+
+// IntrospectToken memvalidasi access token untuk service lain
+func (uc *AuthUseCase) IntrospectToken(ctx context.Context, accessToken string) (*auth.CustomClaims, error) {
+	return uc.ValidateAccessToken(ctx, accessToken)
 }
@@ -23,11 +23,34 @@ type MockTokenRepository struct {
 }
 
 // Implementasi TokenRepository
-func (m *MockTokenRepository) StoreToken(ctx context.Context, tokenID, userID string) error {
-	args := m.Called(ctx, tokenID, userID)
+func (m *MockTokenRepository) StoreToken(ctx context.Context, session *entities.Session) error {
+	args := m.Called(ctx, session)
 	return args.Error(0)
 }
 
+// sessionFor mencocokkan record sesi refresh token milik userID
+func sessionFor(userID string) interface{} {
+	return mock.MatchedBy(func(s *entities.Session) bool {
+		return s.TokenID != "" && s.UserID == userID
+	})
+}
+
+func (m *MockTokenRepository) GetSession(ctx context.Context, tokenID string) (*entities.Session, error) {
+	args := m.Called(ctx, tokenID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Session), args.Error(1)
+}
+
+func (m *MockTokenRepository) ListUserSessions(ctx context.Context, userID string) ([]*entities.Session, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Session), args.Error(1)
+}
+
 func (m *MockTokenRepository) IsTokenRevoked(ctx context.Context, tokenID string) bool {
 	args := m.Called(ctx, tokenID)
 	return args.Bool(0)
@@ -43,6 +66,26 @@ func (m *MockTokenRepository) GetUserIDByTokenID(ctx context.Context, tokenID st
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockTokenRepository) RevokeAllUserTokens(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) InvalidateAccessTokens(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) DenyAccessToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	args := m.Called(ctx, tokenID, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockTokenRepository) IsAccessTokenRevoked(ctx context.Context, tokenID, userID string, issuedAt time.Time) (bool, error) {
+	args := m.Called(ctx, tokenID, userID, issuedAt)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockUserRepository) CreateUser(ctx context.Context, user *entities.User) error {
 	args := m.Called(ctx, user)
 	return args.Error(0)
@@ -64,6 +107,54 @@ func (m *MockUserRepository) FindByID(ctx context.Context, id string) (*entities
 	return args.Get(0).(*entities.User), args.Error(1)
 }
 
+func (m *MockUserRepository) SearchUsers(ctx context.Context, filter entities.UserFilter) ([]*entities.User, int, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*entities.User), args.Int(1), args.Error(2)
+}
+
+func (m *MockUserRepository) SetStatus(ctx context.Context, id string, change entities.StatusChange) error {
+	args := m.Called(ctx, id, change)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SetPasswordResetRequired(ctx context.Context, id string, required bool) error {
+	args := m.Called(ctx, id, required)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, id, passwordHash string) error {
+	args := m.Called(ctx, id, passwordHash)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) UpdateRole(ctx context.Context, id string, role entities.Role) error {
+	args := m.Called(ctx, id, role)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SetPhone(ctx context.Context, id, phone string, verifiedAt time.Time) error {
+	args := m.Called(ctx, id, phone, verifiedAt)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, id string, verifiedAt time.Time) error {
+	args := m.Called(ctx, id, verifiedAt)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SetMFAChannel(ctx context.Context, id string, channel entities.OTPChannel) error {
+	args := m.Called(ctx, id, channel)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SetDeletionSchedule(ctx context.Context, id string, at *time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
 func TestAuthUseCase_Register_Success(t *testing.T) {
 	// Setup
 	mockUserRepo := new(MockUserRepository)
@@ -158,9 +249,10 @@ func TestAuthUseCase_RefreshToken_Success(t *testing.T) {
 	// User data
 	userID := "user-123"
 	user := &entities.User{
-		ID:    userID,
-		Email: "test@example.com",
-		Role:  entities.ClientRole,
+		ID:     userID,
+		Email:  "test@example.com",
+		Role:   entities.ClientRole,
+		Status: entities.StatusActive,
 	}
 
 	// Generate valid refresh token
@@ -178,8 +270,15 @@ func TestAuthUseCase_RefreshToken_Success(t *testing.T) {
 	mockUserRepo.On("FindByID", mock.Anything, userID).Return(user, nil)
 	mockTokenRepo.On("RevokeToken", mock.Anything, claims.ID).Return(nil)
 
+	// auth_time login awal harus ikut ke token hasil refresh
+	loggedInAt := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	authCtx := entities.AuthContext{AuthTime: loggedInAt, Methods: []entities.AuthMethod{entities.AuthMethodPassword}}
+	mockTokenRepo.On("GetSession", mock.Anything, claims.ID).Return(&entities.Session{TokenID: claims.ID, UserID: userID, Auth: authCtx}, nil)
+
 	// Gunakan mock.Anything untuk token ID baru karena nilainya acak
-	mockTokenRepo.On("StoreToken", mock.Anything, mock.AnythingOfType("string"), userID).Return(nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.MatchedBy(func(s *entities.Session) bool {
+		return s.UserID == userID && s.Auth.AuthTime.Equal(loggedInAt)
+	})).Return(nil)
 
 	// Execute
 	accessToken, newRefreshToken, err := authUC.RefreshToken(context.Background(), refreshToken)
@@ -189,6 +288,12 @@ func TestAuthUseCase_RefreshToken_Success(t *testing.T) {
 	assert.NotEmpty(t, accessToken)
 	assert.NotEmpty(t, newRefreshToken)
 
+	accessClaims, err := jwtAuth.ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.True(t, accessClaims.AuthTimeValue().Equal(loggedInAt))
+	assert.Equal(t, entities.ACRSingleFactor, accessClaims.ACR)
+	assert.Equal(t, []string{"pwd"}, accessClaims.AMR)
+
 	mockTokenRepo.AssertExpectations(t)
 	mockUserRepo.AssertExpectations(t)
 }
@@ -237,7 +342,8 @@ func TestAuthUseCase_RefreshToken_Revoked(t *testing.T) {
 func TestAuthUseCase_Logout_Success(t *testing.T) {
 	mockUserRepo := new(MockUserRepository)
 	mockTokenRepo := new(MockTokenRepository)
-	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+	mockAudit := new(MockAuditLogger)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithAuditLogger(mockAudit))
 
 	// Generate valid refresh token
 	jwtAuth := auth.NewJWTAuth("test-secret")
@@ -249,12 +355,17 @@ func TestAuthUseCase_Logout_Success(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Mock expectations
+	mockTokenRepo.On("GetUserIDByTokenID", mock.Anything, claims.ID).Return("user-123", nil)
 	mockTokenRepo.On("RevokeToken", mock.Anything, claims.ID).Return(nil)
+	mockAudit.On("Record", mock.Anything, mock.MatchedBy(func(e *entities.AuditEvent) bool {
+		return e.Action == entities.AuditLogout && e.SubjectID == "user-123" && e.ActorID == "user-123"
+	})).Return(nil)
 
 	err = authUC.Logout(context.Background(), refreshToken)
 
 	assert.NoError(t, err)
 	mockTokenRepo.AssertExpectations(t)
+	mockAudit.AssertExpectations(t)
 }
 
 func TestAuthUseCase_Logout_InvalidToken(t *testing.T) {
@@ -303,3 +414,231 @@ func TestAuthUseCase_RefreshToken_UserNotFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, entities.ErrUserNotFound, err)
 }
+
+func TestAuthUseCase_IntrospectToken(t *testing.T) {
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), mockTokenRepo, "test-secret", nil)
+	jwtAuth := auth.NewJWTAuth("test-secret")
+	mockTokenRepo.On("IsAccessTokenRevoked", mock.Anything, mock.AnythingOfType("string"), "user-123", mock.AnythingOfType("time.Time")).Return(false, nil)
+
+	accessToken, refreshToken, err := jwtAuth.GenerateTokens("user-123", string(entities.ClientRole))
+	assert.NoError(t, err)
+
+	claims, err := authUC.IntrospectToken(context.Background(), accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-123", claims.UserID)
+
+	// Refresh token tidak boleh lolos sebagai access token
+	_, err = authUC.IntrospectToken(context.Background(), refreshToken)
+	assert.Equal(t, entities.ErrInvalidToken, err)
+}
+
+func TestAuthUseCase_Register_AdminRoleNotAllowed(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+
+	_, err := authUC.Register(context.Background(), "evil@example.com", "password123", entities.AdminRole)
+
+	assert.Equal(t, entities.ErrInvalidRole, err)
+	mockUserRepo.AssertNotCalled(t, "CreateUser")
+}
+
+func TestAuthUseCase_Login_SuspendedAccount(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{
+		ID:           "user-123",
+		Email:        "user@example.com",
+		PasswordHash: hash,
+		Role:         entities.ClientRole,
+		Status:       entities.StatusSuspended,
+	}
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+
+	_, _, err = authUC.Login(context.Background(), user.Email, "password123")
+
+	assert.Equal(t, entities.ErrAccountSuspended, err)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+}
+
+func TestAuthUseCase_Login_PasswordResetRequired(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{
+		ID:                    "user-123",
+		Email:                 "user@example.com",
+		PasswordHash:          hash,
+		Role:                  entities.ClientRole,
+		Status:                entities.StatusActive,
+		PasswordResetRequired: true,
+	}
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+
+	_, _, err = authUC.Login(context.Background(), user.Email, "password123")
+
+	assert.Equal(t, entities.ErrPasswordResetRequired, err)
+}
+
+func TestAuthUseCase_RefreshToken_SuspendedAccount(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+
+	_, refreshToken, err := auth.NewJWTAuth("test-secret").GenerateTokens("user-123", string(entities.ClientRole))
+	assert.NoError(t, err)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateRefreshToken(refreshToken)
+	assert.NoError(t, err)
+
+	mockTokenRepo.On("GetUserIDByTokenID", mock.Anything, claims.ID).Return("user-123", nil)
+	mockTokenRepo.On("IsTokenRevoked", mock.Anything, claims.ID).Return(false)
+	mockTokenRepo.On("RevokeToken", mock.Anything, claims.ID).Return(nil)
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").
+		Return(&entities.User{ID: "user-123", Role: entities.ClientRole, Status: entities.StatusSuspended}, nil)
+
+	_, _, err = authUC.RefreshToken(context.Background(), refreshToken)
+
+	assert.Equal(t, entities.ErrAccountSuspended, err)
+	mockTokenRepo.AssertCalled(t, "RevokeToken", mock.Anything, claims.ID)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+}
+
+func TestAuthUseCase_ValidateAccessToken_Revoked(t *testing.T) {
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), mockTokenRepo, "test-secret", nil)
+
+	accessToken, err := auth.NewJWTAuth("test-secret").GenerateAccessToken("user-123", string(entities.ClientRole))
+	assert.NoError(t, err)
+	mockTokenRepo.On("IsAccessTokenRevoked", mock.Anything, mock.AnythingOfType("string"), "user-123", mock.MatchedBy(func(iat time.Time) bool {
+		return !iat.IsZero()
+	})).Return(true, nil)
+
+	_, err = authUC.ValidateAccessToken(context.Background(), accessToken)
+
+	assert.Equal(t, entities.ErrInvalidToken, err)
+}
+
+func TestAuthUseCase_ValidateAccessToken_DenylistUnavailable(t *testing.T) {
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), mockTokenRepo, "test-secret", nil)
+
+	accessToken, err := auth.NewJWTAuth("test-secret").GenerateAccessToken("user-123", string(entities.ClientRole))
+	assert.NoError(t, err)
+	redisDown := errors.New("connection refused")
+	mockTokenRepo.On("IsAccessTokenRevoked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, redisDown)
+
+	// Fail closed, tapi bukan ErrInvalidToken agar interceptor bisa membalas Unavailable
+	_, err = authUC.ValidateAccessToken(context.Background(), accessToken)
+
+	assert.Equal(t, redisDown, err)
+}
+
+func TestAuthUseCase_RevokeAccessToken(t *testing.T) {
+	mockTokenRepo := new(MockTokenRepository)
+	mockPublisher := new(MockRevocationPublisher)
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), mockTokenRepo, "test-secret", nil, usecases.WithRevocationPublisher(mockPublisher))
+
+	accessToken, err := auth.NewJWTAuth("test-secret").GenerateAccessToken("user-123", string(entities.ClientRole))
+	assert.NoError(t, err)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	assert.NoError(t, err)
+	mockTokenRepo.On("DenyAccessToken", mock.Anything, claims.ID, claims.ExpiresAt.Time).Return(nil)
+	mockPublisher.On("PublishRevocation", mock.Anything, mock.MatchedBy(func(e *entities.RevocationEvent) bool {
+		return e.Type == entities.RevocationUserLogout && e.TokenID == claims.ID && e.ExpiresAt != nil
+	})).Return(errors.New("redis down"))
+
+	// Gagal publish tidak menggagalkan logout
+	err = authUC.RevokeAccessToken(context.Background(), claims)
+
+	assert.NoError(t, err)
+	mockTokenRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestAuthUseCase_ChangePassword(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", PasswordHash: hash, Status: entities.StatusActive}, nil)
+	mockUserRepo.On("UpdatePassword", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, "user-123").Return(nil)
+
+	assert.Equal(t, entities.ErrInvalidCredentials, authUC.ChangePassword(context.Background(), "user-123", "wrong", "new-password123"))
+	mockUserRepo.AssertNotCalled(t, "UpdatePassword")
+
+	assert.NoError(t, authUC.ChangePassword(context.Background(), "user-123", "password123", "new-password123"))
+	mockTokenRepo.AssertExpectations(t)
+}
+
+func TestAuthUseCase_RefreshToken_RecordsClientMetadata(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+
+	jwtAuth := auth.NewJWTAuth("test-secret")
+	refreshToken, err := jwtAuth.GenerateRefreshToken()
+	assert.NoError(t, err)
+	claims, err := jwtAuth.ValidateRefreshToken(refreshToken)
+	assert.NoError(t, err)
+
+	mockTokenRepo.On("GetUserIDByTokenID", mock.Anything, claims.ID).Return("user-123", nil)
+	mockTokenRepo.On("IsTokenRevoked", mock.Anything, claims.ID).Return(false)
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole, Status: entities.StatusActive}, nil)
+	mockTokenRepo.On("RevokeToken", mock.Anything, claims.ID).Return(nil)
+	mockTokenRepo.On("GetSession", mock.Anything, claims.ID).Return(nil, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.MatchedBy(func(s *entities.Session) bool {
+		return s.UserID == "user-123" && s.IP == "203.0.113.7" && s.DeviceID == "device-1" && s.AppVersion == "2.4.0"
+	})).Return(nil)
+
+	ctx := entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{
+		IP:         "203.0.113.7",
+		AppVersion: "2.4.0",
+		DeviceID:   "device-1",
+	})
+	_, _, err = authUC.RefreshToken(ctx, refreshToken)
+
+	assert.NoError(t, err)
+	mockTokenRepo.AssertExpectations(t)
+}
+
+func TestAuthUseCase_Reauthenticate_IssuesElevatedToken(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", PasswordHash: hash, Role: entities.PsychologistRole, Status: entities.StatusActive}, nil)
+
+	token, expiresIn, err := authUC.Reauthenticate(context.Background(), "user-123", "", "password123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, auth.ElevatedTokenExpiry, expiresIn)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(token)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), claims.AuthTimeValue(), 5*time.Second)
+	assert.WithinDuration(t, time.Now().Add(auth.ElevatedTokenExpiry), claims.ExpiresAt.Time, 5*time.Second)
+	assert.Equal(t, []string{"pwd"}, claims.AMR)
+}
+
+func TestAuthUseCase_Reauthenticate_WrongPassword(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive}, nil)
+
+	_, _, err = authUC.Reauthenticate(context.Background(), "user-123", "", "wrong-password")
+
+	assert.Equal(t, entities.ErrInvalidCredentials, err)
+}
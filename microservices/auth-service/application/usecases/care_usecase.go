@@ -0,0 +1,263 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/infrastructure/auth"
+	"strings"
+	"time"
+)
+
+// maxEndReasonLength : alasan mengakhiri hubungan hanya catatan singkat, bukan konten klinis
+const maxEndReasonLength = 500
+
+// CareUseCase mengelola hubungan klien - psikolog dan menjawab pertanyaan service lain
+// "boleh tidak user X mengakses data milik user Y" (CheckAccess).
+type CareUseCase struct {
+	authUC *AuthUseCase
+	repo   repositories.CareRelationshipRepository
+}
+
+func NewCareUseCase(authUC *AuthUseCase, repo repositories.CareRelationshipRepository) *CareUseCase {
+	return &CareUseCase{authUC: authUC, repo: repo}
+}
+
+// RequestCare dimulai klien (counterparty psikolog) atau psikolog terverifikasi (counterparty klien).
+// Persetujuan peminta langsung tercatat, hubungan aktif setelah pihak lain menerima.
+func (uc *CareUseCase) RequestCare(ctx context.Context, actorID, counterpartyID string) (*entities.CareRelationship, error) {
+	if actorID == counterpartyID {
+		return nil, entities.ErrInvalidCareParty
+	}
+	actor, err := uc.findUser(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	counterparty, err := uc.findUser(ctx, counterpartyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	rel := &entities.CareRelationship{
+		ID:          auth.GenerateUUID(),
+		Status:      entities.CareRequested,
+		RequestedBy: actorID,
+		CreatedAt:   now,
+	}
+	switch {
+	case actor.Role == entities.ClientRole && counterparty.HasRole(entities.PsychologistRole):
+		rel.ClientID, rel.PsychologistID = actor.ID, counterparty.ID
+		rel.ClientConsentedAt = &now
+	case actor.HasRole(entities.PsychologistRole) && counterparty.Role == entities.ClientRole:
+		rel.ClientID, rel.PsychologistID = counterparty.ID, actor.ID
+		rel.PsychologistConsentedAt = &now
+	default:
+		return nil, entities.ErrInvalidCareParty
+	}
+	if err := uc.requireVerifiedPsychologist(ctx, rel.PsychologistID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.repo.FindOpen(ctx, rel.ClientID, rel.PsychologistID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, entities.ErrCareRelationshipExists
+	}
+	if err := uc.repo.CreateCareRelationship(ctx, rel); err != nil {
+		return nil, err
+	}
+	uc.audit(ctx, entities.AuditCareRequested, rel, nil)
+	return rel, nil
+}
+
+// AcceptCare : hanya pihak yang diminta (bukan peminta) yang bisa menerima
+func (uc *CareUseCase) AcceptCare(ctx context.Context, actorID, relationshipID string) (*entities.CareRelationship, error) {
+	rel, err := uc.find(ctx, actorID, relationshipID)
+	if err != nil {
+		return nil, err
+	}
+	if rel.Status != entities.CareRequested || rel.RequestedBy == actorID {
+		return nil, entities.ErrInvalidStatusTransition
+	}
+	if err := uc.requireVerifiedPsychologist(ctx, rel.PsychologistID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if actorID == rel.ClientID {
+		rel.ClientConsentedAt = &now
+	} else {
+		rel.PsychologistConsentedAt = &now
+	}
+	rel.Status = entities.CareActive
+	linked := newDomainEvent(entities.EventCareLinked, rel.ClientID, entities.CareLinkedPayload{
+		RelationshipID: rel.ID,
+		ClientID:       rel.ClientID,
+		PsychologistID: rel.PsychologistID,
+	})
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.repo.UpdateCareRelationship(ctx, rel, entities.CareRequested)
+	}, linked); err != nil {
+		return nil, err
+	}
+	uc.audit(ctx, entities.AuditCareAccepted, rel, nil)
+	return rel, nil
+}
+
+// EndCare : klien atau psikolog bisa mengakhiri hubungan kapan saja, termasuk menolak
+// atau membatalkan permintaan. CheckAccess langsung menolak, tetapi service lain yang meng-cache
+// hasilnya baru berhenti setelah cache_ttl_seconds atau setelah menerima event care.ended.
+func (uc *CareUseCase) EndCare(ctx context.Context, actorID, relationshipID, reason string) (*entities.CareRelationship, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxEndReasonLength {
+		reason = reason[:maxEndReasonLength]
+	}
+	rel, err := uc.find(ctx, actorID, relationshipID)
+	if err != nil {
+		return nil, err
+	}
+	if rel.Status == entities.CareEnded {
+		return nil, entities.ErrInvalidStatusTransition
+	}
+
+	from := rel.Status
+	now := time.Now().UTC()
+	rel.Status = entities.CareEnded
+	rel.EndedAt = &now
+	rel.EndedBy = actorID
+	rel.EndReason = reason
+
+	var events []*entities.DomainEvent
+	if from == entities.CareActive {
+		events = append(events, newDomainEvent(entities.EventCareEnded, rel.ClientID, entities.CareLinkedPayload{
+			RelationshipID: rel.ID,
+			ClientID:       rel.ClientID,
+			PsychologistID: rel.PsychologistID,
+		}))
+	}
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.repo.UpdateCareRelationship(ctx, rel, from)
+	}, events...); err != nil {
+		return nil, err
+	}
+	uc.audit(ctx, entities.AuditCareEnded, rel, map[string]string{"from": string(from)})
+	return rel, nil
+}
+
+// ListCareRelationships mengembalikan hubungan user sebagai klien maupun psikolog
+func (uc *CareUseCase) ListCareRelationships(ctx context.Context, userID string) ([]*entities.CareRelationship, error) {
+	return uc.repo.ListByUser(ctx, userID)
+}
+
+// CheckAccess memutuskan apakah subject boleh melakukan action terhadap data milik resourceOwner:
+// pemilik sendiri, psikolog dengan care relationship aktif (profil dan data klinis), atau wali
+// akun anak yang masih di bawah umur (profil dan pengelolaan akun). Selain itu ditolak.
+func (uc *CareUseCase) CheckAccess(ctx context.Context, subjectID, resourceOwnerID string, action entities.AccessAction) (*entities.AccessDecision, error) {
+	if !action.IsValid() {
+		return nil, entities.ErrInvalidAccessAction
+	}
+	if subjectID == "" || resourceOwnerID == "" {
+		return &entities.AccessDecision{}, nil
+	}
+	if subjectID == resourceOwnerID {
+		return &entities.AccessDecision{Allowed: true, Grant: entities.GrantSelf}, nil
+	}
+
+	if entities.CareAllows(action) {
+		rel, err := uc.repo.FindOpen(ctx, resourceOwnerID, subjectID)
+		if err != nil {
+			return nil, err
+		}
+		if rel != nil && rel.Status == entities.CareActive {
+			// Akun yang dinonaktifkan, role psikolog yang dicabut, atau lisensi yang kedaluwarsa
+			// ikut menghentikan akses
+			allowed, err := uc.isPracticingPsychologist(ctx, subjectID)
+			if err != nil {
+				return nil, err
+			}
+			if allowed {
+				return &entities.AccessDecision{Allowed: true, Grant: entities.GrantCare, RelationshipID: rel.ID}, nil
+			}
+		}
+	}
+
+	if entities.GuardianshipAllows(action) && uc.authUC.guardians != nil {
+		g, err := uc.authUC.guardians.FindActive(ctx, subjectID, resourceOwnerID)
+		if err != nil {
+			return nil, err
+		}
+		if g != nil {
+			owner, err := uc.authUC.userRepo.FindByID(ctx, resourceOwnerID)
+			if err != nil {
+				return nil, err
+			}
+			if owner != nil && owner.IsMinorAt(time.Now()) {
+				return &entities.AccessDecision{Allowed: true, Grant: entities.GrantGuardianship}, nil
+			}
+		}
+	}
+	return &entities.AccessDecision{}, nil
+}
+
+// find mengembalikan ErrCareRelationshipNotFound juga untuk hubungan milik orang lain
+func (uc *CareUseCase) find(ctx context.Context, actorID, relationshipID string) (*entities.CareRelationship, error) {
+	rel, err := uc.repo.FindCareRelationship(ctx, relationshipID)
+	if err != nil {
+		return nil, err
+	}
+	if rel == nil || !rel.IsParty(actorID) {
+		return nil, entities.ErrCareRelationshipNotFound
+	}
+	return rel, nil
+}
+
+func (uc *CareUseCase) findUser(ctx context.Context, userID string) (*entities.User, error) {
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.StatusError() != nil {
+		return nil, entities.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// isPracticingPsychologist : akun aktif, masih memegang role psikolog dan lisensinya valid
+func (uc *CareUseCase) isPracticingPsychologist(ctx context.Context, userID string) (bool, error) {
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil || user.StatusError() != nil || !user.HasRole(entities.PsychologistRole) {
+		return false, nil
+	}
+	return uc.authUC.isVerifiedPsychologist(ctx, userID)
+}
+
+func (uc *CareUseCase) requireVerifiedPsychologist(ctx context.Context, userID string) error {
+	verified, err := uc.authUC.isVerifiedPsychologist(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return entities.ErrNotPsychologist
+	}
+	return nil
+}
+
+// audit : subject adalah klien, psikolog dicatat di metadata
+func (uc *CareUseCase) audit(ctx context.Context, action entities.AuditAction, rel *entities.CareRelationship, metadata map[string]string) {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadata["relationship_id"] = rel.ID
+	metadata["psychologist_id"] = rel.PsychologistID
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    action,
+		SubjectID: rel.ClientID,
+		Metadata:  metadata,
+	})
+}
@@ -0,0 +1,260 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+)
+
+// memoryCareRepository menyimpan care relationship di memori dengan aturan satu hubungan terbuka per pasangan
+type memoryCareRepository struct {
+	relationships map[string]*entities.CareRelationship
+}
+
+func newMemoryCareRepository() *memoryCareRepository {
+	return &memoryCareRepository{relationships: make(map[string]*entities.CareRelationship)}
+}
+
+func (r *memoryCareRepository) CreateCareRelationship(ctx context.Context, rel *entities.CareRelationship) error {
+	if open, _ := r.FindOpen(ctx, rel.ClientID, rel.PsychologistID); open != nil {
+		return entities.ErrCareRelationshipExists
+	}
+	copied := *rel
+	r.relationships[rel.ID] = &copied
+	return nil
+}
+
+func (r *memoryCareRepository) FindCareRelationship(ctx context.Context, id string) (*entities.CareRelationship, error) {
+	rel, ok := r.relationships[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *rel
+	return &copied, nil
+}
+
+func (r *memoryCareRepository) FindOpen(ctx context.Context, clientID, psychologistID string) (*entities.CareRelationship, error) {
+	for _, rel := range r.relationships {
+		if rel.ClientID == clientID && rel.PsychologistID == psychologistID && rel.Status != entities.CareEnded {
+			copied := *rel
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryCareRepository) ListByUser(ctx context.Context, userID string) ([]*entities.CareRelationship, error) {
+	var result []*entities.CareRelationship
+	for _, rel := range r.relationships {
+		if rel.IsParty(userID) {
+			copied := *rel
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryCareRepository) UpdateCareRelationship(ctx context.Context, rel *entities.CareRelationship, from entities.CareStatus) error {
+	stored, ok := r.relationships[rel.ID]
+	if !ok || stored.Status != from {
+		return entities.ErrCareRelationshipNotFound
+	}
+	copied := *rel
+	r.relationships[rel.ID] = &copied
+	return nil
+}
+
+func TestCareUseCase_RequestAcceptEnd(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryCareRepository()
+	uc := usecases.NewCareUseCase(usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil), repo)
+	ctx := context.Background()
+
+	psychologist := &entities.User{ID: "psy-1", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	client := &entities.User{ID: "client-1", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, psychologist.ID).Return(psychologist, nil)
+	mockUserRepo.On("FindByID", mock.Anything, client.ID).Return(client, nil)
+
+	// 1. Psikolog meminta, belum ada akses sebelum klien menyetujui
+	rel, err := uc.RequestCare(ctx, psychologist.ID, client.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.CareRequested, rel.Status)
+	assert.NotNil(t, rel.PsychologistConsentedAt)
+	assert.Nil(t, rel.ClientConsentedAt)
+
+	_, err = uc.RequestCare(ctx, client.ID, psychologist.ID)
+	assert.Equal(t, entities.ErrCareRelationshipExists, err)
+
+	decision, err := uc.CheckAccess(ctx, psychologist.ID, client.ID, entities.AccessClinicalRead)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+
+	// Peminta tidak bisa menerima permintaannya sendiri
+	_, err = uc.AcceptCare(ctx, psychologist.ID, rel.ID)
+	assert.Equal(t, entities.ErrInvalidStatusTransition, err)
+
+	// 2. Klien menerima, psikolog boleh membaca data klinis tetapi tidak mengelola akun
+	rel, err = uc.AcceptCare(ctx, client.ID, rel.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.CareActive, rel.Status)
+	assert.NotNil(t, rel.ClientConsentedAt)
+
+	decision, err = uc.CheckAccess(ctx, psychologist.ID, client.ID, entities.AccessClinicalWrite)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, entities.GrantCare, decision.Grant)
+	assert.Equal(t, rel.ID, decision.RelationshipID)
+
+	decision, err = uc.CheckAccess(ctx, psychologist.ID, client.ID, entities.AccessAccountManage)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+
+	// Hubungan tidak berlaku ke arah sebaliknya
+	decision, err = uc.CheckAccess(ctx, client.ID, psychologist.ID, entities.AccessClinicalRead)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+
+	// 3. Klien mengakhiri, akses langsung berhenti
+	rel, err = uc.EndCare(ctx, client.ID, rel.ID, "  moved abroad ")
+	require.NoError(t, err)
+	assert.Equal(t, entities.CareEnded, rel.Status)
+	assert.Equal(t, client.ID, rel.EndedBy)
+	assert.Equal(t, "moved abroad", rel.EndReason)
+
+	decision, err = uc.CheckAccess(ctx, psychologist.ID, client.ID, entities.AccessClinicalRead)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+
+	_, err = uc.EndCare(ctx, psychologist.ID, rel.ID, "")
+	assert.Equal(t, entities.ErrInvalidStatusTransition, err)
+
+	// Setelah berakhir pasangan yang sama boleh memulai lagi
+	_, err = uc.RequestCare(ctx, client.ID, psychologist.ID)
+	assert.NoError(t, err)
+
+	rels, err := uc.ListCareRelationships(ctx, client.ID)
+	require.NoError(t, err)
+	assert.Len(t, rels, 2)
+}
+
+func TestCareUseCase_RequestCare_InvalidParties(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	repo := newMemoryCareRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithCredentialRepository(mockCredRepo),
+	)
+	uc := usecases.NewCareUseCase(authUC, repo)
+	ctx := context.Background()
+
+	mockUserRepo.On("FindByID", mock.Anything, "client-1").Return(&entities.User{ID: "client-1", Role: entities.ClientRole, Status: entities.StatusActive}, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "client-2").Return(&entities.User{ID: "client-2", Role: entities.ClientRole, Status: entities.StatusActive}, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Role: entities.PsychologistRole, Status: entities.StatusActive}, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "gone").Return(&entities.User{ID: "gone", Role: entities.ClientRole, Status: entities.StatusDeleted}, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, "psy-1").Return([]*entities.PsychologistCredential{}, nil)
+
+	_, err := uc.RequestCare(ctx, "client-1", "client-2")
+	assert.Equal(t, entities.ErrInvalidCareParty, err)
+	_, err = uc.RequestCare(ctx, "client-1", "client-1")
+	assert.Equal(t, entities.ErrInvalidCareParty, err)
+	_, err = uc.RequestCare(ctx, "psy-1", "gone")
+	assert.Equal(t, entities.ErrUserNotFound, err)
+
+	// Psikolog tanpa lisensi terverifikasi
+	_, err = uc.RequestCare(ctx, "client-1", "psy-1")
+	assert.Equal(t, entities.ErrNotPsychologist, err)
+	assert.Empty(t, repo.relationships)
+}
+
+func TestCareUseCase_OnlyPartiesManageRelationship(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryCareRepository()
+	uc := usecases.NewCareUseCase(usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil), repo)
+	ctx := context.Background()
+
+	repo.relationships["rel-1"] = &entities.CareRelationship{
+		ID: "rel-1", ClientID: "client-1", PsychologistID: "psy-1", Status: entities.CareRequested, RequestedBy: "client-1",
+	}
+
+	_, err := uc.AcceptCare(ctx, "psy-2", "rel-1")
+	assert.Equal(t, entities.ErrCareRelationshipNotFound, err)
+	_, err = uc.EndCare(ctx, "psy-2", "rel-1", "")
+	assert.Equal(t, entities.ErrCareRelationshipNotFound, err)
+	assert.Equal(t, entities.CareRequested, repo.relationships["rel-1"].Status)
+}
+
+func TestCareUseCase_CheckAccess(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	guardians := newMemoryGuardianRepository()
+	repo := newMemoryCareRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithCredentialRepository(mockCredRepo),
+		usecases.WithGuardianRepository(guardians),
+	)
+	uc := usecases.NewCareUseCase(authUC, repo)
+	ctx := context.Background()
+
+	now := time.Now()
+	dob := yearsAgo(13)
+	mockUserRepo.On("FindByID", mock.Anything, "kid-1").Return(&entities.User{ID: "kid-1", Role: entities.ClientRole, Status: entities.StatusActive, DateOfBirth: &dob}, nil)
+	guardians.guardianships["g-1"] = &entities.Guardianship{ID: "g-1", MinorID: "kid-1", GuardianID: "parent-1", Status: entities.GuardianshipActive}
+	repo.relationships["rel-1"] = &entities.CareRelationship{
+		ID: "rel-1", ClientID: "kid-1", PsychologistID: "psy-1", Status: entities.CareActive,
+		ClientConsentedAt: &now, PsychologistConsentedAt: &now,
+	}
+	expired := now.Add(-time.Hour)
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Role: entities.PsychologistRole, Status: entities.StatusActive}, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, "psy-1").Return([]*entities.PsychologistCredential{
+		{ID: "cred-1", UserID: "psy-1", Status: entities.CredentialVerified, ExpiresAt: &expired},
+	}, nil)
+	// Lisensi valid, tetapi akun dibekukan atau role psikolog sudah dicabut
+	mockUserRepo.On("FindByID", mock.Anything, "psy-2").Return(&entities.User{ID: "psy-2", Role: entities.PsychologistRole, Status: entities.StatusActive}, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "psy-3").Return(&entities.User{ID: "psy-3", Role: entities.PsychologistRole, Status: entities.StatusSuspended}, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "psy-4").Return(&entities.User{ID: "psy-4", Role: entities.ClientRole, Roles: []entities.Role{entities.ClientRole}, Status: entities.StatusActive}, nil)
+	for _, id := range []string{"psy-2", "psy-3", "psy-4"} {
+		repo.relationships["rel-"+id] = &entities.CareRelationship{
+			ID: "rel-" + id, ClientID: "kid-1", PsychologistID: id, Status: entities.CareActive,
+			ClientConsentedAt: &now, PsychologistConsentedAt: &now,
+		}
+		mockCredRepo.On("ListByUserID", mock.Anything, id).Return([]*entities.PsychologistCredential{
+			{ID: "cred-" + id, UserID: id, Status: entities.CredentialVerified},
+		}, nil)
+	}
+
+	tests := []struct {
+		name    string
+		subject string
+		action  entities.AccessAction
+		allowed bool
+		grant   entities.AccessGrant
+	}{
+		{"self", "kid-1", entities.AccessClinicalRead, true, entities.GrantSelf},
+		{"guardian manages account", "parent-1", entities.AccessAccountManage, true, entities.GrantGuardianship},
+		{"guardian reads profile", "parent-1", entities.AccessProfileRead, true, entities.GrantGuardianship},
+		{"guardian cannot read clinical data", "parent-1", entities.AccessClinicalRead, false, entities.GrantNone},
+		{"psychologist with expired license", "psy-1", entities.AccessClinicalRead, false, entities.GrantNone},
+		{"treating psychologist", "psy-2", entities.AccessClinicalWrite, true, entities.GrantCare},
+		{"suspended psychologist", "psy-3", entities.AccessClinicalRead, false, entities.GrantNone},
+		{"demoted psychologist", "psy-4", entities.AccessClinicalRead, false, entities.GrantNone},
+		{"stranger", "someone", entities.AccessProfileRead, false, entities.GrantNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := uc.CheckAccess(ctx, tt.subject, "kid-1", tt.action)
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.Allowed)
+			assert.Equal(t, tt.grant, decision.Grant)
+		})
+	}
+
+	_, err := uc.CheckAccess(ctx, "parent-1", "kid-1", "clinical:delete")
+	assert.Equal(t, entities.ErrInvalidAccessAction, err)
+}
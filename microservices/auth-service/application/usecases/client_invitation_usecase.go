@@ -0,0 +1,214 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	clientInvitationDefaultTTL = 14 * 24 * time.Hour
+	clientInvitationMaxTTL     = 30 * 24 * time.Hour
+	clientInvitationMinTTL     = time.Hour
+)
+
+// ClientInvitationUseCase : psikolog terverifikasi mengundang klien lewat email. Emailnya dianggap
+// terverifikasi karena token hanya dikirim ke email undangan; hubungan dengan psikolog tetap
+// menunggu persetujuan klien setelah login.
+type ClientInvitationUseCase struct {
+	authUC      *AuthUseCase
+	repo        repositories.ClientInvitationRepository
+	careRepo    repositories.CareRelationshipRepository
+	notifier    services.Notifier
+	registerURL string // halaman registrasi, token ditambahkan sebagai query "token"
+}
+
+func NewClientInvitationUseCase(authUC *AuthUseCase, repo repositories.ClientInvitationRepository, careRepo repositories.CareRelationshipRepository, notifier services.Notifier, registerURL string) *ClientInvitationUseCase {
+	return &ClientInvitationUseCase{
+		authUC:      authUC,
+		repo:        repo,
+		careRepo:    careRepo,
+		notifier:    notifier,
+		registerURL: registerURL,
+	}
+}
+
+// CreateInvitation membuat undangan dan mengirim link registrasi ke email klien.
+// expiresAt zero berarti default 14 hari; preLinkCare membuat permintaan care relationship saat klien
+// mendaftar. Token tidak dikembalikan ke psikolog.
+func (uc *ClientInvitationUseCase) CreateInvitation(ctx context.Context, psychologistID, email string, expiresAt time.Time, preLinkCare bool) (*entities.ClientInvitation, error) {
+	psychologist, err := uc.requireVerifiedPsychologist(ctx, psychologistID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(clientInvitationDefaultTTL)
+	}
+	expiresAt = expiresAt.UTC()
+	if expiresAt.Before(now.Add(clientInvitationMinTTL)) || expiresAt.After(now.Add(clientInvitationMaxTTL)) {
+		return nil, entities.ErrInvalidInvitationExpiry
+	}
+
+	email = strings.TrimSpace(email)
+	if existing, _ := uc.authUC.userRepo.FindByEmail(ctx, email); existing != nil {
+		return nil, entities.ErrEmailExists
+	}
+
+	inv := &entities.ClientInvitation{
+		ID:             auth.GenerateUUID(),
+		PsychologistID: psychologistID,
+		Email:          email,
+		PreLinkCare:    preLinkCare,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+	}
+	token, err := uc.authUC.jwtAuth.GenerateInvitationToken(inv.ID, inv.Email, inv.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.CreateInvitation(ctx, inv); err != nil {
+		return nil, err
+	}
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    entities.AuditClientInvited,
+		SubjectID: psychologistID,
+		Metadata: map[string]string{
+			"invitation_id": inv.ID,
+			"email":         inv.Email,
+			"pre_link_care": strconv.FormatBool(preLinkCare),
+		},
+	})
+	uc.sendInvitation(ctx, psychologist, inv, token)
+	return inv, nil
+}
+
+// sendInvitation : undangan tetap tersimpan walau antrean gagal; psikolog bisa membatalkan dan mengundang ulang
+func (uc *ClientInvitationUseCase) sendInvitation(ctx context.Context, psychologist *entities.User, inv *entities.ClientInvitation, token string) {
+	if uc.notifier == nil {
+		return
+	}
+	days := int(time.Until(inv.ExpiresAt).Hours()/24 + 0.5)
+	if days < 1 {
+		days = 1
+	}
+	if err := uc.notifier.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationClientInvitation,
+		Recipient: inv.Email,
+		Data: map[string]string{
+			"link":            linkWithToken(uc.registerURL, token),
+			"invited_by":      psychologist.Email,
+			"expires_in_days": strconv.Itoa(days),
+		},
+	}); err != nil {
+		zap.L().Warn("failed to queue client invitation", zap.String("invitation_id", inv.ID), zap.Error(err))
+	}
+}
+
+// ListInvitations mengembalikan undangan milik psikolog, terbaru lebih dulu
+func (uc *ClientInvitationUseCase) ListInvitations(ctx context.Context, psychologistID string) ([]*entities.ClientInvitation, error) {
+	return uc.repo.ListByPsychologist(ctx, psychologistID)
+}
+
+// RevokeInvitation membatalkan undangan yang belum dipakai; token yang sudah terkirim tidak berlaku lagi
+func (uc *ClientInvitationUseCase) RevokeInvitation(ctx context.Context, psychologistID, invitationID string) error {
+	if err := uc.repo.RevokeInvitation(ctx, invitationID, psychologistID, time.Now().UTC()); err != nil {
+		return err
+	}
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    entities.AuditClientInviteRevoked,
+		SubjectID: psychologistID,
+		Metadata:  map[string]string{"invitation_id": invitationID},
+	})
+	return nil
+}
+
+// Register mendaftarkan klien dengan token undangan. Email harus sama dengan email yang diundang;
+// akun langsung aktif dengan email terverifikasi. Mendaftar bukan persetujuan care: dengan preLinkCare
+// hubungan dibuat sebagai permintaan psikolog yang baru aktif setelah klien menerimanya (AcceptCare).
+func (uc *ClientInvitationUseCase) Register(ctx context.Context, token, email, password string) (*entities.User, error) {
+	claims, err := uc.authUC.jwtAuth.ValidateInvitationToken(token)
+	if err != nil {
+		return nil, entities.ErrInvalidToken
+	}
+	inv, err := uc.repo.FindInvitation(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if inv == nil || !inv.IsUsableAt(now) {
+		return nil, entities.ErrInvalidToken
+	}
+	if !strings.EqualFold(strings.TrimSpace(email), inv.Email) {
+		return nil, entities.ErrInvitationEmailMismatch
+	}
+
+	user, err := uc.authUC.newUser(ctx, inv.Email, password, entities.ClientRole)
+	if err != nil {
+		return nil, err
+	}
+	// Link undangan diterima di email yang diundang, jadi verifikasi email tidak perlu diulang
+	user.EmailVerifiedAt = &now
+	user.Status = entities.StatusActive
+
+	var care *entities.CareRelationship
+	if inv.PreLinkCare {
+		care = &entities.CareRelationship{
+			ID:             auth.GenerateUUID(),
+			ClientID:       user.ID,
+			PsychologistID: inv.PsychologistID,
+			Status:         entities.CareRequested,
+			RequestedBy:    inv.PsychologistID,
+			CreatedAt:      now,
+			// Psikolog menyetujui saat membuat undangan; klien belum, token bisa dipakai siapa pun yang membaca emailnya
+			PsychologistConsentedAt: &inv.CreatedAt,
+		}
+	}
+	if err := uc.authUC.createUser(ctx, user, func(ctx context.Context) error {
+		if err := uc.repo.AcceptInvitation(ctx, inv.ID, user.ID, now); err != nil {
+			return err
+		}
+		if care != nil {
+			return uc.careRepo.CreateCareRelationship(ctx, care)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditClientInviteAccepted, user.ID, map[string]string{
+		"invitation_id":   inv.ID,
+		"psychologist_id": inv.PsychologistID,
+		"care_requested":  strconv.FormatBool(care != nil),
+	})
+	return user, nil
+}
+
+// requireVerifiedPsychologist : psikolog dengan lisensi yang belum diverifikasi belum boleh mengundang klien
+func (uc *ClientInvitationUseCase) requireVerifiedPsychologist(ctx context.Context, userID string) (*entities.User, error) {
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	if !user.HasRole(entities.PsychologistRole) {
+		return nil, entities.ErrNotPsychologist
+	}
+	verified, err := uc.authUC.isVerifiedPsychologist(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, entities.ErrNotPsychologist
+	}
+	return user, nil
+}
@@ -0,0 +1,207 @@
+package usecases_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// memoryClientInvitationRepository menyimpan undangan klien di memori
+type memoryClientInvitationRepository struct {
+	invitations map[string]*entities.ClientInvitation
+}
+
+func newMemoryClientInvitationRepository() *memoryClientInvitationRepository {
+	return &memoryClientInvitationRepository{invitations: make(map[string]*entities.ClientInvitation)}
+}
+
+func (r *memoryClientInvitationRepository) CreateInvitation(ctx context.Context, inv *entities.ClientInvitation) error {
+	copied := *inv
+	r.invitations[inv.ID] = &copied
+	return nil
+}
+
+func (r *memoryClientInvitationRepository) FindInvitation(ctx context.Context, id string) (*entities.ClientInvitation, error) {
+	inv, ok := r.invitations[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *inv
+	return &copied, nil
+}
+
+func (r *memoryClientInvitationRepository) ListByPsychologist(ctx context.Context, psychologistID string) ([]*entities.ClientInvitation, error) {
+	var result []*entities.ClientInvitation
+	for _, inv := range r.invitations {
+		if inv.PsychologistID == psychologistID {
+			copied := *inv
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryClientInvitationRepository) AcceptInvitation(ctx context.Context, id, clientID string, acceptedAt time.Time) error {
+	inv, ok := r.invitations[id]
+	if !ok || !inv.IsUsableAt(acceptedAt) {
+		return entities.ErrInvalidToken
+	}
+	inv.AcceptedAt = &acceptedAt
+	inv.ClientID = clientID
+	return nil
+}
+
+func (r *memoryClientInvitationRepository) RevokeInvitation(ctx context.Context, id, psychologistID string, revokedAt time.Time) error {
+	inv, ok := r.invitations[id]
+	if !ok || inv.PsychologistID != psychologistID || inv.AcceptedAt != nil || inv.RevokedAt != nil {
+		return entities.ErrInvitationNotFound
+	}
+	inv.RevokedAt = &revokedAt
+	return nil
+}
+
+func newClientInvitationUseCase(mockUserRepo *MockUserRepository, repo *memoryClientInvitationRepository, careRepo *memoryCareRepository, notifier *MockNotifier, opts ...usecases.Option) *usecases.ClientInvitationUseCase {
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil, opts...)
+	return usecases.NewClientInvitationUseCase(authUC, repo, careRepo, notifier, "https://app.example.com/register")
+}
+
+func TestClientInvitationUseCase_CreateInvitation_RequiresVerifiedPsychologist(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	repo := newMemoryClientInvitationRepository()
+	careRepo := newMemoryCareRepository()
+	uc := newClientInvitationUseCase(mockUserRepo, repo, careRepo, new(MockNotifier), usecases.WithCredentialRepository(mockCredRepo))
+	ctx := context.Background()
+
+	mockUserRepo.On("FindByID", mock.Anything, "client-1").Return(&entities.User{ID: "client-1", Role: entities.ClientRole}, nil)
+	_, err := uc.CreateInvitation(ctx, "client-1", "new@example.com", time.Time{}, false)
+	assert.Equal(t, entities.ErrNotPsychologist, err)
+
+	// Psikolog yang lisensinya belum diverifikasi
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Role: entities.PsychologistRole}, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, "psy-1").Return([]*entities.PsychologistCredential{
+		{ID: "cred-1", UserID: "psy-1", Status: entities.CredentialPending},
+	}, nil)
+	_, err = uc.CreateInvitation(ctx, "psy-1", "new@example.com", time.Time{}, false)
+	assert.Equal(t, entities.ErrNotPsychologist, err)
+	assert.Empty(t, repo.invitations)
+}
+
+func TestClientInvitationUseCase_CreateInvitation_Validation(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryClientInvitationRepository()
+	careRepo := newMemoryCareRepository()
+	uc := newClientInvitationUseCase(mockUserRepo, repo, careRepo, new(MockNotifier))
+	ctx := context.Background()
+
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Email: "psy@example.com", Role: entities.PsychologistRole}, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "taken@example.com").Return(&entities.User{ID: "client-1"}, nil)
+
+	_, err := uc.CreateInvitation(ctx, "psy-1", "new@example.com", time.Now().Add(31*24*time.Hour), false)
+	assert.Equal(t, entities.ErrInvalidInvitationExpiry, err)
+	_, err = uc.CreateInvitation(ctx, "psy-1", "new@example.com", time.Now().Add(time.Minute), false)
+	assert.Equal(t, entities.ErrInvalidInvitationExpiry, err)
+	_, err = uc.CreateInvitation(ctx, "psy-1", "taken@example.com", time.Time{}, false)
+	assert.Equal(t, entities.ErrEmailExists, err)
+	assert.Empty(t, repo.invitations)
+}
+
+func TestClientInvitationUseCase_RegisterWithInvitation(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryClientInvitationRepository()
+	careRepo := newMemoryCareRepository()
+	mockNotifier := new(MockNotifier)
+	// Dengan OTP aktif registrasi biasa menunggu verifikasi email
+	sender := newOutboxSender()
+	uc := newClientInvitationUseCase(mockUserRepo, repo, careRepo, mockNotifier,
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)))
+	ctx := context.Background()
+
+	// 1. Psikolog mengundang klien, link registrasi dikirim ke email klien
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Email: "psy@example.com", Role: entities.PsychologistRole}, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "client@example.com").Return((*entities.User)(nil), nil)
+	var sent *entities.Notification
+	mockNotifier.On("Notify", mock.Anything, mock.AnythingOfType("*entities.Notification")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*entities.Notification) }).Return(nil)
+
+	inv, err := uc.CreateInvitation(ctx, "psy-1", "client@example.com", time.Time{}, true)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(14*24*time.Hour), inv.ExpiresAt, time.Minute)
+	require.NotNil(t, sent)
+	assert.Equal(t, entities.NotificationClientInvitation, sent.Kind)
+	assert.Equal(t, "client@example.com", sent.Recipient)
+	assert.Equal(t, "psy@example.com", sent.Data["invited_by"])
+	link, err := url.Parse(sent.Data["link"])
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+	require.NotEmpty(t, token)
+
+	// 2. Token undangan hanya berlaku untuk email yang diundang
+	_, err = uc.Register(ctx, token, "other@example.com", "password123")
+	assert.Equal(t, entities.ErrInvitationEmailMismatch, err)
+
+	// 3. Klien mendaftar: aktif dan email terverifikasi, tetapi hubungan care menunggu persetujuan klien
+	mockUserRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*entities.User")).Return(nil).Once()
+	user, err := uc.Register(ctx, token, "Client@example.com", "password123")
+	require.NoError(t, err)
+	assert.Equal(t, entities.ClientRole, user.Role)
+	assert.Equal(t, entities.StatusActive, user.Status)
+	assert.NotNil(t, user.EmailVerifiedAt)
+	assert.Equal(t, user.ID, repo.invitations[inv.ID].ClientID)
+	care, err := careRepo.FindOpen(ctx, user.ID, "psy-1")
+	require.NoError(t, err)
+	require.NotNil(t, care)
+	assert.Equal(t, entities.CareRequested, care.Status)
+	assert.Equal(t, "psy-1", care.RequestedBy)
+	assert.Nil(t, care.ClientConsentedAt)
+	assert.NotNil(t, care.PsychologistConsentedAt)
+
+	// 4. Token hanya bisa dipakai sekali
+	_, err = uc.Register(ctx, token, "client@example.com", "password123")
+	assert.Equal(t, entities.ErrInvalidToken, err)
+	mockUserRepo.AssertNumberOfCalls(t, "CreateUser", 1)
+}
+
+func TestClientInvitationUseCase_Register_RejectsInvalidTokens(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryClientInvitationRepository()
+	careRepo := newMemoryCareRepository()
+	mockNotifier := new(MockNotifier)
+	uc := newClientInvitationUseCase(mockUserRepo, repo, careRepo, mockNotifier)
+	ctx := context.Background()
+
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Email: "psy@example.com", Role: entities.PsychologistRole}, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "client@example.com").Return((*entities.User)(nil), nil)
+	var sent *entities.Notification
+	mockNotifier.On("Notify", mock.Anything, mock.AnythingOfType("*entities.Notification")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*entities.Notification) }).Return(nil)
+	inv, err := uc.CreateInvitation(ctx, "psy-1", "client@example.com", time.Time{}, false)
+	require.NoError(t, err)
+	link, err := url.Parse(sent.Data["link"])
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+
+	// Access token yang ditandatangani secret yang sama bukan token undangan
+	accessToken, err := auth.NewJWTAuth("test-secret").GenerateAccessToken("psy-1", string(entities.PsychologistRole))
+	require.NoError(t, err)
+	_, err = uc.Register(ctx, accessToken, "client@example.com", "password123")
+	assert.Equal(t, entities.ErrInvalidToken, err)
+
+	// Psikolog lain tidak bisa mencabut undangan ini
+	assert.Equal(t, entities.ErrInvitationNotFound, uc.RevokeInvitation(ctx, "psy-2", inv.ID))
+
+	require.NoError(t, uc.RevokeInvitation(ctx, "psy-1", inv.ID))
+	_, err = uc.Register(ctx, token, "client@example.com", "password123")
+	assert.Equal(t, entities.ErrInvalidToken, err)
+	mockUserRepo.AssertNotCalled(t, "CreateUser")
+	assert.Empty(t, careRepo.relationships)
+}
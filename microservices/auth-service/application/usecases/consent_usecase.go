@@ -0,0 +1,198 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/infrastructure/auth"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConsentUseCase mengelola versi dokumen persetujuan (syarat layanan, kebijakan privasi,
+// pemrosesan data klinis) dan bukti persetujuan setiap user.
+type ConsentUseCase struct {
+	authUC *AuthUseCase
+	repo   repositories.ConsentRepository
+}
+
+func NewConsentUseCase(authUC *AuthUseCase, repo repositories.ConsentRepository) *ConsentUseCase {
+	return &ConsentUseCase{authUC: authUC, repo: repo}
+}
+
+// ListDocuments mengembalikan versi terbaru setiap dokumen
+func (uc *ConsentUseCase) ListDocuments(ctx context.Context) ([]*entities.ConsentDocument, error) {
+	return uc.repo.ListCurrentDocuments(ctx)
+}
+
+// PublishDocument menerbitkan versi baru. Jika Required, login user yang belum menyetujui
+// versi ini tertahan sampai mereka menyetujuinya.
+func (uc *ConsentUseCase) PublishDocument(ctx context.Context, doc *entities.ConsentDocument) error {
+	doc.Version = strings.TrimSpace(doc.Version)
+	doc.Title = strings.TrimSpace(doc.Title)
+	if !doc.Kind.IsValid() || doc.Version == "" || doc.Title == "" {
+		return entities.ErrInvalidConsentDocument
+	}
+	doc.PublishedAt = time.Now().UTC()
+	if err := uc.repo.PublishDocument(ctx, doc); err != nil {
+		return err
+	}
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    entities.AuditConsentPublished,
+		SubjectID: string(doc.Kind),
+		Metadata: map[string]string{
+			"version":  doc.Version,
+			"required": strconv.FormatBool(doc.Required),
+		},
+	})
+	return nil
+}
+
+// RecordConsent mencatat persetujuan user atas versi yang berlaku beserta IP dan user agent.
+// Menyetujui versi lama ditolak dengan ErrConsentVersionOutdated; mengulang persetujuan
+// yang masih aktif tidak membuat record baru.
+func (uc *ConsentUseCase) RecordConsent(ctx context.Context, userID string, kind entities.ConsentKind, version string) (*entities.ConsentRecord, error) {
+	current, err := uc.currentDocument(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	if current.Version != version {
+		return nil, entities.ErrConsentVersionOutdated
+	}
+
+	records, err := uc.repo.ListRecords(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range records {
+		if rec.Kind == kind && rec.Version == version && rec.WithdrawnAt == nil {
+			return rec, nil
+		}
+	}
+
+	info := entities.RequestInfoFromContext(ctx)
+	record := &entities.ConsentRecord{
+		ID:         auth.GenerateUUID(),
+		UserID:     userID,
+		Kind:       kind,
+		Version:    version,
+		AcceptedAt: time.Now().UTC(),
+		IP:         info.IP,
+		UserAgent:  info.UserAgent,
+	}
+	if err := uc.repo.CreateRecord(ctx, record); err != nil {
+		return nil, err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditConsentRecorded, userID, map[string]string{
+		"kind":    string(kind),
+		"version": version,
+	})
+	return record, nil
+}
+
+// RecordConsentWithToken dipakai client yang loginnya tertahan (belum punya access token)
+// dengan token dari ConsentRequiredError
+func (uc *ConsentUseCase) RecordConsentWithToken(ctx context.Context, token string, kind entities.ConsentKind, version string) (*entities.ConsentRecord, error) {
+	userID, err := uc.authUC.jwtAuth.ValidateConsentToken(token)
+	if err != nil {
+		return nil, entities.ErrInvalidToken
+	}
+	return uc.RecordConsent(ctx, userID, kind, version)
+}
+
+// ListConsents mengembalikan seluruh riwayat persetujuan user, termasuk yang sudah ditarik
+func (uc *ConsentUseCase) ListConsents(ctx context.Context, userID string) ([]*entities.ConsentRecord, error) {
+	return uc.repo.ListRecords(ctx, userID)
+}
+
+// WithdrawConsent menarik persetujuan user untuk satu jenis dokumen. Jika dokumen tersebut
+// wajib bagi user, semua sesi dicabut dan login berikutnya meminta persetujuan ulang.
+func (uc *ConsentUseCase) WithdrawConsent(ctx context.Context, userID string, kind entities.ConsentKind) error {
+	current, err := uc.currentDocument(ctx, kind)
+	if err != nil {
+		return err
+	}
+	n, err := uc.repo.WithdrawRecords(ctx, userID, kind, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return entities.ErrConsentNotFound
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditConsentWithdrawn, userID, map[string]string{"kind": string(kind)})
+
+	if !current.Required {
+		return nil
+	}
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user != nil && current.AppliesTo(user) {
+		return uc.authUC.revokeUserSessions(ctx, userID)
+	}
+	return nil
+}
+
+func (uc *ConsentUseCase) currentDocument(ctx context.Context, kind entities.ConsentKind) (*entities.ConsentDocument, error) {
+	if !kind.IsValid() {
+		return nil, entities.ErrConsentDocumentNotFound
+	}
+	docs, err := uc.repo.ListCurrentDocuments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range docs {
+		if doc.Kind == kind {
+			return doc, nil
+		}
+	}
+	return nil, entities.ErrConsentDocumentNotFound
+}
+
+// requireConsents mengembalikan *ConsentRequiredError jika ada dokumen wajib yang berlaku
+// untuk user dan versi terbarunya belum disetujui
+func (uc *AuthUseCase) requireConsents(ctx context.Context, user *entities.User) error {
+	if uc.consents == nil {
+		return nil
+	}
+	docs, err := uc.consents.ListCurrentDocuments(ctx)
+	if err != nil {
+		return err
+	}
+	records, err := uc.consents.ListRecords(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	type kindVersion struct {
+		kind    entities.ConsentKind
+		version string
+	}
+	accepted := make(map[kindVersion]bool)
+	for _, rec := range records {
+		if rec.WithdrawnAt == nil {
+			accepted[kindVersion{rec.Kind, rec.Version}] = true
+		}
+	}
+
+	var missing []*entities.ConsentDocument
+	for _, doc := range docs {
+		if !doc.Required || !doc.AppliesTo(user) {
+			continue
+		}
+		if !accepted[kindVersion{doc.Kind, doc.Version}] {
+			missing = append(missing, doc)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	token, err := uc.jwtAuth.GenerateConsentToken(user.ID)
+	if err != nil {
+		return err
+	}
+	uc.auditSelf(ctx, entities.AuditLoginFailed, user.ID, map[string]string{"reason": "consent_required"})
+	return &entities.ConsentRequiredError{Token: token, Missing: missing}
+}
@@ -0,0 +1,269 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// memoryConsentRepository menyimpan dokumen dan record persetujuan di memori
+type memoryConsentRepository struct {
+	documents []*entities.ConsentDocument
+	records   []*entities.ConsentRecord
+}
+
+func (r *memoryConsentRepository) PublishDocument(ctx context.Context, doc *entities.ConsentDocument) error {
+	for _, d := range r.documents {
+		if d.Kind == doc.Kind && d.Version == doc.Version {
+			return entities.ErrConsentVersionExists
+		}
+	}
+	copied := *doc
+	r.documents = append(r.documents, &copied)
+	return nil
+}
+
+func (r *memoryConsentRepository) ListCurrentDocuments(ctx context.Context) ([]*entities.ConsentDocument, error) {
+	latest := make(map[entities.ConsentKind]*entities.ConsentDocument)
+	var kinds []entities.ConsentKind
+	for _, d := range r.documents {
+		current, ok := latest[d.Kind]
+		if !ok {
+			kinds = append(kinds, d.Kind)
+		}
+		if !ok || !d.PublishedAt.Before(current.PublishedAt) {
+			latest[d.Kind] = d
+		}
+	}
+	result := make([]*entities.ConsentDocument, len(kinds))
+	for i, kind := range kinds {
+		copied := *latest[kind]
+		result[i] = &copied
+	}
+	return result, nil
+}
+
+func (r *memoryConsentRepository) CreateRecord(ctx context.Context, record *entities.ConsentRecord) error {
+	copied := *record
+	r.records = append(r.records, &copied)
+	return nil
+}
+
+func (r *memoryConsentRepository) ListRecords(ctx context.Context, userID string) ([]*entities.ConsentRecord, error) {
+	var result []*entities.ConsentRecord
+	for _, rec := range r.records {
+		if rec.UserID == userID {
+			copied := *rec
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryConsentRepository) WithdrawRecords(ctx context.Context, userID string, kind entities.ConsentKind, withdrawnAt time.Time) (int, error) {
+	n := 0
+	for _, rec := range r.records {
+		if rec.UserID == userID && rec.Kind == kind && rec.WithdrawnAt == nil {
+			rec.WithdrawnAt = &withdrawnAt
+			n++
+		}
+	}
+	return n, nil
+}
+
+func newConsentTestUser(t *testing.T, role entities.Role) *entities.User {
+	hash, err := auth.Argon2Hash("password123")
+	require.NoError(t, err)
+	return &entities.User{ID: "user-123", Email: "user@example.com", PasswordHash: hash, Role: role, Status: entities.StatusActive}
+}
+
+func TestConsentUseCase_LoginBlockedUntilRequiredConsent(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := &memoryConsentRepository{}
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithConsentRepository(repo))
+	uc := usecases.NewConsentUseCase(authUC, repo)
+	ctx := entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{IP: "203.0.113.7", UserAgent: "app/1.0"})
+
+	user := newConsentTestUser(t, entities.ClientRole)
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.AnythingOfType("*entities.Session")).Return(nil)
+
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "2024-01", Title: "Terms", Required: true}))
+	// Dokumen untuk psikolog dan dokumen opsional tidak menahan login klien
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{
+		Kind: entities.ConsentClinicalDataProcessing, Version: "1", Title: "Clinical", Required: true,
+		Roles: []entities.Role{entities.PsychologistRole},
+	}))
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentPrivacyPolicy, Version: "1", Title: "Privacy"}))
+
+	// 1. Belum menyetujui terms: token tidak diterbitkan
+	_, _, err := authUC.Login(ctx, user.Email, "password123")
+	assert.ErrorIs(t, err, entities.ErrConsentRequired)
+	var consentErr *entities.ConsentRequiredError
+	require.True(t, errors.As(err, &consentErr))
+	require.Len(t, consentErr.Missing, 1)
+	assert.Equal(t, entities.ConsentTerms, consentErr.Missing[0].Kind)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+
+	// 2. Versi lama tidak bisa disetujui
+	_, err = uc.RecordConsentWithToken(ctx, consentErr.Token, entities.ConsentTerms, "2023-01")
+	assert.Equal(t, entities.ErrConsentVersionOutdated, err)
+
+	// 3. Persetujuan dengan consent token mencatat IP dan user agent, lalu login berhasil
+	record, err := uc.RecordConsentWithToken(ctx, consentErr.Token, entities.ConsentTerms, "2024-01")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, record.UserID)
+	assert.Equal(t, "203.0.113.7", record.IP)
+	assert.Equal(t, "app/1.0", record.UserAgent)
+
+	again, err := uc.RecordConsent(ctx, user.ID, entities.ConsentTerms, "2024-01")
+	require.NoError(t, err)
+	assert.Equal(t, record.ID, again.ID)
+	assert.Len(t, repo.records, 1)
+
+	accessToken, _, err := authUC.Login(ctx, user.Email, "password123")
+	require.NoError(t, err)
+	assert.NotEmpty(t, accessToken)
+
+	// 4. Versi baru terbit: login kembali tertahan
+	time.Sleep(time.Millisecond)
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "2025-01", Title: "Terms", Required: true}))
+	_, _, err = authUC.Login(ctx, user.Email, "password123")
+	assert.ErrorIs(t, err, entities.ErrConsentRequired)
+}
+
+func TestConsentUseCase_RefreshBlockedByNewRequiredDocument(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := &memoryConsentRepository{}
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithConsentRepository(repo))
+	uc := usecases.NewConsentUseCase(authUC, repo)
+	ctx := context.Background()
+
+	user := newConsentTestUser(t, entities.ClientRole)
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.AnythingOfType("*entities.Session")).Return(nil)
+	mockTokenRepo.On("GetUserIDByTokenID", mock.Anything, mock.AnythingOfType("string")).Return(user.ID, nil)
+	mockTokenRepo.On("IsTokenRevoked", mock.Anything, mock.AnythingOfType("string")).Return(false)
+	mockTokenRepo.On("GetSession", mock.Anything, mock.AnythingOfType("string")).Return((*entities.Session)(nil), nil)
+	mockTokenRepo.On("RevokeToken", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+	_, refreshToken, err := authUC.Login(ctx, user.Email, "password123")
+	require.NoError(t, err)
+
+	// Dokumen wajib baru terbit selama sesi berjalan: refresh tertahan, sesi tidak dicabut
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "2025-01", Title: "Terms", Required: true}))
+	_, _, err = authUC.RefreshToken(ctx, refreshToken)
+	var consentErr *entities.ConsentRequiredError
+	require.True(t, errors.As(err, &consentErr))
+	mockTokenRepo.AssertNotCalled(t, "RevokeToken", mock.Anything, mock.Anything)
+
+	_, err = uc.RecordConsentWithToken(ctx, consentErr.Token, entities.ConsentTerms, "2025-01")
+	require.NoError(t, err)
+	accessToken, _, err := authUC.RefreshToken(ctx, refreshToken)
+	require.NoError(t, err)
+	assert.NotEmpty(t, accessToken)
+}
+
+func TestConsentUseCase_ConsentCheckedAfterMFA(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := &memoryConsentRepository{}
+	sender := newOutboxSender()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithConsentRepository(repo),
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)),
+	)
+	uc := usecases.NewConsentUseCase(authUC, repo)
+	ctx := context.Background()
+
+	user := newConsentTestUser(t, entities.ClientRole)
+	user.MFAChannel = entities.OTPChannelEmail
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "2024-01", Title: "Terms", Required: true}))
+
+	// Password saja tidak cukup untuk mendapatkan consent token
+	_, _, err := authUC.Login(ctx, user.Email, "password123")
+	var mfa *entities.MFARequiredError
+	require.True(t, errors.As(err, &mfa))
+	assert.NotErrorIs(t, err, entities.ErrConsentRequired)
+
+	_, _, err = authUC.VerifyMFA(ctx, mfa.ChallengeID, sender.code(user.Email))
+	var consentErr *entities.ConsentRequiredError
+	require.True(t, errors.As(err, &consentErr))
+	assert.NotEmpty(t, consentErr.Token)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+}
+
+func TestConsentUseCase_RecordConsentWithToken_RejectsOtherTokens(t *testing.T) {
+	repo := &memoryConsentRepository{}
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), new(MockTokenRepository), "test-secret", nil, usecases.WithConsentRepository(repo))
+	uc := usecases.NewConsentUseCase(authUC, repo)
+	ctx := context.Background()
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "1", Title: "Terms", Required: true}))
+
+	accessToken, err := auth.NewJWTAuth("test-secret").GenerateAccessToken("user-123", string(entities.ClientRole))
+	require.NoError(t, err)
+	_, err = uc.RecordConsentWithToken(ctx, accessToken, entities.ConsentTerms, "1")
+	assert.Equal(t, entities.ErrInvalidToken, err)
+	assert.Empty(t, repo.records)
+}
+
+func TestConsentUseCase_WithdrawConsent(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := &memoryConsentRepository{}
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithConsentRepository(repo))
+	uc := usecases.NewConsentUseCase(authUC, repo)
+	ctx := context.Background()
+
+	user := newConsentTestUser(t, entities.ClientRole)
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, user.ID).Return(nil)
+
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "1", Title: "Terms", Required: true}))
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentPrivacyPolicy, Version: "1", Title: "Privacy"}))
+	_, err := uc.RecordConsent(ctx, user.ID, entities.ConsentTerms, "1")
+	require.NoError(t, err)
+	_, err = uc.RecordConsent(ctx, user.ID, entities.ConsentPrivacyPolicy, "1")
+	require.NoError(t, err)
+
+	// Dokumen opsional: sesi tetap berlaku
+	require.NoError(t, uc.WithdrawConsent(ctx, user.ID, entities.ConsentPrivacyPolicy))
+	mockTokenRepo.AssertNotCalled(t, "RevokeAllUserTokens", mock.Anything, user.ID)
+
+	// Dokumen wajib: semua sesi dicabut, riwayat tetap tersimpan
+	require.NoError(t, uc.WithdrawConsent(ctx, user.ID, entities.ConsentTerms))
+	mockTokenRepo.AssertCalled(t, "RevokeAllUserTokens", mock.Anything, user.ID)
+
+	assert.Equal(t, entities.ErrConsentNotFound, uc.WithdrawConsent(ctx, user.ID, entities.ConsentTerms))
+	records, err := uc.ListConsents(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	for _, rec := range records {
+		assert.NotNil(t, rec.WithdrawnAt)
+	}
+}
+
+func TestConsentUseCase_PublishDocument_Validation(t *testing.T) {
+	repo := &memoryConsentRepository{}
+	uc := usecases.NewConsentUseCase(usecases.NewAuthUseCase(new(MockUserRepository), new(MockTokenRepository), "test-secret", nil), repo)
+	ctx := context.Background()
+
+	assert.Equal(t, entities.ErrInvalidConsentDocument, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: "cookies", Version: "1", Title: "Cookies"}))
+	assert.Equal(t, entities.ErrInvalidConsentDocument, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: " ", Title: "Terms"}))
+	require.NoError(t, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "1", Title: "Terms"}))
+	assert.Equal(t, entities.ErrConsentVersionExists, uc.PublishDocument(ctx, &entities.ConsentDocument{Kind: entities.ConsentTerms, Version: "1", Title: "Terms"}))
+}
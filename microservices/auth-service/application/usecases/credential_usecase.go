@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// CredentialUseCase mengelola verifikasi lisensi psikolog: pengajuan, review admin, dan masa berlaku
+type CredentialUseCase struct {
+	userRepo       repositories.UserRepository
+	credentialRepo repositories.CredentialRepository
+	audit          services.AuditLogger
+}
+
+// CredentialOption untuk dependency opsional CredentialUseCase
+type CredentialOption func(*CredentialUseCase)
+
+// WithCredentialAuditLogger mencatat pengajuan dan review lisensi ke audit log
+// (reviewer sebagai actor, psikolog sebagai subject)
+func WithCredentialAuditLogger(audit services.AuditLogger) CredentialOption {
+	return func(uc *CredentialUseCase) { uc.audit = audit }
+}
+
+func NewCredentialUseCase(userRepo repositories.UserRepository, credentialRepo repositories.CredentialRepository, opts ...CredentialOption) *CredentialUseCase {
+	uc := &CredentialUseCase{
+		userRepo:       userRepo,
+		credentialRepo: credentialRepo,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// SubmitCredential dipakai untuk pengajuan pertama maupun perpanjangan lisensi.
+// Credential verified yang lama tetap berlaku sampai pengajuan baru disetujui.
+func (uc *CredentialUseCase) SubmitCredential(ctx context.Context, userID, licenseNumber, jurisdiction string, documentRefs []string) (*entities.PsychologistCredential, error) {
+	licenseNumber = strings.TrimSpace(licenseNumber)
+	jurisdiction = strings.TrimSpace(jurisdiction)
+	if licenseNumber == "" || jurisdiction == "" || len(documentRefs) == 0 {
+		return nil, entities.ErrInvalidCredential
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	if !user.HasRole(entities.PsychologistRole) {
+		return nil, entities.ErrNotPsychologist
+	}
+
+	existing, err := uc.credentialRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	for _, c := range existing {
+		if c.IsPending(now) {
+			return nil, entities.ErrCredentialPending
+		}
+	}
+
+	cred := &entities.PsychologistCredential{
+		ID:            auth.GenerateUUID(),
+		UserID:        userID,
+		LicenseNumber: licenseNumber,
+		Jurisdiction:  jurisdiction,
+		DocumentRefs:  documentRefs,
+		Status:        entities.CredentialPending,
+		SubmittedAt:   now,
+	}
+	if err := uc.credentialRepo.CreateCredential(ctx, cred); err != nil {
+		return nil, err
+	}
+	uc.auditReview(ctx, entities.AuditCredentialSubmitted, userID, cred)
+	return cred, nil
+}
+
+// ListUserCredentials mengembalikan riwayat pengajuan user, terbaru di depan
+func (uc *CredentialUseCase) ListUserCredentials(ctx context.Context, userID string) ([]*entities.PsychologistCredential, error) {
+	return uc.credentialRepo.ListByUserID(ctx, userID)
+}
+
+func (uc *CredentialUseCase) ListCredentials(ctx context.Context, status entities.CredentialStatus, limit, offset int) ([]*entities.PsychologistCredential, error) {
+	if status == "" {
+		status = entities.CredentialPending
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return uc.credentialRepo.ListByStatus(ctx, status, limit, offset)
+}
+
+// ApproveCredential memverifikasi pengajuan, expiresAt adalah tanggal habis lisensi
+func (uc *CredentialUseCase) ApproveCredential(ctx context.Context, credentialID, reviewerID string, expiresAt time.Time, note string) (*entities.PsychologistCredential, error) {
+	now := time.Now().UTC()
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, entities.ErrInvalidCredential
+	}
+
+	cred, err := uc.pendingCredential(ctx, credentialID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	cred.Status = entities.CredentialVerified
+	cred.ReviewedBy = reviewerID
+	cred.ReviewNote = note
+	cred.ReviewedAt = &now
+	if !expiresAt.IsZero() {
+		cred.ExpiresAt = &expiresAt
+	}
+	if err := uc.credentialRepo.UpdateReview(ctx, cred); err != nil {
+		return nil, err
+	}
+	uc.auditReview(ctx, entities.AuditCredentialApproved, reviewerID, cred)
+	return cred, nil
+}
+
+func (uc *CredentialUseCase) RejectCredential(ctx context.Context, credentialID, reviewerID, note string) (*entities.PsychologistCredential, error) {
+	cred, err := uc.pendingCredential(ctx, credentialID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	cred.Status = entities.CredentialRejected
+	cred.ReviewedBy = reviewerID
+	cred.ReviewNote = note
+	cred.ReviewedAt = &now
+	if err := uc.credentialRepo.UpdateReview(ctx, cred); err != nil {
+		return nil, err
+	}
+	uc.auditReview(ctx, entities.AuditCredentialRejected, reviewerID, cred)
+	return cred, nil
+}
+
+// auditReview : nomor lisensi tidak dicatat, cukup ID credential untuk menelusurinya
+func (uc *CredentialUseCase) auditReview(ctx context.Context, action entities.AuditAction, actorID string, cred *entities.PsychologistCredential) {
+	metadata := map[string]string{
+		"credential_id": cred.ID,
+		"jurisdiction":  cred.Jurisdiction,
+	}
+	if cred.ExpiresAt != nil {
+		metadata["expires_at"] = cred.ExpiresAt.Format(time.RFC3339)
+	}
+	recordAudit(ctx, uc.audit, &entities.AuditEvent{
+		Action:    action,
+		ActorID:   actorID,
+		SubjectID: cred.UserID,
+		Metadata:  metadata,
+	})
+}
+
+// Run menandai lisensi yang sudah habis setiap interval sampai ctx selesai. Psikolog terkait
+// perlu mengajukan perpanjangan; token berikutnya memakai claim psychologist_pending.
+func (uc *CredentialUseCase) Run(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, zap.L(), "credential expiry failed", func(ctx context.Context) (bool, error) {
+		n, err := uc.ExpireCredentials(ctx)
+		if n > 0 {
+			zap.L().Info("credentials expired", zap.Int("count", n))
+		}
+		return false, err
+	})
+}
+
+// ExpireCredentials menandai lisensi verified yang masa berlakunya sudah lewat, dan pengajuan
+// yang terlalu lama tidak direview, sebagai expired
+func (uc *CredentialUseCase) ExpireCredentials(ctx context.Context) (int, error) {
+	return uc.credentialRepo.ExpireCredentials(ctx, time.Now().UTC())
+}
+
+// pendingCredential : pengajuan yang melewati CredentialPendingTTL tidak bisa direview lagi
+// (walau worker belum menandainya expired), dan reviewer tidak boleh mereview lisensinya sendiri
+func (uc *CredentialUseCase) pendingCredential(ctx context.Context, credentialID, reviewerID string) (*entities.PsychologistCredential, error) {
+	cred, err := uc.credentialRepo.FindByID(ctx, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil {
+		return nil, entities.ErrCredentialNotFound
+	}
+	if cred.UserID == reviewerID {
+		return nil, entities.ErrCredentialSelfReview
+	}
+	if !cred.IsPending(time.Now()) {
+		return nil, entities.ErrCredentialNotPending
+	}
+	return cred, nil
+}
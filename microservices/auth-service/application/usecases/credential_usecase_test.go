@@ -0,0 +1,226 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type MockCredentialRepository struct {
+	mock.Mock
+}
+
+func (m *MockCredentialRepository) CreateCredential(ctx context.Context, cred *entities.PsychologistCredential) error {
+	args := m.Called(ctx, cred)
+	return args.Error(0)
+}
+
+func (m *MockCredentialRepository) FindByID(ctx context.Context, id string) (*entities.PsychologistCredential, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PsychologistCredential), args.Error(1)
+}
+
+func (m *MockCredentialRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.PsychologistCredential, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PsychologistCredential), args.Error(1)
+}
+
+func (m *MockCredentialRepository) ListByStatus(ctx context.Context, status entities.CredentialStatus, limit, offset int) ([]*entities.PsychologistCredential, error) {
+	args := m.Called(ctx, status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PsychologistCredential), args.Error(1)
+}
+
+func (m *MockCredentialRepository) UpdateReview(ctx context.Context, cred *entities.PsychologistCredential) error {
+	args := m.Called(ctx, cred)
+	return args.Error(0)
+}
+
+func (m *MockCredentialRepository) ExpireCredentials(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func TestCredentialUseCase_SubmitCredential_Success(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	uc := usecases.NewCredentialUseCase(mockUserRepo, mockCredRepo)
+
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Role: entities.PsychologistRole}, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, "psy-1").Return(nil, nil)
+	mockCredRepo.On("CreateCredential", mock.Anything, mock.AnythingOfType("*entities.PsychologistCredential")).Return(nil)
+
+	cred, err := uc.SubmitCredential(context.Background(), "psy-1", " SIPP-123 ", "DKI Jakarta", []string{"docs/psy-1/sipp.pdf"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.CredentialPending, cred.Status)
+	assert.Equal(t, "SIPP-123", cred.LicenseNumber)
+}
+
+func TestCredentialUseCase_SubmitCredential_NotPsychologist(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	uc := usecases.NewCredentialUseCase(mockUserRepo, mockCredRepo)
+
+	mockUserRepo.On("FindByID", mock.Anything, "client-1").Return(&entities.User{ID: "client-1", Role: entities.ClientRole}, nil)
+
+	_, err := uc.SubmitCredential(context.Background(), "client-1", "SIPP-123", "DKI Jakarta", []string{"doc"})
+
+	assert.Equal(t, entities.ErrNotPsychologist, err)
+	mockCredRepo.AssertNotCalled(t, "CreateCredential")
+}
+
+func TestCredentialUseCase_SubmitCredential_AlreadyPending(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	uc := usecases.NewCredentialUseCase(mockUserRepo, mockCredRepo)
+
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Role: entities.PsychologistRole}, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, "psy-1").
+		Return([]*entities.PsychologistCredential{{ID: "cred-1", Status: entities.CredentialPending, SubmittedAt: time.Now().Add(-time.Hour)}}, nil)
+
+	_, err := uc.SubmitCredential(context.Background(), "psy-1", "SIPP-123", "DKI Jakarta", []string{"doc"})
+
+	assert.Equal(t, entities.ErrCredentialPending, err)
+}
+
+func TestCredentialUseCase_ApproveCredential(t *testing.T) {
+	mockCredRepo := new(MockCredentialRepository)
+	mockAudit := new(MockAuditLogger)
+	uc := usecases.NewCredentialUseCase(new(MockUserRepository), mockCredRepo, usecases.WithCredentialAuditLogger(mockAudit))
+
+	expiresAt := time.Now().AddDate(5, 0, 0)
+	mockCredRepo.On("FindByID", mock.Anything, "cred-1").
+		Return(&entities.PsychologistCredential{ID: "cred-1", UserID: "psy-1", Status: entities.CredentialPending, SubmittedAt: time.Now().Add(-time.Hour)}, nil)
+	mockCredRepo.On("UpdateReview", mock.Anything, mock.MatchedBy(func(c *entities.PsychologistCredential) bool {
+		return c.Status == entities.CredentialVerified && c.ReviewedBy == "admin-1" && c.ExpiresAt != nil
+	})).Return(nil)
+
+	mockAudit.On("Record", mock.Anything, mock.MatchedBy(func(e *entities.AuditEvent) bool {
+		return e.Action == entities.AuditCredentialApproved && e.ActorID == "admin-1" && e.SubjectID == "psy-1" &&
+			e.Metadata["credential_id"] == "cred-1"
+	})).Return(nil)
+
+	cred, err := uc.ApproveCredential(context.Background(), "cred-1", "admin-1", expiresAt, "ok")
+
+	assert.NoError(t, err)
+	assert.True(t, cred.IsValid(time.Now()))
+	assert.False(t, cred.IsValid(expiresAt.Add(time.Hour)))
+	mockAudit.AssertExpectations(t)
+}
+
+func TestCredentialUseCase_RejectCredential_NotPending(t *testing.T) {
+	mockCredRepo := new(MockCredentialRepository)
+	uc := usecases.NewCredentialUseCase(new(MockUserRepository), mockCredRepo)
+
+	mockCredRepo.On("FindByID", mock.Anything, "cred-1").
+		Return(&entities.PsychologistCredential{ID: "cred-1", Status: entities.CredentialVerified}, nil)
+
+	_, err := uc.RejectCredential(context.Background(), "cred-1", "admin-1", "late")
+
+	assert.Equal(t, entities.ErrCredentialNotPending, err)
+	mockCredRepo.AssertNotCalled(t, "UpdateReview")
+}
+
+func TestCredentialUseCase_ReviewRejectsSelfAndStalePending(t *testing.T) {
+	mockCredRepo := new(MockCredentialRepository)
+	uc := usecases.NewCredentialUseCase(new(MockUserRepository), mockCredRepo)
+
+	mockCredRepo.On("FindByID", mock.Anything, "cred-1").
+		Return(&entities.PsychologistCredential{ID: "cred-1", UserID: "psy-1", Status: entities.CredentialPending, SubmittedAt: time.Now().Add(-time.Hour)}, nil)
+	mockCredRepo.On("FindByID", mock.Anything, "cred-stale").
+		Return(&entities.PsychologistCredential{ID: "cred-stale", UserID: "psy-2", Status: entities.CredentialPending, SubmittedAt: time.Now().Add(-entities.CredentialPendingTTL - time.Hour)}, nil)
+
+	// Admin yang juga psikolog tidak boleh menyetujui lisensinya sendiri
+	_, err := uc.ApproveCredential(context.Background(), "cred-1", "psy-1", time.Now().AddDate(1, 0, 0), "")
+	assert.Equal(t, entities.ErrCredentialSelfReview, err)
+	_, err = uc.RejectCredential(context.Background(), "cred-1", "psy-1", "")
+	assert.Equal(t, entities.ErrCredentialSelfReview, err)
+
+	_, err = uc.ApproveCredential(context.Background(), "cred-stale", "admin-1", time.Now().AddDate(1, 0, 0), "")
+	assert.Equal(t, entities.ErrCredentialNotPending, err)
+	mockCredRepo.AssertNotCalled(t, "UpdateReview")
+}
+
+func TestCredentialUseCase_SubmitCredential_StalePendingDoesNotBlock(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	uc := usecases.NewCredentialUseCase(mockUserRepo, mockCredRepo)
+
+	mockUserRepo.On("FindByID", mock.Anything, "psy-1").Return(&entities.User{ID: "psy-1", Role: entities.PsychologistRole}, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, "psy-1").Return([]*entities.PsychologistCredential{
+		{ID: "cred-1", Status: entities.CredentialPending, SubmittedAt: time.Now().Add(-entities.CredentialPendingTTL - time.Hour)},
+	}, nil)
+	mockCredRepo.On("CreateCredential", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := uc.SubmitCredential(context.Background(), "psy-1", "SIPP-123", "DKI Jakarta", []string{"doc"})
+
+	assert.NoError(t, err)
+}
+
+func TestAuthUseCase_Login_UnverifiedPsychologistGetsPendingRole(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithCredentialRepository(mockCredRepo))
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{ID: "psy-1", Email: "psy@example.com", PasswordHash: hash, Role: entities.PsychologistRole, Status: entities.StatusActive}
+
+	// Lisensi sudah habis
+	expired := time.Now().Add(-time.Hour)
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, user.ID).
+		Return([]*entities.PsychologistCredential{{ID: "cred-1", Status: entities.CredentialVerified, ExpiresAt: &expired}}, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(user.ID)).Return(nil)
+
+	accessToken, _, err := authUC.Login(context.Background(), user.Email, "password123")
+	assert.NoError(t, err)
+
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, string(entities.PendingPsychologistRole), claims.Role)
+	assert.Equal(t, []string{string(entities.PendingPsychologistRole)}, claims.Roles)
+}
+
+func TestAuthUseCase_Login_VerifiedPsychologist(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockCredRepo := new(MockCredentialRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithCredentialRepository(mockCredRepo))
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{ID: "psy-1", Email: "psy@example.com", PasswordHash: hash, Role: entities.PsychologistRole, Status: entities.StatusActive}
+
+	expiresAt := time.Now().AddDate(1, 0, 0)
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockCredRepo.On("ListByUserID", mock.Anything, user.ID).Return([]*entities.PsychologistCredential{
+		{ID: "cred-2", Status: entities.CredentialPending},
+		{ID: "cred-1", Status: entities.CredentialVerified, ExpiresAt: &expiresAt},
+	}, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(user.ID)).Return(nil)
+
+	accessToken, _, err := authUC.Login(context.Background(), user.Email, "password123")
+	assert.NoError(t, err)
+
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, string(entities.PsychologistRole), claims.Role)
+}
@@ -0,0 +1,257 @@
+package usecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	dataExportBatchSize     = 5
+	dataExportClaimLease    = 5 * time.Minute
+	dataExportRetryDelay    = time.Minute
+	dataExportMaxAttempts   = 3
+	dataExportFormatVersion = 1
+
+	// Isi arsip: data.json dan tanda tangannya (entities.DataExportSignature), diverifikasi
+	// dengan public key dari ListSigningKeys
+	dataExportDataFile      = "data.json"
+	dataExportSignatureFile = "data.json.sig"
+)
+
+// DataExportUseCase menerima permintaan export data user dan, lewat Run, menyusun arsipnya
+// di background. Beberapa instance boleh berjalan bersamaan karena job di-claim dengan lease.
+// Arsip disimpan terenkripsi dengan kunci user (UserKeyring).
+type DataExportUseCase struct {
+	authUC     *AuthUseCase
+	repo       repositories.DataExportRepository
+	keyring    *UserKeyring
+	identities repositories.IdentityRepository
+	consents   repositories.ConsentRepository
+	auditUC    *AuditUseCase
+	signer     services.ExportSigner
+	logger     *zap.Logger
+}
+
+func NewDataExportUseCase(authUC *AuthUseCase, repo repositories.DataExportRepository, keyring *UserKeyring, identities repositories.IdentityRepository, consents repositories.ConsentRepository, auditUC *AuditUseCase, signer services.ExportSigner, logger *zap.Logger) *DataExportUseCase {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &DataExportUseCase{authUC: authUC, repo: repo, keyring: keyring, identities: identities, consents: consents, auditUC: auditUC, signer: signer, logger: logger}
+}
+
+// RequestExport membuat job baru, atau mengembalikan job yang masih berjalan
+func (uc *DataExportUseCase) RequestExport(ctx context.Context, userID string) (*entities.DataExport, error) {
+	active, err := uc.repo.FindActiveByUser(ctx, userID)
+	if err != nil || active != nil {
+		return active, err
+	}
+
+	export := &entities.DataExport{
+		ID:          auth.GenerateUUID(),
+		UserID:      userID,
+		Status:      entities.DataExportPending,
+		RequestedAt: time.Now().UTC(),
+	}
+	if err := uc.repo.CreateExport(ctx, export); err != nil {
+		// Permintaan bersamaan dari user yang sama
+		if errors.Is(err, entities.ErrDataExportInProgress) {
+			return uc.repo.FindActiveByUser(ctx, userID)
+		}
+		return nil, err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditDataExportRequested, userID, map[string]string{"export_id": export.ID})
+	return export, nil
+}
+
+// GetExport mengembalikan status job tanpa arsip
+func (uc *DataExportUseCase) GetExport(ctx context.Context, userID, exportID string) (*entities.DataExport, error) {
+	return uc.find(ctx, userID, exportID, false)
+}
+
+// ListSigningKeys : public key untuk memverifikasi data.json.sig, termasuk key sebelum rotasi
+func (uc *DataExportUseCase) ListSigningKeys(ctx context.Context) ([]*entities.SigningKey, error) {
+	return uc.signer.PublicKeys(ctx)
+}
+
+// DownloadExport mengembalikan job beserta arsipnya, hanya untuk job yang sudah selesai
+func (uc *DataExportUseCase) DownloadExport(ctx context.Context, userID, exportID string) (*entities.DataExport, error) {
+	export, err := uc.find(ctx, userID, exportID, true)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != entities.DataExportCompleted || len(export.Archive) == 0 {
+		return nil, entities.ErrDataExportNotReady
+	}
+	if export.Archive, err = uc.keyring.Open(ctx, userID, export.Archive); err != nil {
+		return nil, err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditDataExportDownloaded, userID, map[string]string{"export_id": export.ID})
+	return export, nil
+}
+
+// find mengembalikan ErrDataExportNotFound juga untuk job milik user lain
+func (uc *DataExportUseCase) find(ctx context.Context, userID, exportID string, withArchive bool) (*entities.DataExport, error) {
+	export, err := uc.repo.FindExport(ctx, exportID, withArchive)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil || export.UserID != userID {
+		return nil, entities.ErrDataExportNotFound
+	}
+	return export, nil
+}
+
+// Run memanggil ProcessOnce lewat runPeriodically sampai ctx dibatalkan
+func (uc *DataExportUseCase) Run(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, uc.logger, "data export processing failed", func(ctx context.Context) (bool, error) {
+		n, err := uc.ProcessOnce(ctx)
+		return n == dataExportBatchSize, err
+	})
+}
+
+// ProcessOnce menghapus arsip kedaluwarsa lalu menyusun satu batch job, mengembalikan
+// jumlah job yang di-claim
+func (uc *DataExportUseCase) ProcessOnce(ctx context.Context) (int, error) {
+	if _, err := uc.repo.PurgeExpired(ctx, time.Now().UTC()); err != nil {
+		return 0, err
+	}
+	exports, err := uc.repo.ClaimPending(ctx, dataExportBatchSize, dataExportClaimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, export := range exports {
+		archive, err := uc.buildArchive(ctx, export)
+		if err != nil {
+			if export.Attempts >= dataExportMaxAttempts || errors.Is(err, entities.ErrUserNotFound) {
+				uc.logger.Error("data export failed", zap.String("export_id", export.ID), zap.Int("attempts", export.Attempts), zap.Error(err))
+				if err := uc.repo.MarkDead(ctx, export.ID, err.Error()); err != nil {
+					return len(exports), err
+				}
+				continue
+			}
+			uc.logger.Warn("data export attempt failed", zap.String("export_id", export.ID), zap.Int("attempts", export.Attempts), zap.Error(err))
+			if err := uc.repo.MarkFailed(ctx, export.ID, err.Error(), time.Now().UTC().Add(dataExportRetryDelay)); err != nil {
+				return len(exports), err
+			}
+			continue
+		}
+
+		// Checksum dihitung dari arsip yang diterima user, bukan dari ciphertext
+		sum := sha256.Sum256(archive)
+		sealed, err := uc.keyring.Seal(ctx, export.UserID, archive)
+		if err != nil {
+			return len(exports), err
+		}
+		now := time.Now().UTC()
+		if err := uc.repo.CompleteExport(ctx, export.ID, sealed, hex.EncodeToString(sum[:]), now, now.Add(entities.DataExportRetention)); err != nil {
+			return len(exports), err
+		}
+	}
+	return len(exports), nil
+}
+
+// buildArchive mengumpulkan data user dan mengemasnya ke ZIP berisi data.json dan tanda tangannya
+func (uc *DataExportUseCase) buildArchive(ctx context.Context, export *entities.DataExport) ([]byte, error) {
+	doc, err := uc.collect(ctx, export)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	keyID, signature, err := uc.signer.Sign(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := json.Marshal(&entities.DataExportSignature{Algorithm: uc.signer.Algorithm(), KeyID: keyID, Signature: signature})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{dataExportDataFile, data},
+		{dataExportSignatureFile, sig},
+	}
+	for _, f := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: doc.GeneratedAt})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(f.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (uc *DataExportUseCase) collect(ctx context.Context, export *entities.DataExport) (*entities.DataExportDocument, error) {
+	user, err := uc.authUC.userRepo.FindByID(ctx, export.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	doc := &entities.DataExportDocument{
+		FormatVersion: dataExportFormatVersion,
+		GeneratedAt:   time.Now().UTC(),
+		ExportID:      export.ID,
+		User:          user,
+		MFA: entities.DataExportMFA{
+			Enabled:         user.MFAEnabled(),
+			Channel:         user.MFAChannel,
+			Phone:           user.Phone,
+			PhoneVerifiedAt: user.PhoneVerifiedAt,
+		},
+	}
+	if doc.Profile.Identities, err = uc.identities.ListByUserID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if doc.Sessions, err = uc.authUC.tokenRepo.ListUserSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if doc.Consents, err = uc.consents.ListRecords(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	err = uc.auditUC.Export(ctx, entities.AuditFilter{SubjectID: user.ID}, func(e *entities.AuditEvent) error {
+		if e.SubjectID != user.ID {
+			return nil
+		}
+		if e.ActorID != user.ID {
+			// Sama seperti Pseudonymize: IP, perangkat dan request aksi admin/pihak lain
+			// adalah data pelakunya, bukan data user
+			redacted := *e
+			redacted.IP, redacted.UserAgent, redacted.AppVersion, redacted.DeviceID, redacted.RequestID = "", "", "", "", ""
+			e = &redacted
+		}
+		doc.AuditEvents = append(doc.AuditEvents, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
@@ -0,0 +1,313 @@
+package usecases_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/kms"
+)
+
+// memoryDataExportRepository menyimpan job export di memori; lease diabaikan
+type memoryDataExportRepository struct {
+	exports map[string]*entities.DataExport
+}
+
+func newMemoryDataExportRepository() *memoryDataExportRepository {
+	return &memoryDataExportRepository{exports: make(map[string]*entities.DataExport)}
+}
+
+func (r *memoryDataExportRepository) CreateExport(ctx context.Context, export *entities.DataExport) error {
+	if active, _ := r.FindActiveByUser(ctx, export.UserID); active != nil {
+		return entities.ErrDataExportInProgress
+	}
+	copied := *export
+	r.exports[export.ID] = &copied
+	return nil
+}
+
+func (r *memoryDataExportRepository) FindExport(ctx context.Context, id string, withArchive bool) (*entities.DataExport, error) {
+	export, ok := r.exports[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *export
+	if !withArchive {
+		copied.Archive = nil
+	}
+	return &copied, nil
+}
+
+func (r *memoryDataExportRepository) FindActiveByUser(ctx context.Context, userID string) (*entities.DataExport, error) {
+	for _, export := range r.exports {
+		if export.UserID == userID && export.IsActive() {
+			copied := *export
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryDataExportRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.DataExport, error) {
+	var result []*entities.DataExport
+	for _, export := range r.exports {
+		if export.Status == entities.DataExportPending && len(result) < limit {
+			export.Status = entities.DataExportProcessing
+			export.Attempts++
+			copied := *export
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryDataExportRepository) CompleteExport(ctx context.Context, id string, archive []byte, sha256 string, completedAt, expiresAt time.Time) error {
+	export := r.exports[id]
+	export.Status = entities.DataExportCompleted
+	export.Archive = archive
+	export.SHA256 = sha256
+	export.CompletedAt = &completedAt
+	export.ExpiresAt = &expiresAt
+	return nil
+}
+
+func (r *memoryDataExportRepository) MarkFailed(ctx context.Context, id, lastError string, retryAt time.Time) error {
+	r.exports[id].Status = entities.DataExportPending
+	r.exports[id].Error = lastError
+	return nil
+}
+
+func (r *memoryDataExportRepository) MarkDead(ctx context.Context, id, lastError string) error {
+	r.exports[id].Status = entities.DataExportFailed
+	r.exports[id].Error = lastError
+	return nil
+}
+
+func (r *memoryDataExportRepository) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	n := 0
+	for _, export := range r.exports {
+		if export.Status == entities.DataExportCompleted && !export.ExpiresAt.After(now) {
+			export.Status = entities.DataExportExpired
+			export.Archive = nil
+			n++
+		}
+	}
+	return n, nil
+}
+
+// readZip mengembalikan isi setiap file di arsip
+func readZip(t *testing.T, archive []byte) map[string][]byte {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = content
+	}
+	return files
+}
+
+func newExportSigner(t *testing.T) *kms.FileSigner {
+	path := filepath.Join(t.TempDir(), "export-signing-keys.json")
+	_, err := kms.EnsureSigningKeyFile(path)
+	require.NoError(t, err)
+	signer, err := kms.NewFileSigner(path)
+	require.NoError(t, err)
+	return signer
+}
+
+func TestDataExportUseCase_ExportAndDownload(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockIdentityRepo := new(MockIdentityRepository)
+	auditRepo := &memoryAuditRepository{}
+	auditUC := usecases.NewAuditUseCase(auditRepo)
+	consentRepo := &memoryConsentRepository{}
+	repo := newMemoryDataExportRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithAuditLogger(auditUC))
+	keys := newMemoryUserKeyRepository()
+	signer := newExportSigner(t)
+	uc := usecases.NewDataExportUseCase(authUC, repo, usecases.NewUserKeyring(keys), mockIdentityRepo, consentRepo, auditUC, signer, nil)
+	ctx := context.Background()
+
+	verifiedAt := time.Now().Add(-time.Hour).UTC()
+	user := &entities.User{
+		ID: "user-123", Email: "user@example.com", PasswordHash: "argon2-secret-hash", Role: entities.ClientRole,
+		Status: entities.StatusActive, Phone: "+6281234567890", PhoneVerifiedAt: &verifiedAt, MFAChannel: entities.OTPChannelSMS,
+	}
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	mockIdentityRepo.On("ListByUserID", mock.Anything, user.ID).Return([]*entities.UserIdentity{
+		{ID: "id-1", UserID: user.ID, Provider: "google", Subject: "g-123", Email: user.Email},
+	}, nil)
+	mockTokenRepo.On("ListUserSessions", mock.Anything, user.ID).Return([]*entities.Session{
+		{TokenID: "session-1", UserID: user.ID, IP: "203.0.113.7", UserAgent: "app/1.0"},
+	}, nil)
+	consentRepo.records = append(consentRepo.records, &entities.ConsentRecord{ID: "consent-1", UserID: user.ID, Kind: entities.ConsentTerms, Version: "1"})
+	require.NoError(t, auditUC.Record(ctx, &entities.AuditEvent{Action: entities.AuditLoginSucceeded, SubjectID: "someone-else"}))
+	require.NoError(t, auditUC.Record(ctx, &entities.AuditEvent{
+		Action: entities.AuditRoleChanged, ActorID: "admin-1", SubjectID: user.ID,
+		IP: "198.51.100.9", UserAgent: "admin-console/2.0", AppVersion: "2.0", DeviceID: "admin-device", RequestID: "req-admin",
+	}))
+
+	// 1. Permintaan kedua selama job berjalan mengembalikan job yang sama
+	export, err := uc.RequestExport(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.DataExportPending, export.Status)
+	again, err := uc.RequestExport(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, export.ID, again.ID)
+	assert.Len(t, repo.exports, 1)
+
+	_, err = uc.DownloadExport(ctx, user.ID, export.ID)
+	assert.Equal(t, entities.ErrDataExportNotReady, err)
+
+	// 2. Worker menyusun arsip
+	n, err := uc.ProcessOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	status, err := uc.GetExport(ctx, user.ID, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entities.DataExportCompleted, status.Status)
+	assert.Nil(t, status.Archive)
+	require.NotNil(t, status.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(entities.DataExportRetention), *status.ExpiresAt, time.Minute)
+	// Arsip tersimpan terenkripsi dengan kunci user, bukan ZIP mentah
+	assert.NotEqual(t, "PK", string(repo.exports[export.ID].Archive[:2]))
+
+	// 3. Unduhan: hash cocok, tanda tangan valid, tanpa secret
+	downloaded, err := uc.DownloadExport(ctx, user.ID, export.ID)
+	require.NoError(t, err)
+	sum := sha256.Sum256(downloaded.Archive)
+	assert.Equal(t, hex.EncodeToString(sum[:]), downloaded.SHA256)
+
+	files := readZip(t, downloaded.Archive)
+	data := files["data.json"]
+	require.NotEmpty(t, data)
+	// Tanda tangan diverifikasi hanya dengan public key yang dipublikasikan
+	var sig entities.DataExportSignature
+	require.NoError(t, json.Unmarshal(files["data.json.sig"], &sig))
+	assert.Equal(t, "Ed25519", sig.Algorithm)
+	publicKeys, err := uc.ListSigningKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, publicKeys, 1)
+	assert.Equal(t, publicKeys[0].ID, sig.KeyID)
+	assert.True(t, ed25519.Verify(publicKeys[0].PublicKey, data, sig.Signature))
+	assert.False(t, ed25519.Verify(publicKeys[0].PublicKey, append(data, ' '), sig.Signature))
+	assert.NotContains(t, string(data), "argon2-secret-hash")
+
+	var doc entities.DataExportDocument
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, user.Email, doc.User.Email)
+	assert.True(t, doc.MFA.Enabled)
+	assert.Equal(t, entities.OTPChannelSMS, doc.MFA.Channel)
+	require.Len(t, doc.Profile.Identities, 1)
+	assert.Equal(t, "google", doc.Profile.Identities[0].Provider)
+	require.Len(t, doc.Sessions, 1)
+	assert.Equal(t, "203.0.113.7", doc.Sessions[0].IP)
+	require.Len(t, doc.Consents, 1)
+	assert.Equal(t, entities.ConsentTerms, doc.Consents[0].Kind)
+	// Hanya audit tentang user ini, bukan milik user lain; data perangkat admin dikosongkan
+	require.Len(t, doc.AuditEvents, 2)
+	var adminEvent *entities.AuditEvent
+	for _, e := range doc.AuditEvents {
+		if e.Action == entities.AuditRoleChanged {
+			adminEvent = e
+		}
+	}
+	require.NotNil(t, adminEvent)
+	assert.Equal(t, "admin-1", adminEvent.ActorID)
+	assert.Empty(t, adminEvent.IP)
+	assert.Empty(t, adminEvent.UserAgent)
+	assert.Empty(t, adminEvent.AppVersion)
+	assert.Empty(t, adminEvent.DeviceID)
+	assert.Empty(t, adminEvent.RequestID)
+	assert.NotContains(t, string(data), "198.51.100.9")
+
+	// 4. User lain tidak bisa melihat atau mengunduh
+	_, err = uc.GetExport(ctx, "user-456", export.ID)
+	assert.Equal(t, entities.ErrDataExportNotFound, err)
+	_, err = uc.DownloadExport(ctx, "user-456", export.ID)
+	assert.Equal(t, entities.ErrDataExportNotFound, err)
+
+	// 5. Setelah kunci user dimusnahkan arsip tidak bisa dibuka lagi
+	require.NoError(t, keys.DestroyKey(ctx, user.ID))
+	_, err = uc.DownloadExport(ctx, user.ID, export.ID)
+	assert.Equal(t, entities.ErrUserKeyDestroyed, err)
+}
+
+func TestDataExportUseCase_ProcessOnce_RetriesThenFails(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockIdentityRepo := new(MockIdentityRepository)
+	repo := newMemoryDataExportRepository()
+	auditUC := usecases.NewAuditUseCase(&memoryAuditRepository{})
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+	uc := usecases.NewDataExportUseCase(authUC, repo, usecases.NewUserKeyring(newMemoryUserKeyRepository()), mockIdentityRepo, &memoryConsentRepository{}, auditUC, newExportSigner(t), nil)
+	ctx := context.Background()
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Status: entities.StatusActive}, nil)
+	mockIdentityRepo.On("ListByUserID", mock.Anything, "user-123").Return([]*entities.UserIdentity{}, nil)
+	mockTokenRepo.On("ListUserSessions", mock.Anything, "user-123").Return(nil, assert.AnError)
+	mockUserRepo.On("FindByID", mock.Anything, "gone").Return((*entities.User)(nil), nil)
+
+	export, err := uc.RequestExport(ctx, "user-123")
+	require.NoError(t, err)
+	gone, err := uc.RequestExport(ctx, "gone")
+	require.NoError(t, err)
+
+	// User yang sudah tidak ada langsung gagal, error sementara dicoba ulang
+	_, err = uc.ProcessOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entities.DataExportFailed, repo.exports[gone.ID].Status)
+	assert.Equal(t, entities.DataExportPending, repo.exports[export.ID].Status)
+
+	for i := 0; i < 2; i++ {
+		_, err = uc.ProcessOnce(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, entities.DataExportFailed, repo.exports[export.ID].Status)
+	assert.Equal(t, 3, repo.exports[export.ID].Attempts)
+
+	// Job yang gagal tidak menghalangi permintaan baru
+	retry, err := uc.RequestExport(ctx, "user-123")
+	require.NoError(t, err)
+	assert.NotEqual(t, export.ID, retry.ID)
+}
+
+func TestDataExportUseCase_ExpiredArchiveIsPurged(t *testing.T) {
+	repo := newMemoryDataExportRepository()
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewDataExportUseCase(authUC, repo, usecases.NewUserKeyring(newMemoryUserKeyRepository()), new(MockIdentityRepository), &memoryConsentRepository{}, usecases.NewAuditUseCase(&memoryAuditRepository{}), newExportSigner(t), nil)
+	ctx := context.Background()
+
+	expiredAt := time.Now().Add(-time.Minute)
+	repo.exports["exp-1"] = &entities.DataExport{
+		ID: "exp-1", UserID: "user-123", Status: entities.DataExportCompleted, Archive: []byte("zip"), ExpiresAt: &expiredAt,
+	}
+
+	_, err := uc.ProcessOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entities.DataExportExpired, repo.exports["exp-1"].Status)
+	assert.Nil(t, repo.exports["exp-1"].Archive)
+	_, err = uc.DownloadExport(ctx, "user-123", "exp-1")
+	assert.Equal(t, entities.ErrDataExportNotReady, err)
+}
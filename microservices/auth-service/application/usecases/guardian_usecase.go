@@ -0,0 +1,293 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const guardianConsentTTL = 7 * 24 * time.Hour
+
+// maxAge : tanggal lahir lebih tua dari ini dianggap salah input
+const maxAge = 120
+
+// GuardianUseCase menangani akun klien di bawah umur: registrasi dengan persetujuan wali
+// dan pengelolaan akun anak oleh walinya. Wali hanya mengelola akun (status, sesi),
+// konten klinis tetap milik klien dan tidak diekspos lewat use case ini.
+type GuardianUseCase struct {
+	authUC     *AuthUseCase
+	repo       repositories.GuardianRepository
+	notifier   services.Notifier
+	consentURL string // halaman persetujuan wali, token ditambahkan sebagai query "token"
+}
+
+func NewGuardianUseCase(authUC *AuthUseCase, repo repositories.GuardianRepository, notifier services.Notifier, consentURL string) *GuardianUseCase {
+	return &GuardianUseCase{
+		authUC:     authUC,
+		repo:       repo,
+		notifier:   notifier,
+		consentURL: consentURL,
+	}
+}
+
+// Register mendaftarkan user beserta tanggal lahirnya. Klien di bawah umur dibuat dengan status
+// pending_guardian_consent dan undangan persetujuan dikirim ke email wali. Verifikasi email tetap
+// berlaku terpisah (lihat AuthUseCase.RequiresEmailVerification).
+func (uc *GuardianUseCase) Register(ctx context.Context, email, password string, role entities.Role, dateOfBirth time.Time, guardianEmail string, relationship entities.GuardianRelationship) (*entities.User, error) {
+	now := time.Now().UTC()
+	dob := dateOfBirth.UTC().Truncate(24 * time.Hour)
+	if dob.After(now) || dob.Before(now.AddDate(-maxAge, 0, 0)) {
+		return nil, entities.ErrInvalidDateOfBirth
+	}
+
+	user, err := uc.authUC.newUser(ctx, email, password, role)
+	if err != nil {
+		return nil, err
+	}
+	user.DateOfBirth = &dob
+	if !user.IsMinorAt(now) {
+		return user, uc.authUC.createUser(ctx, user, nil)
+	}
+
+	// Hanya klien yang boleh di bawah umur, psikolog wajib dewasa
+	if role != entities.ClientRole {
+		return nil, entities.ErrInvalidRole
+	}
+	guardianEmail = strings.TrimSpace(guardianEmail)
+	if guardianEmail == "" || strings.EqualFold(guardianEmail, email) {
+		return nil, entities.ErrGuardianRequired
+	}
+	if relationship == "" {
+		relationship = entities.RelationshipParent
+	}
+	if !relationship.IsValid() {
+		return nil, entities.ErrGuardianRequired
+	}
+
+	token, hash, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	user.Status = entities.StatusPendingGuardianConsent
+	guardianship := &entities.Guardianship{
+		ID:               auth.GenerateUUID(),
+		MinorID:          user.ID,
+		GuardianEmail:    guardianEmail,
+		Relationship:     relationship,
+		Status:           entities.GuardianshipPending,
+		ConsentTokenHash: hash,
+		ConsentExpiresAt: now.Add(guardianConsentTTL),
+		CreatedAt:        now,
+	}
+	if err := uc.authUC.createUser(ctx, user, func(ctx context.Context) error {
+		return uc.repo.CreateGuardianship(ctx, guardianship)
+	}); err != nil {
+		return nil, err
+	}
+
+	uc.requestConsent(ctx, user, guardianship, token)
+	return user, nil
+}
+
+// requestConsent : kegagalan antrean tidak menggagalkan registrasi, akun tetap menunggu persetujuan
+func (uc *GuardianUseCase) requestConsent(ctx context.Context, minor *entities.User, g *entities.Guardianship, token string) {
+	if uc.notifier == nil {
+		return
+	}
+	if err := uc.notifier.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationGuardianConsent,
+		Recipient: g.GuardianEmail,
+		Data: map[string]string{
+			"link":            linkWithToken(uc.consentURL, token),
+			"minor_email":     minor.Email,
+			"relationship":    string(g.Relationship),
+			"expires_in_days": strconv.Itoa(int(guardianConsentTTL.Hours() / 24)),
+		},
+	}); err != nil {
+		zap.L().Warn("failed to queue guardian consent email", zap.String("user_id", minor.ID), zap.Error(err))
+	}
+}
+
+// AcceptGuardianship dipanggil wali yang sudah login dengan token dari email undangan.
+// Akun wali harus memakai email yang diundang. Akun anak aktif jika emailnya sudah diverifikasi,
+// jika belum statusnya menjadi pending_verification.
+func (uc *GuardianUseCase) AcceptGuardianship(ctx context.Context, guardianID, token string) (*entities.Ward, error) {
+	g, err := uc.repo.FindByConsentToken(ctx, auth.HashOpaqueToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if g == nil || g.Status != entities.GuardianshipPending || now.After(g.ConsentExpiresAt) {
+		return nil, entities.ErrInvalidToken
+	}
+
+	guardian, err := uc.authUC.userRepo.FindByID(ctx, guardianID)
+	if err != nil {
+		return nil, err
+	}
+	if guardian == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	if !strings.EqualFold(guardian.Email, g.GuardianEmail) {
+		return nil, entities.ErrGuardianEmailMismatch
+	}
+	if guardian.IsMinorAt(now) {
+		return nil, entities.ErrGuardianNotAdult
+	}
+
+	minor, err := uc.authUC.userRepo.FindByID(ctx, g.MinorID)
+	if err != nil {
+		return nil, err
+	}
+	if minor == nil {
+		return nil, entities.ErrUserNotFound
+	}
+
+	next := entities.StatusActive
+	if uc.authUC.RequiresEmailVerification(minor) {
+		next = entities.StatusPendingVerification
+	}
+	linked := newDomainEvent(entities.EventGuardianLinked, minor.ID, entities.GuardianLinkedPayload{
+		MinorID:      minor.ID,
+		GuardianID:   guardian.ID,
+		Relationship: string(g.Relationship),
+	})
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		if err := uc.repo.ActivateGuardianship(ctx, g.ID, guardian.ID, now); err != nil {
+			return err
+		}
+		if minor.Status != entities.StatusPendingGuardianConsent {
+			return nil
+		}
+		return uc.authUC.userRepo.SetStatus(ctx, minor.ID, entities.StatusChange{
+			Status:    next,
+			Reason:    "guardian_consent",
+			ChangedAt: now,
+		})
+	}, linked); err != nil {
+		return nil, err
+	}
+	uc.auditGuardian(ctx, entities.AuditGuardianLinked, guardian.ID, minor.ID, map[string]string{
+		"relationship": string(g.Relationship),
+	})
+
+	if minor.Status == entities.StatusPendingGuardianConsent {
+		minor.Status = next
+	}
+	g.GuardianID = guardian.ID
+	g.Status = entities.GuardianshipActive
+	g.ConsentTokenHash = ""
+	g.ConsentedAt = &now
+	return &entities.Ward{User: minor, Guardianship: g}, nil
+}
+
+// ListWards mengembalikan semua akun anak yang dikelola wali
+func (uc *GuardianUseCase) ListWards(ctx context.Context, guardianID string) ([]*entities.Ward, error) {
+	guardianships, err := uc.repo.ListActiveByGuardian(ctx, guardianID)
+	if err != nil {
+		return nil, err
+	}
+	wards := make([]*entities.Ward, 0, len(guardianships))
+	for _, g := range guardianships {
+		minor, err := uc.authUC.userRepo.FindByID(ctx, g.MinorID)
+		if err != nil {
+			return nil, err
+		}
+		// Perwalian berakhir saat anak mencapai usia dewasa
+		if minor == nil || !minor.IsMinorAt(time.Now()) {
+			continue
+		}
+		wards = append(wards, &entities.Ward{User: minor, Guardianship: g})
+	}
+	return wards, nil
+}
+
+// GetWard mengembalikan ErrGuardianshipNotFound jika guardianID bukan wali aktif minorID
+// atau minorID sudah dewasa
+func (uc *GuardianUseCase) GetWard(ctx context.Context, guardianID, minorID string) (*entities.Ward, error) {
+	g, err := uc.repo.FindActive(ctx, guardianID, minorID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, entities.ErrGuardianshipNotFound
+	}
+	minor, err := uc.authUC.userRepo.FindByID(ctx, minorID)
+	if err != nil {
+		return nil, err
+	}
+	if minor == nil || !minor.IsMinorAt(time.Now()) {
+		return nil, entities.ErrGuardianshipNotFound
+	}
+	return &entities.Ward{User: minor, Guardianship: g}, nil
+}
+
+// SetWardStatus : wali hanya boleh menangguhkan atau mengaktifkan kembali akun anak.
+// Penghapusan dan deaktivasi tetap lewat admin.
+func (uc *GuardianUseCase) SetWardStatus(ctx context.Context, guardianID, minorID string, status entities.UserStatus, reason string) error {
+	if status != entities.StatusActive && status != entities.StatusSuspended {
+		return entities.ErrInvalidStatusTransition
+	}
+	ward, err := uc.GetWard(ctx, guardianID, minorID)
+	if err != nil {
+		return err
+	}
+	if !ward.User.Status.CanTransitionTo(status) {
+		return entities.ErrInvalidStatusTransition
+	}
+
+	var events []*entities.DomainEvent
+	if status == entities.StatusSuspended {
+		events = append(events, newDomainEvent(entities.EventUserSuspended, minorID, entities.UserSuspendedPayload{
+			UserID: minorID,
+			Reason: reason,
+		}))
+	}
+	change := entities.StatusChange{Status: status, Reason: reason, ChangedAt: time.Now().UTC()}
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.authUC.userRepo.SetStatus(ctx, minorID, change)
+	}, events...); err != nil {
+		return err
+	}
+	uc.auditGuardian(ctx, entities.AuditWardStatusChanged, guardianID, minorID, map[string]string{
+		"from":   string(ward.User.Status),
+		"to":     string(status),
+		"reason": reason,
+	})
+
+	if status == entities.StatusSuspended {
+		if err := uc.authUC.tokenRepo.RevokeAllUserTokens(ctx, minorID); err != nil {
+			return err
+		}
+		publishRevocation(ctx, uc.authUC.publisher, userRevocation(entities.RevocationUserSuspended, minorID))
+	}
+	return nil
+}
+
+// LogoutWard mengeluarkan akun anak dari semua device
+func (uc *GuardianUseCase) LogoutWard(ctx context.Context, guardianID, minorID string) error {
+	if _, err := uc.GetWard(ctx, guardianID, minorID); err != nil {
+		return err
+	}
+	if err := uc.authUC.revokeUserSessions(ctx, minorID); err != nil {
+		return err
+	}
+	uc.auditGuardian(ctx, entities.AuditWardLogout, guardianID, minorID, nil)
+	return nil
+}
+
+func (uc *GuardianUseCase) auditGuardian(ctx context.Context, action entities.AuditAction, guardianID, minorID string, metadata map[string]string) {
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    action,
+		ActorID:   guardianID,
+		SubjectID: minorID,
+		Metadata:  metadata,
+	})
+}
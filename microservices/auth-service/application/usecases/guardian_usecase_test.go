@@ -0,0 +1,316 @@
+package usecases_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// memoryGuardianRepository menyimpan guardianship di memori, cukup untuk alur undangan - persetujuan
+type memoryGuardianRepository struct {
+	guardianships map[string]*entities.Guardianship
+}
+
+func newMemoryGuardianRepository() *memoryGuardianRepository {
+	return &memoryGuardianRepository{guardianships: make(map[string]*entities.Guardianship)}
+}
+
+func (r *memoryGuardianRepository) CreateGuardianship(ctx context.Context, g *entities.Guardianship) error {
+	copied := *g
+	r.guardianships[g.ID] = &copied
+	return nil
+}
+
+func (r *memoryGuardianRepository) FindByConsentToken(ctx context.Context, tokenHash string) (*entities.Guardianship, error) {
+	for _, g := range r.guardianships {
+		if g.ConsentTokenHash != "" && g.ConsentTokenHash == tokenHash {
+			copied := *g
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryGuardianRepository) ActivateGuardianship(ctx context.Context, id, guardianID string, consentedAt time.Time) error {
+	g, ok := r.guardianships[id]
+	if !ok || g.Status != entities.GuardianshipPending {
+		return entities.ErrGuardianshipNotFound
+	}
+	g.GuardianID = guardianID
+	g.Status = entities.GuardianshipActive
+	g.ConsentTokenHash = ""
+	g.ConsentedAt = &consentedAt
+	return nil
+}
+
+func (r *memoryGuardianRepository) FindActive(ctx context.Context, guardianID, minorID string) (*entities.Guardianship, error) {
+	for _, g := range r.guardianships {
+		if g.Status == entities.GuardianshipActive && g.GuardianID == guardianID && g.MinorID == minorID {
+			copied := *g
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryGuardianRepository) ListActiveByGuardian(ctx context.Context, guardianID string) ([]*entities.Guardianship, error) {
+	var result []*entities.Guardianship
+	for _, g := range r.guardianships {
+		if g.Status == entities.GuardianshipActive && g.GuardianID == guardianID {
+			copied := *g
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func yearsAgo(years int) time.Time {
+	return time.Now().UTC().AddDate(-years, 0, -1)
+}
+
+func TestGuardianUseCase_Register_Adult(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryGuardianRepository()
+	mockNotifier := new(MockNotifier)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewGuardianUseCase(authUC, repo, mockNotifier, "https://app.example.com/consent")
+
+	mockUserRepo.On("FindByEmail", mock.Anything, "adult@example.com").Return((*entities.User)(nil), nil)
+	mockUserRepo.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.Status == entities.StatusActive && u.DateOfBirth != nil
+	})).Return(nil)
+
+	user, err := uc.Register(context.Background(), "adult@example.com", "password123", entities.ClientRole, yearsAgo(30), "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusActive, user.Status)
+	assert.Empty(t, repo.guardianships)
+	mockNotifier.AssertNotCalled(t, "Notify")
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestGuardianUseCase_Register_MinorValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		role          entities.Role
+		dob           time.Time
+		guardianEmail string
+		wantErr       error
+	}{
+		{"future date of birth", entities.ClientRole, time.Now().AddDate(0, 0, 2), "parent@example.com", entities.ErrInvalidDateOfBirth},
+		{"missing guardian", entities.ClientRole, yearsAgo(15), "", entities.ErrGuardianRequired},
+		{"own email as guardian", entities.ClientRole, yearsAgo(15), "KID@example.com", entities.ErrGuardianRequired},
+		{"minor psychologist", entities.PsychologistRole, yearsAgo(15), "parent@example.com", entities.ErrInvalidRole},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(MockUserRepository)
+			authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+			uc := usecases.NewGuardianUseCase(authUC, newMemoryGuardianRepository(), new(MockNotifier), "")
+
+			mockUserRepo.On("FindByEmail", mock.Anything, "kid@example.com").Return((*entities.User)(nil), nil)
+
+			_, err := uc.Register(context.Background(), "kid@example.com", "password123", tt.role, tt.dob, tt.guardianEmail, "")
+
+			assert.Equal(t, tt.wantErr, err)
+			mockUserRepo.AssertNotCalled(t, "CreateUser")
+		})
+	}
+}
+
+func TestGuardianUseCase_ConsentFlow(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := newMemoryGuardianRepository()
+	mockNotifier := new(MockNotifier)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithGuardianRepository(repo),
+	)
+	uc := usecases.NewGuardianUseCase(authUC, repo, mockNotifier, "https://app.example.com/consent")
+	ctx := context.Background()
+
+	// 1. Anak mendaftar, akun menunggu persetujuan dan undangan dikirim ke wali
+	var minor *entities.User
+	mockUserRepo.On("FindByEmail", mock.Anything, "kid@example.com").Return((*entities.User)(nil), nil).Once()
+	mockUserRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*entities.User")).
+		Run(func(args mock.Arguments) { minor = args.Get(1).(*entities.User) }).Return(nil)
+	var sent *entities.Notification
+	mockNotifier.On("Notify", mock.Anything, mock.AnythingOfType("*entities.Notification")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*entities.Notification) }).Return(nil)
+
+	_, err := uc.Register(ctx, "kid@example.com", "password123", entities.ClientRole, yearsAgo(14), "parent@example.com", entities.RelationshipParent)
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusPendingGuardianConsent, minor.Status)
+	assert.Equal(t, entities.ErrGuardianConsentRequired, minor.StatusError())
+	require.NotNil(t, sent)
+	assert.Equal(t, entities.NotificationGuardianConsent, sent.Kind)
+	assert.Equal(t, "parent@example.com", sent.Recipient)
+	assert.Equal(t, "kid@example.com", sent.Data["minor_email"])
+
+	link, err := url.Parse(sent.Data["link"])
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+	require.NotEmpty(t, token)
+
+	// 2. Akun lain tidak bisa memakai undangan
+	stranger := &entities.User{ID: "stranger-1", Email: "stranger@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, stranger.ID).Return(stranger, nil)
+	_, err = uc.AcceptGuardianship(ctx, stranger.ID, token)
+	assert.Equal(t, entities.ErrGuardianEmailMismatch, err)
+
+	// 3. Wali yang diundang menyetujui, akun anak aktif
+	hash, _ := auth.Argon2Hash("guardian-pass")
+	guardian := &entities.User{ID: "parent-1", Email: "Parent@example.com", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, guardian.ID).Return(guardian, nil)
+	mockUserRepo.On("FindByID", mock.Anything, minor.ID).Return(minor, nil)
+	mockUserRepo.On("SetStatus", mock.Anything, minor.ID, mock.MatchedBy(func(c entities.StatusChange) bool {
+		return c.Status == entities.StatusActive
+	})).Return(nil).Once()
+
+	ward, err := uc.AcceptGuardianship(ctx, guardian.ID, token)
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusActive, ward.User.Status)
+	assert.Equal(t, entities.GuardianshipActive, ward.Guardianship.Status)
+
+	// Token hangus setelah dipakai
+	_, err = uc.AcceptGuardianship(ctx, guardian.ID, token)
+	assert.Equal(t, entities.ErrInvalidToken, err)
+
+	// 4. Wali melihat dan mengelola akun anak, token wali membawa claim guardian_of
+	wards, err := uc.ListWards(ctx, guardian.ID)
+	require.NoError(t, err)
+	require.Len(t, wards, 1)
+	assert.Equal(t, minor.ID, wards[0].User.ID)
+
+	mockUserRepo.On("FindByEmail", mock.Anything, guardian.Email).Return(guardian, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(guardian.ID)).Return(nil)
+	accessToken, _, err := authUC.Login(ctx, guardian.Email, "guardian-pass")
+	require.NoError(t, err)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{minor.ID}, claims.GuardianOf)
+	assert.False(t, claims.Minor)
+
+	mockUserRepo.On("SetStatus", mock.Anything, minor.ID, mock.MatchedBy(func(c entities.StatusChange) bool {
+		return c.Status == entities.StatusSuspended
+	})).Return(nil).Once()
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, minor.ID).Return(nil)
+	assert.NoError(t, uc.SetWardStatus(ctx, guardian.ID, minor.ID, entities.StatusSuspended, "screen time"))
+	mockTokenRepo.AssertCalled(t, "RevokeAllUserTokens", mock.Anything, minor.ID)
+}
+
+func TestGuardianUseCase_ConsentDoesNotSkipEmailVerification(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryGuardianRepository()
+	mockNotifier := new(MockNotifier)
+	sender := newOutboxSender()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)),
+	)
+	uc := usecases.NewGuardianUseCase(authUC, repo, mockNotifier, "https://app.example.com/consent")
+	ctx := context.Background()
+
+	var minor *entities.User
+	mockUserRepo.On("FindByEmail", mock.Anything, "kid@example.com").Return((*entities.User)(nil), nil).Once()
+	mockUserRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*entities.User")).
+		Run(func(args mock.Arguments) { minor = args.Get(1).(*entities.User) }).Return(nil)
+	var sent *entities.Notification
+	mockNotifier.On("Notify", mock.Anything, mock.AnythingOfType("*entities.Notification")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*entities.Notification) }).Return(nil)
+
+	_, err := uc.Register(ctx, "kid@example.com", "password123", entities.ClientRole, yearsAgo(14), "parent@example.com", entities.RelationshipParent)
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusPendingGuardianConsent, minor.Status)
+	// Menunggu wali tidak menghapus kewajiban verifikasi email
+	assert.True(t, authUC.RequiresEmailVerification(minor))
+	challengeID, err := authUC.SendEmailVerification(ctx, minor)
+	require.NoError(t, err)
+
+	link, err := url.Parse(sent.Data["link"])
+	require.NoError(t, err)
+	guardian := &entities.User{ID: "parent-1", Email: "parent@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, guardian.ID).Return(guardian, nil)
+	mockUserRepo.On("FindByID", mock.Anything, minor.ID).Return(minor, nil)
+	mockUserRepo.On("SetStatus", mock.Anything, minor.ID, mock.MatchedBy(func(c entities.StatusChange) bool {
+		return c.Status == entities.StatusPendingVerification
+	})).Return(nil).Once()
+
+	ward, err := uc.AcceptGuardianship(ctx, guardian.ID, link.Query().Get("token"))
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusPendingVerification, ward.User.Status)
+	assert.Equal(t, entities.ErrAccountPendingVerification, ward.User.StatusError())
+
+	// Verifikasi email menyelesaikan gerbang terakhir
+	mockUserRepo.On("MarkEmailVerified", mock.Anything, minor.ID, mock.AnythingOfType("time.Time")).Return(nil).Once()
+	require.NoError(t, authUC.VerifyEmail(ctx, challengeID, sender.code("kid@example.com")))
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestAuthUseCase_GuardianOfExcludesAdultWards(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := newMemoryGuardianRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithGuardianRepository(repo))
+	ctx := context.Background()
+
+	childDOB, adultDOB := yearsAgo(12), yearsAgo(19)
+	child := &entities.User{ID: "kid-1", Role: entities.ClientRole, Status: entities.StatusActive, DateOfBirth: &childDOB}
+	grownUp := &entities.User{ID: "kid-2", Role: entities.ClientRole, Status: entities.StatusActive, DateOfBirth: &adultDOB}
+	mockUserRepo.On("FindByID", mock.Anything, child.ID).Return(child, nil)
+	mockUserRepo.On("FindByID", mock.Anything, grownUp.ID).Return(grownUp, nil)
+	consentedAt := time.Now().AddDate(-6, 0, 0)
+	for id, minorID := range map[string]string{"g-1": child.ID, "g-2": grownUp.ID} {
+		repo.guardianships[id] = &entities.Guardianship{
+			ID: id, MinorID: minorID, GuardianID: "parent-1", Status: entities.GuardianshipActive, ConsentedAt: &consentedAt,
+		}
+	}
+
+	hash, _ := auth.Argon2Hash("guardian-pass")
+	guardian := &entities.User{ID: "parent-1", Email: "parent@example.com", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByEmail", mock.Anything, guardian.Email).Return(guardian, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(guardian.ID)).Return(nil)
+
+	accessToken, _, err := authUC.Login(ctx, guardian.Email, "guardian-pass")
+	require.NoError(t, err)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, []string{child.ID}, claims.GuardianOf)
+}
+
+func TestGuardianUseCase_WardAccessRequiresActiveGuardianship(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := newMemoryGuardianRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+	uc := usecases.NewGuardianUseCase(authUC, repo, new(MockNotifier), "")
+	ctx := context.Background()
+
+	dob := yearsAgo(12)
+	minor := &entities.User{ID: "kid-1", Email: "kid@example.com", Role: entities.ClientRole, Status: entities.StatusActive, DateOfBirth: &dob}
+	mockUserRepo.On("FindByID", mock.Anything, minor.ID).Return(minor, nil)
+	consentedAt := time.Now()
+	repo.guardianships["g-1"] = &entities.Guardianship{
+		ID: "g-1", MinorID: minor.ID, GuardianID: "parent-1", Status: entities.GuardianshipActive, ConsentedAt: &consentedAt,
+	}
+
+	_, err := uc.GetWard(ctx, "someone-else", minor.ID)
+	assert.Equal(t, entities.ErrGuardianshipNotFound, err)
+	assert.Equal(t, entities.ErrGuardianshipNotFound, uc.LogoutWard(ctx, "someone-else", minor.ID))
+
+	// Wali tidak bisa menghapus atau menonaktifkan akun anak
+	err = uc.SetWardStatus(ctx, "parent-1", minor.ID, entities.StatusDeleted, "")
+	assert.Equal(t, entities.ErrInvalidStatusTransition, err)
+
+	mockTokenRepo.On("RevokeAllUserTokens", mock.Anything, minor.ID).Return(nil)
+	assert.NoError(t, uc.LogoutWard(ctx, "parent-1", minor.ID))
+	mockUserRepo.AssertNotCalled(t, "SetStatus")
+}
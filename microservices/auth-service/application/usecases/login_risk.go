@@ -0,0 +1,152 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"time"
+)
+
+// Bobot setiap sinyal risiko, total dibatasi 100
+const (
+	riskWeightNewDevice        = 30
+	riskWeightNewCountry       = 30
+	riskWeightImpossibleTravel = 50
+
+	// Jarak di bawah ini diabaikan karena akurasi GeoIP hanya sebatas kota/region
+	minTravelDistanceKm = 500
+)
+
+// LoginRiskPolicy : ambang risiko tinggi dan apakah login berisiko tinggi wajib step-up MFA
+type LoginRiskPolicy struct {
+	HighRiskThreshold int
+	RequireStepUp     bool
+	MaxTravelSpeedKmh float64 // di atas kecepatan pesawat komersial dianggap impossible travel
+}
+
+func DefaultLoginRiskPolicy() LoginRiskPolicy {
+	return LoginRiskPolicy{
+		HighRiskThreshold: 60,
+		MaxTravelSpeedKmh: 900,
+	}
+}
+
+// LoginRiskAssessor menilai login berdasarkan device, negara dan lokasi login sebelumnya.
+// locator boleh nil, maka hanya deteksi device baru yang aktif.
+type LoginRiskAssessor struct {
+	repo    repositories.LoginHistoryRepository
+	locator services.GeoLocator
+	policy  LoginRiskPolicy
+}
+
+func NewLoginRiskAssessor(repo repositories.LoginHistoryRepository, locator services.GeoLocator, policy LoginRiskPolicy) *LoginRiskAssessor {
+	return &LoginRiskAssessor{repo: repo, locator: locator, policy: policy}
+}
+
+// Assess menghitung skor risiko login user dengan metadata request di context
+func (a *LoginRiskAssessor) Assess(ctx context.Context, userID string) (*entities.LoginRisk, error) {
+	info := entities.RequestInfoFromContext(ctx)
+	risk := &entities.LoginRisk{Fingerprint: entities.DeviceFingerprint(info)}
+
+	if a.locator != nil && info.IP != "" {
+		location, err := a.locator.Locate(info.IP)
+		if err != nil {
+			return nil, err
+		}
+		risk.Location = location
+	}
+
+	last, err := a.repo.LastLogin(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Login pertama menjadi baseline, tidak ada yang bisa dibandingkan
+	if last == nil {
+		risk.FirstLogin = true
+		return risk, nil
+	}
+
+	device, err := a.repo.FindDevice(ctx, userID, risk.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if device == nil {
+		risk.Reasons = append(risk.Reasons, entities.RiskNewDevice)
+		risk.Score += riskWeightNewDevice
+	}
+
+	if risk.Location != nil && risk.Location.Country != "" {
+		seen, err := a.repo.HasLoginFromCountry(ctx, userID, risk.Location.Country)
+		if err != nil {
+			return nil, err
+		}
+		if !seen {
+			risk.Reasons = append(risk.Reasons, entities.RiskNewCountry)
+			risk.Score += riskWeightNewCountry
+		}
+	}
+
+	if a.impossibleTravel(last, risk.Location, time.Now()) {
+		risk.Reasons = append(risk.Reasons, entities.RiskImpossibleTravel)
+		risk.Score += riskWeightImpossibleTravel
+	}
+
+	if risk.Score > 100 {
+		risk.Score = 100
+	}
+	return risk, nil
+}
+
+func (a *LoginRiskAssessor) impossibleTravel(last *entities.LoginRecord, current *entities.GeoLocation, now time.Time) bool {
+	if last.Location == nil || current == nil {
+		return false
+	}
+	distance := last.Location.DistanceKm(current)
+	if distance < minTravelDistanceKm {
+		return false
+	}
+	// Minimal satu menit agar dua login beruntun tidak membagi dengan nol
+	hours := now.Sub(last.OccurredAt).Hours()
+	if hours < 1.0/60 {
+		hours = 1.0 / 60
+	}
+	return distance/hours > a.policy.MaxTravelSpeedKmh
+}
+
+// RequiresStepUp true jika risiko tinggi dan kebijakan mewajibkan verifikasi tambahan
+func (a *LoginRiskAssessor) RequiresStepUp(risk *entities.LoginRisk) bool {
+	return a.policy.RequireStepUp && risk.Score >= a.policy.HighRiskThreshold
+}
+
+// Record menyimpan device dan riwayat login setelah login berhasil
+func (a *LoginRiskAssessor) Record(ctx context.Context, userID string, risk *entities.LoginRisk) error {
+	info := entities.RequestInfoFromContext(ctx)
+	now := time.Now().UTC()
+
+	var country string
+	if risk.Location != nil {
+		country = risk.Location.Country
+	}
+	if err := a.repo.UpsertDevice(ctx, &entities.KnownDevice{
+		UserID:      userID,
+		Fingerprint: risk.Fingerprint,
+		UserAgent:   info.UserAgent,
+		AppVersion:  info.AppVersion,
+		LastIP:      info.IP,
+		LastCountry: country,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	}); err != nil {
+		return err
+	}
+	return a.repo.RecordLogin(ctx, &entities.LoginRecord{
+		UserID:      userID,
+		Fingerprint: risk.Fingerprint,
+		IP:          info.IP,
+		Location:    risk.Location,
+		RiskScore:   risk.Score,
+		RiskReasons: risk.Reasons,
+		OccurredAt:  now,
+	})
+}
@@ -0,0 +1,220 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type MockLoginHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockLoginHistoryRepository) FindDevice(ctx context.Context, userID, fingerprint string) (*entities.KnownDevice, error) {
+	args := m.Called(ctx, userID, fingerprint)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.KnownDevice), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) UpsertDevice(ctx context.Context, device *entities.KnownDevice) error {
+	args := m.Called(ctx, device)
+	return args.Error(0)
+}
+
+func (m *MockLoginHistoryRepository) LastLogin(ctx context.Context, userID string) (*entities.LoginRecord, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.LoginRecord), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) HasLoginFromCountry(ctx context.Context, userID, country string) (bool, error) {
+	args := m.Called(ctx, userID, country)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoginHistoryRepository) RecordLogin(ctx context.Context, record *entities.LoginRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+// staticLocator memetakan IP ke lokasi tetap tanpa database GeoIP
+type staticLocator map[string]*entities.GeoLocation
+
+func (l staticLocator) Locate(ip string) (*entities.GeoLocation, error) {
+	return l[ip], nil
+}
+
+var (
+	jakarta = &entities.GeoLocation{Country: "ID", City: "Jakarta", Latitude: -6.2088, Longitude: 106.8456}
+	london  = &entities.GeoLocation{Country: "GB", City: "London", Latitude: 51.5074, Longitude: -0.1278}
+)
+
+func loginContext(ip, deviceID string) context.Context {
+	return entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{IP: ip, DeviceID: deviceID, UserAgent: "app/1.0"})
+}
+
+func TestLoginRiskAssessor_FirstLoginIsBaseline(t *testing.T) {
+	mockRepo := new(MockLoginHistoryRepository)
+	assessor := usecases.NewLoginRiskAssessor(mockRepo, staticLocator{"1.1.1.1": jakarta}, usecases.DefaultLoginRiskPolicy())
+
+	mockRepo.On("LastLogin", mock.Anything, "user-123").Return(nil, nil)
+
+	risk, err := assessor.Assess(loginContext("1.1.1.1", "device-a"), "user-123")
+
+	assert.NoError(t, err)
+	assert.True(t, risk.FirstLogin)
+	assert.Zero(t, risk.Score)
+	assert.Empty(t, risk.Reasons)
+	mockRepo.AssertNotCalled(t, "FindDevice")
+}
+
+func TestLoginRiskAssessor_NewDevice(t *testing.T) {
+	mockRepo := new(MockLoginHistoryRepository)
+	assessor := usecases.NewLoginRiskAssessor(mockRepo, staticLocator{"1.1.1.1": jakarta}, usecases.DefaultLoginRiskPolicy())
+
+	mockRepo.On("LastLogin", mock.Anything, "user-123").Return(&entities.LoginRecord{
+		UserID: "user-123", Location: jakarta, OccurredAt: time.Now().Add(-time.Hour),
+	}, nil)
+	mockRepo.On("FindDevice", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(nil, nil)
+	mockRepo.On("HasLoginFromCountry", mock.Anything, "user-123", "ID").Return(true, nil)
+
+	risk, err := assessor.Assess(loginContext("1.1.1.1", "device-b"), "user-123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.RiskReason{entities.RiskNewDevice}, risk.Reasons)
+	assert.Equal(t, 30, risk.Score)
+}
+
+func TestLoginRiskAssessor_ImpossibleTravel(t *testing.T) {
+	mockRepo := new(MockLoginHistoryRepository)
+	assessor := usecases.NewLoginRiskAssessor(mockRepo, staticLocator{"2.2.2.2": london}, usecases.DefaultLoginRiskPolicy())
+
+	// Jakarta -> London (~11.700 km) dalam 2 jam
+	mockRepo.On("LastLogin", mock.Anything, "user-123").Return(&entities.LoginRecord{
+		UserID: "user-123", Location: jakarta, OccurredAt: time.Now().Add(-2 * time.Hour),
+	}, nil)
+	mockRepo.On("FindDevice", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(&entities.KnownDevice{}, nil)
+	mockRepo.On("HasLoginFromCountry", mock.Anything, "user-123", "GB").Return(false, nil)
+
+	risk, err := assessor.Assess(loginContext("2.2.2.2", "device-a"), "user-123")
+
+	assert.NoError(t, err)
+	assert.True(t, risk.Has(entities.RiskNewCountry))
+	assert.True(t, risk.Has(entities.RiskImpossibleTravel))
+	assert.False(t, risk.Has(entities.RiskNewDevice))
+	assert.Equal(t, 80, risk.Score)
+}
+
+func TestLoginRiskAssessor_TravelWithPlausibleSpeed(t *testing.T) {
+	mockRepo := new(MockLoginHistoryRepository)
+	assessor := usecases.NewLoginRiskAssessor(mockRepo, staticLocator{"2.2.2.2": london}, usecases.DefaultLoginRiskPolicy())
+
+	mockRepo.On("LastLogin", mock.Anything, "user-123").Return(&entities.LoginRecord{
+		UserID: "user-123", Location: jakarta, OccurredAt: time.Now().Add(-20 * time.Hour),
+	}, nil)
+	mockRepo.On("FindDevice", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(&entities.KnownDevice{}, nil)
+	mockRepo.On("HasLoginFromCountry", mock.Anything, "user-123", "GB").Return(true, nil)
+
+	risk, err := assessor.Assess(loginContext("2.2.2.2", "device-a"), "user-123")
+
+	assert.NoError(t, err)
+	assert.Empty(t, risk.Reasons)
+}
+
+func TestAuthUseCase_Login_HighRiskRequiresStepUp(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockHistory := new(MockLoginHistoryRepository)
+	mockOutbox := new(MockOutboxRepository)
+	policy := usecases.DefaultLoginRiskPolicy()
+	policy.RequireStepUp = true
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithLoginRiskAssessor(usecases.NewLoginRiskAssessor(mockHistory, staticLocator{"2.2.2.2": london}, policy)),
+		usecases.WithOutbox(usecases.NewOutbox(&fakeTransactor{}, mockOutbox)),
+	)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{ID: "user-123", Email: "user@example.com", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockHistory.On("LastLogin", mock.Anything, "user-123").Return(&entities.LoginRecord{
+		UserID: "user-123", Location: jakarta, OccurredAt: time.Now().Add(-time.Hour),
+	}, nil)
+	mockHistory.On("FindDevice", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(nil, nil)
+	mockHistory.On("HasLoginFromCountry", mock.Anything, "user-123", "GB").Return(false, nil)
+
+	var alert entities.LoginAlertPayload
+	mockOutbox.On("Enqueue", mock.Anything, mock.MatchedBy(func(events []*entities.DomainEvent) bool {
+		return len(events) == 1 && events[0].Type == entities.EventLoginAlert
+	})).Run(func(args mock.Arguments) {
+		_ = json.Unmarshal(args.Get(1).([]*entities.DomainEvent)[0].Payload, &alert)
+	}).Return(nil)
+
+	_, _, err = authUC.Login(loginContext("2.2.2.2", "device-b"), user.Email, "password123")
+
+	assert.Equal(t, entities.ErrStepUpRequired, err)
+	assert.True(t, alert.Blocked)
+	assert.Equal(t, "GB", alert.Country)
+	assert.Equal(t, 100, alert.RiskScore)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+	mockHistory.AssertNotCalled(t, "RecordLogin")
+}
+
+func TestAuthUseCase_Login_NewDeviceAlertsAndRecordsHistory(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockHistory := new(MockLoginHistoryRepository)
+	mockOutbox := new(MockOutboxRepository)
+	mockNotifier := new(MockNotifier)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithLoginRiskAssessor(usecases.NewLoginRiskAssessor(mockHistory, staticLocator{"1.1.1.1": jakarta}, usecases.DefaultLoginRiskPolicy())),
+		usecases.WithOutbox(usecases.NewOutbox(&fakeTransactor{}, mockOutbox)),
+		usecases.WithNotifier(mockNotifier),
+	)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{ID: "user-123", Email: "user@example.com", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor("user-123")).Return(nil)
+	mockHistory.On("LastLogin", mock.Anything, "user-123").Return(&entities.LoginRecord{
+		UserID: "user-123", Location: jakarta, OccurredAt: time.Now().Add(-time.Hour),
+	}, nil)
+	mockHistory.On("FindDevice", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(nil, nil)
+	mockHistory.On("HasLoginFromCountry", mock.Anything, "user-123", "ID").Return(true, nil)
+	mockHistory.On("UpsertDevice", mock.Anything, mock.MatchedBy(func(d *entities.KnownDevice) bool {
+		return d.UserID == "user-123" && d.LastIP == "1.1.1.1" && d.LastCountry == "ID"
+	})).Return(nil)
+	mockHistory.On("RecordLogin", mock.Anything, mock.MatchedBy(func(r *entities.LoginRecord) bool {
+		return r.RiskScore == 30 && r.Location == jakarta
+	})).Return(nil)
+	mockOutbox.On("Enqueue", mock.Anything, mock.MatchedBy(func(events []*entities.DomainEvent) bool {
+		return len(events) == 1 && events[0].Type == entities.EventLoginAlert
+	})).Return(nil).Once()
+	mockOutbox.On("Enqueue", mock.Anything, mock.MatchedBy(func(events []*entities.DomainEvent) bool {
+		return len(events) == 1 && events[0].Type == entities.EventUserLoggedIn
+	})).Return(nil).Once()
+	mockNotifier.On("Notify", mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.Kind == entities.NotificationLoginAlert && n.Recipient == user.Email &&
+			n.Data["country"] == "ID" && n.Data["blocked"] == "false"
+	})).Return(nil)
+
+	_, _, err = authUC.Login(loginContext("1.1.1.1", "device-b"), user.Email, "password123")
+
+	assert.NoError(t, err)
+	mockHistory.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
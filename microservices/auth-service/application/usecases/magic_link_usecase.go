@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/infrastructure/auth"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	magicLinkTTL = 15 * time.Minute
+	// Batas permintaan per email, berlaku sama untuk email terdaftar maupun tidak
+	magicLinkMaxRequests = 3
+	magicLinkRateWindow  = 15 * time.Minute
+)
+
+// MagicLinkUseCase menangani login tanpa password lewat link yang dikirim ke email user
+type MagicLinkUseCase struct {
+	authUC  *AuthUseCase
+	repo    repositories.MagicLinkRepository
+	queue   *NotificationQueue
+	linkURL string // URL aplikasi yang menerima token, token ditambahkan sebagai query "token"
+}
+
+// NewMagicLinkUseCase : email hanya dimasukkan ke antrean notifikasi, tidak dikirim langsung,
+// agar waktu respons tidak membedakan email terdaftar dan tidak terdaftar
+func NewMagicLinkUseCase(authUC *AuthUseCase, repo repositories.MagicLinkRepository, queue *NotificationQueue, linkURL string) *MagicLinkUseCase {
+	return &MagicLinkUseCase{
+		authUC:  authUC,
+		repo:    repo,
+		queue:   queue,
+		linkURL: linkURL,
+	}
+}
+
+// RequestMagicLink mengirim link login ke email user. Email yang tidak terdaftar atau akun
+// yang tidak aktif tidak menghasilkan error agar keberadaan akun tidak bocor; batas
+// permintaan dihitung per email sebelum user dicari.
+func (uc *MagicLinkUseCase) RequestMagicLink(ctx context.Context, email string) error {
+	n, err := uc.repo.CountRequest(ctx, auth.HashOpaqueToken(strings.ToLower(strings.TrimSpace(email))), magicLinkRateWindow)
+	if err != nil {
+		zap.L().Error("failed to count magic link requests", zap.Error(err))
+		return err
+	}
+	if n > magicLinkMaxRequests {
+		return entities.ErrMagicLinkRateLimited
+	}
+
+	user, err := uc.authUC.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		zap.L().Error("failed to look up magic link recipient", zap.Error(err))
+		return err
+	}
+	if user == nil || user.StatusError() != nil {
+		return nil
+	}
+
+	token, hash, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+	link := &entities.MagicLink{
+		TokenHash:         hash,
+		UserID:            user.ID,
+		DeviceFingerprint: entities.DeviceFingerprint(entities.RequestInfoFromContext(ctx)),
+		CreatedAt:         time.Now().UTC(),
+	}
+	if err := uc.repo.StoreMagicLink(ctx, link, magicLinkTTL); err != nil {
+		zap.L().Error("failed to store magic link", zap.String("user_id", user.ID), zap.Error(err))
+		return err
+	}
+
+	if err := uc.queue.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationMagicLink,
+		UserID:    user.ID,
+		Recipient: user.Email,
+		Data: map[string]string{
+			"token":              token,
+			"link":               linkWithToken(uc.linkURL, token),
+			"expires_in_minutes": strconv.Itoa(int(magicLinkTTL.Minutes())),
+		},
+	}); err != nil {
+		zap.L().Error("failed to enqueue magic link", zap.String("user_id", user.ID), zap.Error(err))
+		return err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditMagicLinkRequested, user.ID, nil)
+	return nil
+}
+
+// linkWithToken menambahkan token sebagai query "token" ke URL halaman aplikasi
+func linkWithToken(baseURL, token string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || baseURL == "" {
+		return ""
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// ConsumeMagicLink menukar token magic link dengan access/refresh token biasa.
+// Token langsung hangus setelah dicoba, termasuk jika device tidak cocok.
+func (uc *MagicLinkUseCase) ConsumeMagicLink(ctx context.Context, token string) (string, string, error) {
+	link, err := uc.repo.ConsumeMagicLink(ctx, auth.HashOpaqueToken(token))
+	if err != nil {
+		return "", "", err
+	}
+	if link == nil {
+		return "", "", entities.ErrInvalidToken
+	}
+	if link.DeviceFingerprint != entities.DeviceFingerprint(entities.RequestInfoFromContext(ctx)) {
+		uc.authUC.auditSelf(ctx, entities.AuditLoginFailed, link.UserID, map[string]string{"reason": "magic_link_device_mismatch"})
+		return "", "", entities.ErrInvalidToken
+	}
+
+	user, err := uc.authUC.userRepo.FindByID(ctx, link.UserID)
+	if err != nil || user == nil {
+		return "", "", entities.ErrInvalidToken
+	}
+	if err := user.StatusError(); err != nil {
+		return "", "", err
+	}
+	if user.PasswordResetRequired {
+		return "", "", entities.ErrPasswordResetRequired
+	}
+
+	return uc.authUC.completeLogin(ctx, user, entities.AuthMethodEmailLink, "magic_link")
+}
@@ -0,0 +1,175 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type MockMagicLinkRepository struct {
+	mock.Mock
+}
+
+func (m *MockMagicLinkRepository) StoreMagicLink(ctx context.Context, link *entities.MagicLink, ttl time.Duration) error {
+	args := m.Called(ctx, link, ttl)
+	return args.Error(0)
+}
+
+func (m *MockMagicLinkRepository) ConsumeMagicLink(ctx context.Context, tokenHash string) (*entities.MagicLink, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.MagicLink), args.Error(1)
+}
+
+func (m *MockMagicLinkRepository) CountRequest(ctx context.Context, recipientHash string, window time.Duration) (int, error) {
+	args := m.Called(ctx, recipientHash, window)
+	return args.Int(0), args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, notification *entities.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func deviceContext(deviceID string) context.Context {
+	return entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{DeviceID: deviceID})
+}
+
+func TestMagicLinkUseCase_RequestMagicLink_UnknownEmail(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRepo := new(MockMagicLinkRepository)
+	mockQueueRepo := new(MockNotificationRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewMagicLinkUseCase(authUC, mockRepo, usecases.NewNotificationQueue(mockQueueRepo), "https://app.example.com/magic")
+
+	mockRepo.On("CountRequest", mock.Anything, mock.AnythingOfType("string"), 15*time.Minute).Return(1, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "nobody@example.com").Return(nil, nil)
+
+	err := uc.RequestMagicLink(context.Background(), "nobody@example.com")
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "StoreMagicLink")
+	mockQueueRepo.AssertNotCalled(t, "Enqueue")
+}
+
+func TestMagicLinkUseCase_RequestMagicLink_RateLimitedPerEmail(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRepo := new(MockMagicLinkRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewMagicLinkUseCase(authUC, mockRepo, usecases.NewNotificationQueue(new(MockNotificationRepository)), "")
+
+	// Email dinormalisasi sebelum dihitung, jadi variasi huruf besar tidak menghindari batas
+	recipient := auth.HashOpaqueToken("ana@example.com")
+	mockRepo.On("CountRequest", mock.Anything, recipient, 15*time.Minute).Return(4, nil)
+
+	err := uc.RequestMagicLink(context.Background(), " Ana@Example.com")
+
+	assert.Equal(t, entities.ErrMagicLinkRateLimited, err)
+	mockUserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestMagicLinkUseCase_RequestMagicLink_RepositoryError(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRepo := new(MockMagicLinkRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewMagicLinkUseCase(authUC, mockRepo, usecases.NewNotificationQueue(new(MockNotificationRepository)), "")
+
+	dbErr := errors.New("connection refused")
+	mockRepo.On("CountRequest", mock.Anything, mock.AnythingOfType("string"), 15*time.Minute).Return(1, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "ana@example.com").Return(nil, dbErr)
+
+	assert.Equal(t, dbErr, uc.RequestMagicLink(context.Background(), "ana@example.com"))
+	mockRepo.AssertNotCalled(t, "StoreMagicLink")
+}
+
+func TestMagicLinkUseCase_RequestAndConsume(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockRepo := new(MockMagicLinkRepository)
+	mockQueueRepo := new(MockNotificationRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+	uc := usecases.NewMagicLinkUseCase(authUC, mockRepo, usecases.NewNotificationQueue(mockQueueRepo), "https://app.example.com/magic")
+
+	user := &entities.User{ID: "user-123", Email: "ana@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockRepo.On("CountRequest", mock.Anything, mock.AnythingOfType("string"), 15*time.Minute).Return(1, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+
+	var stored *entities.MagicLink
+	mockRepo.On("StoreMagicLink", mock.Anything, mock.AnythingOfType("*entities.MagicLink"), 15*time.Minute).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*entities.MagicLink) }).Return(nil)
+	var sent *entities.Notification
+	mockQueueRepo.On("Enqueue", mock.Anything, mock.AnythingOfType("*entities.Notification")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*entities.Notification) }).Return(nil)
+
+	err := uc.RequestMagicLink(deviceContext("phone-1"), user.Email)
+	assert.NoError(t, err)
+
+	token := sent.Data["token"]
+	assert.NotEmpty(t, token)
+	assert.Equal(t, entities.NotificationMagicLink, sent.Kind)
+	assert.Equal(t, user.Email, sent.Recipient)
+	assert.True(t, strings.HasPrefix(sent.Data["link"], "https://app.example.com/magic?token="))
+	// Hanya hash yang disimpan
+	assert.Equal(t, auth.HashOpaqueToken(token), stored.TokenHash)
+
+	mockRepo.On("ConsumeMagicLink", mock.Anything, stored.TokenHash).Return(stored, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(user, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.MatchedBy(func(s *entities.Session) bool {
+		return s.UserID == "user-123" && s.Auth.Has(entities.AuthMethodEmailLink)
+	})).Return(nil)
+
+	accessToken, refreshToken, err := uc.ConsumeMagicLink(deviceContext("phone-1"), token)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, accessToken)
+	assert.NotEmpty(t, refreshToken)
+	mockTokenRepo.AssertExpectations(t)
+}
+
+func TestMagicLinkUseCase_ConsumeMagicLink_OtherDevice(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockRepo := new(MockMagicLinkRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil)
+	uc := usecases.NewMagicLinkUseCase(authUC, mockRepo, usecases.NewNotificationQueue(new(MockNotificationRepository)), "")
+
+	link := &entities.MagicLink{
+		TokenHash:         auth.HashOpaqueToken("stolen-token"),
+		UserID:            "user-123",
+		DeviceFingerprint: entities.DeviceFingerprint(entities.RequestInfo{DeviceID: "phone-1"}),
+	}
+	mockRepo.On("ConsumeMagicLink", mock.Anything, link.TokenHash).Return(link, nil)
+
+	_, _, err := uc.ConsumeMagicLink(deviceContext("laptop-9"), "stolen-token")
+
+	assert.Equal(t, entities.ErrInvalidToken, err)
+	mockUserRepo.AssertNotCalled(t, "FindByID")
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+}
+
+func TestMagicLinkUseCase_ConsumeMagicLink_AlreadyUsed(t *testing.T) {
+	mockRepo := new(MockMagicLinkRepository)
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewMagicLinkUseCase(authUC, mockRepo, usecases.NewNotificationQueue(new(MockNotificationRepository)), "")
+
+	mockRepo.On("ConsumeMagicLink", mock.Anything, auth.HashOpaqueToken("used-token")).Return(nil, nil)
+
+	_, _, err := uc.ConsumeMagicLink(context.Background(), "used-token")
+
+	assert.Equal(t, entities.ErrInvalidToken, err)
+}
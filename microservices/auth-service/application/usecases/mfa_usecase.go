@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// Mengubah channel MFA (terutama menonaktifkan) maupun nomor telepon mensyaratkan autentikasi
+// baru-baru ini
+const mfaChangeMaxAuthAge = 10 * time.Minute
+
+// MFAUseCase mengelola nomor telepon terverifikasi dan channel MFA user
+type MFAUseCase struct {
+	authUC *AuthUseCase
+	otp    *OTPUseCase
+}
+
+func NewMFAUseCase(authUC *AuthUseCase, otp *OTPUseCase) *MFAUseCase {
+	return &MFAUseCase{authUC: authUC, otp: otp}
+}
+
+// StartPhoneVerification mengirim kode SMS ke nomor baru. Nomor baru tersimpan setelah dikonfirmasi.
+// Nomor telepon bisa menjadi faktor MFA, jadi authTime dari access token harus baru seperti SetMFAChannel.
+func (uc *MFAUseCase) StartPhoneVerification(ctx context.Context, userID, phone string, authTime time.Time) (string, error) {
+	if !entities.IsValidPhone(phone) {
+		return "", entities.ErrInvalidPhone
+	}
+	if err := requireRecentAuth(authTime); err != nil {
+		return "", err
+	}
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return "", entities.ErrUserNotFound
+	}
+	challenge := &entities.OTPChallenge{
+		UserID:      user.ID,
+		Purpose:     entities.OTPPurposePhoneVerification,
+		Channel:     entities.OTPChannelSMS,
+		Destination: phone,
+	}
+	if err := uc.otp.Issue(ctx, challenge); err != nil {
+		return "", err
+	}
+	return challenge.ID, nil
+}
+
+// ConfirmPhoneVerification mengganti nomor telepon user, juga mensyaratkan autentikasi baru-baru ini
+func (uc *MFAUseCase) ConfirmPhoneVerification(ctx context.Context, userID, challengeID, code string, authTime time.Time) error {
+	if err := requireRecentAuth(authTime); err != nil {
+		return err
+	}
+	challenge, err := uc.otp.Verify(ctx, challengeID, code, entities.OTPPurposePhoneVerification)
+	if err != nil {
+		return err
+	}
+	if challenge.UserID != userID {
+		return entities.ErrInvalidOTP
+	}
+	if err := uc.authUC.userRepo.SetPhone(ctx, userID, challenge.Destination, time.Now().UTC()); err != nil {
+		return err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditPhoneVerified, userID, nil)
+	return nil
+}
+
+// SetMFAChannel mengaktifkan MFA lewat sms/email, channel kosong menonaktifkan.
+// authTime diambil dari access token pemanggil.
+func (uc *MFAUseCase) SetMFAChannel(ctx context.Context, userID string, channel entities.OTPChannel, authTime time.Time) error {
+	if channel != "" && !channel.IsValid() {
+		return entities.ErrInvalidOTPChannel
+	}
+	if err := requireRecentAuth(authTime); err != nil {
+		return err
+	}
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return entities.ErrUserNotFound
+	}
+	if channel != "" {
+		if _, err := user.OTPDestination(channel); err != nil {
+			return err
+		}
+	}
+	if err := uc.authUC.userRepo.SetMFAChannel(ctx, userID, channel); err != nil {
+		return err
+	}
+	uc.authUC.auditSelf(ctx, entities.AuditMFAChannelChanged, userID, map[string]string{
+		"from": string(user.MFAChannel),
+		"to":   string(channel),
+	})
+	return nil
+}
+
+func requireRecentAuth(authTime time.Time) error {
+	if authTime.IsZero() || time.Since(authTime) > mfaChangeMaxAuthAge {
+		return entities.ErrStepUpRequired
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	notificationBatchSize   = 50
+	notificationClaimLease  = time.Minute
+	notificationMinBackoff  = 30 * time.Second
+	notificationMaxBackoff  = time.Hour
+	notificationMaxAttempts = 8 // ~1 jam sejak percobaan pertama sebelum masuk dead letter
+)
+
+// notificationTTL : kode dan tautan di notifikasi ini sudah tidak berlaku setelah TTL-nya,
+// sehingga notifikasi yang masih tertahan di antrean dibuang alih-alih dikirim terlambat
+var notificationTTL = map[entities.NotificationKind]time.Duration{
+	entities.NotificationVerificationCode: otpTTL,
+	entities.NotificationMagicLink:        magicLinkTTL,
+	entities.NotificationPasswordReset:    passwordResetTTL,
+}
+
+// NotificationDispatcher merender dan mengirim notifikasi dari antrean (at-least-once).
+// Beberapa instance boleh berjalan bersamaan karena notifikasi di-claim dengan lease.
+type NotificationDispatcher struct {
+	repo      repositories.NotificationRepository
+	renderer  services.MessageRenderer
+	transport services.MessageTransport
+	logger    *zap.Logger
+}
+
+func NewNotificationDispatcher(repo repositories.NotificationRepository, renderer services.MessageRenderer, transport services.MessageTransport, logger *zap.Logger) *NotificationDispatcher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &NotificationDispatcher{repo: repo, renderer: renderer, transport: transport, logger: logger}
+}
+
+// Run memanggil DispatchOnce lewat runPeriodically sampai ctx dibatalkan
+func (d *NotificationDispatcher) Run(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, d.logger, "notification dispatch failed", func(ctx context.Context) (bool, error) {
+		n, err := d.DispatchOnce(ctx)
+		return n == notificationBatchSize, err
+	})
+}
+
+// DispatchOnce mengirim satu batch dan mengembalikan jumlah notifikasi yang di-claim.
+// Template yang gagal dirender tidak akan berhasil jika diulang, langsung masuk dead letter,
+// begitu juga notifikasi yang melewati notificationTTL.
+func (d *NotificationDispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	notifications, err := d.repo.ClaimPending(ctx, notificationBatchSize, notificationClaimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, n := range notifications {
+		if ttl, ok := notificationTTL[n.Kind]; ok && time.Since(n.CreatedAt) >= ttl {
+			d.logger.Warn("notification expired before delivery",
+				zap.String("notification_id", n.ID),
+				zap.String("kind", string(n.Kind)),
+				zap.Int("attempts", n.Attempts),
+			)
+			if err := d.repo.MarkDead(ctx, n.ID, "expired"); err != nil {
+				return len(notifications), err
+			}
+			continue
+		}
+
+		message, err := d.renderer.Render(n)
+		if err != nil {
+			d.logger.Error("failed to render notification", zap.String("notification_id", n.ID), zap.String("kind", string(n.Kind)), zap.Error(err))
+			if err := d.repo.MarkDead(ctx, n.ID, err.Error()); err != nil {
+				return len(notifications), err
+			}
+			continue
+		}
+
+		if err := d.transport.Send(ctx, message); err != nil {
+			attempts := n.Attempts + 1
+			if attempts >= notificationMaxAttempts {
+				d.logger.Error("notification moved to dead letter",
+					zap.String("notification_id", n.ID),
+					zap.String("kind", string(n.Kind)),
+					zap.Int("attempts", attempts),
+					zap.Error(err),
+				)
+				if err := d.repo.MarkDead(ctx, n.ID, err.Error()); err != nil {
+					return len(notifications), err
+				}
+				continue
+			}
+			d.logger.Warn("failed to send notification",
+				zap.String("notification_id", n.ID),
+				zap.String("kind", string(n.Kind)),
+				zap.Int("attempts", attempts),
+				zap.Error(err),
+			)
+			next := time.Now().UTC().Add(notificationBackoff(attempts))
+			if err := d.repo.MarkFailed(ctx, n.ID, err.Error(), next); err != nil {
+				return len(notifications), err
+			}
+			continue
+		}
+
+		// Jika gagal di sini notifikasi bisa terkirim dua kali setelah lease habis
+		if err := d.repo.MarkSent(ctx, n.ID, time.Now().UTC()); err != nil {
+			return len(notifications), err
+		}
+	}
+	return len(notifications), nil
+}
+
+// notificationBackoff : 30s, 1m, 2m, ... maksimal 1 jam
+func notificationBackoff(attempts int) time.Duration {
+	backoff := notificationMinBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= notificationMaxBackoff {
+			return notificationMaxBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,144 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+)
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) Enqueue(ctx context.Context, notification *entities.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.Notification, error) {
+	args := m.Called(ctx, limit, lease)
+	return args.Get(0).([]*entities.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	args := m.Called(ctx, id, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkDead(ctx context.Context, id string, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+// subjectRenderer merender subject dari kind, gagal untuk kind yang tidak dikenal
+type subjectRenderer struct{}
+
+func (subjectRenderer) Render(n *entities.Notification) (*entities.Message, error) {
+	if n.Kind == "unknown" {
+		return nil, errors.New("no template")
+	}
+	return &entities.Message{To: n.Recipient, Subject: string(n.Kind)}, nil
+}
+
+type MockMessageTransport struct {
+	mock.Mock
+}
+
+func (m *MockMessageTransport) Send(ctx context.Context, message *entities.Message) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func toRecipient(to string) interface{} {
+	return mock.MatchedBy(func(m *entities.Message) bool { return m.To == to })
+}
+
+func TestNotificationQueue_Notify(t *testing.T) {
+	mockRepo := new(MockNotificationRepository)
+	queue := usecases.NewNotificationQueue(mockRepo)
+
+	mockRepo.On("Enqueue", mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.ID != "" && n.Status == entities.NotificationPending && n.Locale == "en" && !n.NextAttemptAt.IsZero()
+	})).Return(nil).Once()
+	mockRepo.On("Enqueue", mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.Locale == entities.DefaultLocale
+	})).Return(nil).Once()
+
+	// Bahasa diambil dari accept-language request, default jika tidak ada
+	ctx := entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{Locale: "en"})
+	assert.NoError(t, queue.Notify(ctx, &entities.Notification{Kind: entities.NotificationMagicLink, Recipient: "a@example.com"}))
+	assert.NoError(t, queue.Notify(context.Background(), &entities.Notification{Kind: entities.NotificationMagicLink, Recipient: "b@example.com"}))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNotificationDispatcher_DispatchOnce(t *testing.T) {
+	mockRepo := new(MockNotificationRepository)
+	mockTransport := new(MockMessageTransport)
+	dispatcher := usecases.NewNotificationDispatcher(mockRepo, subjectRenderer{}, mockTransport, nil)
+
+	ok := &entities.Notification{ID: "n-1", Kind: entities.NotificationMagicLink, Recipient: "ok@example.com", CreatedAt: time.Now()}
+	retry := &entities.Notification{ID: "n-2", Kind: entities.NotificationLoginAlert, Recipient: "retry@example.com", Attempts: 2}
+	exhausted := &entities.Notification{ID: "n-3", Kind: entities.NotificationLoginAlert, Recipient: "dead@example.com", Attempts: 7}
+	broken := &entities.Notification{ID: "n-4", Kind: "unknown", Recipient: "broken@example.com"}
+	mockRepo.On("ClaimPending", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*entities.Notification{ok, retry, exhausted, broken}, nil)
+
+	mockTransport.On("Send", mock.Anything, toRecipient("ok@example.com")).Return(nil)
+	mockTransport.On("Send", mock.Anything, toRecipient("retry@example.com")).Return(errors.New("connection refused"))
+	mockTransport.On("Send", mock.Anything, toRecipient("dead@example.com")).Return(errors.New("mailbox unavailable"))
+
+	mockRepo.On("MarkSent", mock.Anything, "n-1", mock.Anything).Return(nil)
+	// Percobaan ke-3: backoff 2 menit
+	mockRepo.On("MarkFailed", mock.Anything, "n-2", "connection refused", mock.MatchedBy(func(next time.Time) bool {
+		d := time.Until(next)
+		return d > 110*time.Second && d <= 2*time.Minute
+	})).Return(nil)
+	mockRepo.On("MarkDead", mock.Anything, "n-3", "mailbox unavailable").Return(nil)
+	// Template tidak ada: langsung dead letter tanpa dikirim
+	mockRepo.On("MarkDead", mock.Anything, "n-4", "no template").Return(nil)
+
+	n, err := dispatcher.DispatchOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	mockRepo.AssertExpectations(t)
+	mockTransport.AssertExpectations(t)
+	mockTransport.AssertNotCalled(t, "Send", mock.Anything, toRecipient("broken@example.com"))
+}
+
+func TestNotificationDispatcher_DispatchOnce_DropsExpiredCodes(t *testing.T) {
+	mockRepo := new(MockNotificationRepository)
+	mockTransport := new(MockMessageTransport)
+	dispatcher := usecases.NewNotificationDispatcher(mockRepo, subjectRenderer{}, mockTransport, nil)
+
+	// Kode OTP berlaku 5 menit dan tautan magic link 15 menit; alert tidak punya batas
+	staleCode := &entities.Notification{ID: "n-1", Kind: entities.NotificationVerificationCode, Recipient: "code@example.com", Attempts: 3, CreatedAt: time.Now().Add(-6 * time.Minute)}
+	staleLink := &entities.Notification{ID: "n-2", Kind: entities.NotificationMagicLink, Recipient: "link@example.com", Attempts: 4, CreatedAt: time.Now().Add(-16 * time.Minute)}
+	alert := &entities.Notification{ID: "n-3", Kind: entities.NotificationLoginAlert, Recipient: "alert@example.com", Attempts: 5, CreatedAt: time.Now().Add(-time.Hour)}
+	mockRepo.On("ClaimPending", mock.Anything, mock.Anything, mock.Anything).
+		Return([]*entities.Notification{staleCode, staleLink, alert}, nil)
+
+	mockRepo.On("MarkDead", mock.Anything, "n-1", "expired").Return(nil)
+	mockRepo.On("MarkDead", mock.Anything, "n-2", "expired").Return(nil)
+	mockTransport.On("Send", mock.Anything, toRecipient("alert@example.com")).Return(nil)
+	mockRepo.On("MarkSent", mock.Anything, "n-3", mock.Anything).Return(nil)
+
+	n, err := dispatcher.DispatchOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	mockRepo.AssertExpectations(t)
+	mockTransport.AssertNumberOfCalls(t, "Send", 1)
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+)
+
+// NotificationQueue adalah services.Notifier yang hanya menyimpan notifikasi ke antrean;
+// pengiriman dilakukan NotificationDispatcher sehingga kegagalan SMTP tidak menggagalkan request.
+type NotificationQueue struct {
+	repo repositories.NotificationRepository
+}
+
+func NewNotificationQueue(repo repositories.NotificationRepository) *NotificationQueue {
+	return &NotificationQueue{repo: repo}
+}
+
+// Notify memakai bahasa dari accept-language request jika notifikasi tidak menentukan bahasa
+func (q *NotificationQueue) Notify(ctx context.Context, notification *entities.Notification) error {
+	now := time.Now().UTC()
+	notification.ID = auth.GenerateUUID()
+	notification.Status = entities.NotificationPending
+	notification.CreatedAt = now
+	notification.NextAttemptAt = now
+	if notification.Locale == "" {
+		notification.Locale = entities.RequestInfoFromContext(ctx).Locale
+	}
+	if notification.Locale == "" {
+		notification.Locale = entities.DefaultLocale
+	}
+	return q.repo.Enqueue(ctx, notification)
+}
@@ -0,0 +1,331 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const orgInvitationTTL = 7 * 24 * time.Hour
+
+// OrganizationUseCase mengelola klinik (tenant), member dan undangan.
+//
+// Selain CreateOrganization, ListMyOrganizations dan AcceptInvitation, method di sini berjalan
+// dalam organisasi aktif di ctx (entities.ContextWithTenant, diisi middleware dari claim org_id).
+type OrganizationUseCase struct {
+	authUC    *AuthUseCase
+	repo      repositories.OrganizationRepository
+	notifier  services.Notifier
+	inviteURL string // halaman penerimaan undangan, token ditambahkan sebagai query "token"
+}
+
+func NewOrganizationUseCase(authUC *AuthUseCase, repo repositories.OrganizationRepository, notifier services.Notifier, inviteURL string) *OrganizationUseCase {
+	return &OrganizationUseCase{
+		authUC:    authUC,
+		repo:      repo,
+		notifier:  notifier,
+		inviteURL: inviteURL,
+	}
+}
+
+// CreateOrganization : psikolog, clinic manager dan admin boleh membuat organisasi dan menjadi owner-nya
+func (uc *OrganizationUseCase) CreateOrganization(ctx context.Context, userID, name string) (*entities.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, entities.ErrInvalidOrganizationName
+	}
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	if !user.HasRole(entities.PsychologistRole) && !user.HasRole(entities.ClinicManagerRole) && !user.HasRole(entities.AdminRole) {
+		return nil, entities.ErrUnauthorized
+	}
+
+	now := time.Now().UTC()
+	org := &entities.Organization{
+		ID:        auth.GenerateUUID(),
+		Name:      name,
+		CreatedBy: userID,
+		CreatedAt: now,
+	}
+	owner := &entities.Membership{OrgID: org.ID, OrgName: org.Name, UserID: userID, Email: user.Email, Role: entities.OrgRoleOwner, CreatedAt: now}
+	added := newDomainEvent(entities.EventOrgMemberAdded, userID, entities.OrgMembershipPayload{
+		OrgID:  org.ID,
+		UserID: userID,
+		Role:   owner.Role,
+	})
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.repo.CreateOrganization(ctx, org, owner)
+	}, added); err != nil {
+		return nil, err
+	}
+	uc.audit(entities.ContextWithTenant(ctx, org.ID), entities.AuditOrgCreated, userID, map[string]string{"name": name})
+	return org, nil
+}
+
+// ListMyOrganizations mengembalikan organisasi tempat user menjadi member beserta role-nya
+func (uc *OrganizationUseCase) ListMyOrganizations(ctx context.Context, userID string) ([]*entities.Membership, error) {
+	return uc.repo.ListMembershipsByUser(ctx, userID)
+}
+
+// InviteMember mengirim undangan ke email. Hanya owner yang boleh mengundang owner lain.
+func (uc *OrganizationUseCase) InviteMember(ctx context.Context, actorID, email string, role entities.OrgRole) (*entities.OrgInvitation, error) {
+	if !role.IsValid() {
+		return nil, entities.ErrInvalidOrgRole
+	}
+	actor, err := uc.requireManager(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if role == entities.OrgRoleOwner && actor.Role != entities.OrgRoleOwner {
+		return nil, entities.ErrUnauthorized
+	}
+
+	email = strings.TrimSpace(email)
+	if existing, _ := uc.authUC.userRepo.FindByEmail(ctx, email); existing != nil {
+		member, err := uc.repo.FindMember(ctx, existing.ID)
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			return nil, entities.ErrAlreadyOrgMember
+		}
+	}
+
+	token, hash, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	inv := &entities.OrgInvitation{
+		ID:        auth.GenerateUUID(),
+		Email:     email,
+		Role:      role,
+		TokenHash: hash,
+		InvitedBy: actorID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(orgInvitationTTL),
+	}
+	if err := uc.repo.CreateInvitation(ctx, inv); err != nil {
+		return nil, err
+	}
+	uc.audit(ctx, entities.AuditOrgMemberInvited, "", map[string]string{"email": email, "role": string(role)})
+	uc.sendInvitation(ctx, actor, inv, token)
+	return inv, nil
+}
+
+// sendInvitation : undangan tetap tersimpan walau antrean gagal, manager bisa mengundang ulang
+func (uc *OrganizationUseCase) sendInvitation(ctx context.Context, actor *entities.Membership, inv *entities.OrgInvitation, token string) {
+	if uc.notifier == nil {
+		return
+	}
+	if err := uc.notifier.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationOrgInvitation,
+		Recipient: inv.Email,
+		Data: map[string]string{
+			"link":            linkWithToken(uc.inviteURL, token),
+			"org_name":        actor.OrgName,
+			"invited_by":      actor.Email,
+			"role":            string(inv.Role),
+			"expires_in_days": strconv.Itoa(int(orgInvitationTTL.Hours() / 24)),
+		},
+	}); err != nil {
+		zap.L().Warn("failed to queue organization invitation", zap.String("org_id", inv.OrgID), zap.Error(err))
+	}
+}
+
+// AcceptInvitation dipanggil user yang sudah login dengan token dari email undangan
+func (uc *OrganizationUseCase) AcceptInvitation(ctx context.Context, userID, token string) (*entities.Membership, error) {
+	inv, err := uc.repo.FindInvitationByToken(ctx, auth.HashOpaqueToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if inv == nil || inv.AcceptedAt != nil || now.After(inv.ExpiresAt) {
+		return nil, entities.ErrInvalidToken
+	}
+
+	user, err := uc.authUC.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	if !strings.EqualFold(user.Email, inv.Email) {
+		return nil, entities.ErrInvitationEmailMismatch
+	}
+	// Role psychologist di klinik hanya untuk psikolog dengan lisensi terverifikasi
+	if inv.Role == entities.OrgRolePsychologist {
+		verified := user.HasRole(entities.PsychologistRole)
+		if verified {
+			if verified, err = uc.authUC.isVerifiedPsychologist(ctx, user.ID); err != nil {
+				return nil, err
+			}
+		}
+		if !verified {
+			return nil, entities.ErrNotPsychologist
+		}
+	}
+
+	ctx = entities.ContextWithTenant(ctx, inv.OrgID)
+	membership := &entities.Membership{UserID: user.ID, Email: user.Email, Role: inv.Role, CreatedAt: now}
+	added := newDomainEvent(entities.EventOrgMemberAdded, user.ID, entities.OrgMembershipPayload{
+		OrgID:  inv.OrgID,
+		UserID: user.ID,
+		Role:   inv.Role,
+	})
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		if err := uc.repo.MarkInvitationAccepted(ctx, inv.ID, now); err != nil {
+			return err
+		}
+		return uc.repo.AddMember(ctx, membership)
+	}, added); err != nil {
+		return nil, err
+	}
+	membership.OrgID = inv.OrgID
+	uc.audit(ctx, entities.AuditOrgMemberJoined, user.ID, map[string]string{"role": string(inv.Role)})
+	return membership, nil
+}
+
+// ListMembers : semua member boleh melihat daftar member organisasinya
+func (uc *OrganizationUseCase) ListMembers(ctx context.Context, actorID string) ([]*entities.Membership, error) {
+	if _, err := uc.requireMember(ctx, actorID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListMembers(ctx)
+}
+
+// SetMemberRole : hanya owner yang boleh memberi atau mencabut role owner
+func (uc *OrganizationUseCase) SetMemberRole(ctx context.Context, actorID, userID string, role entities.OrgRole) error {
+	if !role.IsValid() {
+		return entities.ErrInvalidOrgRole
+	}
+	actor, err := uc.requireManager(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	target, err := uc.requireMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if (role == entities.OrgRoleOwner || target.Role == entities.OrgRoleOwner) && actor.Role != entities.OrgRoleOwner {
+		return entities.ErrUnauthorized
+	}
+	if target.Role == entities.OrgRoleOwner && role != entities.OrgRoleOwner {
+		if err := uc.ensureAnotherOwner(ctx); err != nil {
+			return err
+		}
+	}
+
+	changed := newDomainEvent(entities.EventOrgMemberRoleChanged, userID, entities.OrgMembershipPayload{
+		OrgID:  target.OrgID,
+		UserID: userID,
+		Role:   role,
+	})
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.repo.SetMemberRole(ctx, userID, role)
+	}, changed); err != nil {
+		return err
+	}
+	uc.audit(ctx, entities.AuditOrgMemberRoleChanged, userID, map[string]string{
+		"from": string(target.Role),
+		"to":   string(role),
+	})
+	return nil
+}
+
+// RemoveMember mengeluarkan member; member juga boleh keluar sendiri. Token tenant milik member
+// tetap berlaku sampai expired, refresh berikutnya ditolak karena keanggotaan diperiksa ulang.
+func (uc *OrganizationUseCase) RemoveMember(ctx context.Context, actorID, userID string) error {
+	target, err := uc.requireMember(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if actorID != userID {
+		actor, err := uc.requireManager(ctx, actorID)
+		if err != nil {
+			return err
+		}
+		if target.Role == entities.OrgRoleOwner && actor.Role != entities.OrgRoleOwner {
+			return entities.ErrUnauthorized
+		}
+	}
+	if target.Role == entities.OrgRoleOwner {
+		if err := uc.ensureAnotherOwner(ctx); err != nil {
+			return err
+		}
+	}
+
+	removed := newDomainEvent(entities.EventOrgMemberRemoved, userID, entities.OrgMembershipPayload{
+		OrgID:  target.OrgID,
+		UserID: userID,
+	})
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		return uc.repo.RemoveMember(ctx, userID)
+	}, removed); err != nil {
+		return err
+	}
+	uc.audit(ctx, entities.AuditOrgMemberRemoved, userID, map[string]string{"role": string(target.Role)})
+	return nil
+}
+
+func (uc *OrganizationUseCase) requireMember(ctx context.Context, userID string) (*entities.Membership, error) {
+	m, err := uc.repo.FindMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, entities.ErrNotOrgMember
+	}
+	return m, nil
+}
+
+func (uc *OrganizationUseCase) requireManager(ctx context.Context, userID string) (*entities.Membership, error) {
+	m, err := uc.requireMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Role.CanManageMembers() {
+		return nil, entities.ErrUnauthorized
+	}
+	return m, nil
+}
+
+// ensureAnotherOwner mencegah organisasi kehilangan owner terakhir
+func (uc *OrganizationUseCase) ensureAnotherOwner(ctx context.Context) error {
+	owners, err := uc.repo.CountMembersWithRole(ctx, entities.OrgRoleOwner)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return entities.ErrLastOrgOwner
+	}
+	return nil
+}
+
+// audit mencatat organisasi aktif di metadata org_id
+func (uc *OrganizationUseCase) audit(ctx context.Context, action entities.AuditAction, subjectID string, metadata map[string]string) {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	if orgID, ok := entities.TenantFromContext(ctx); ok {
+		metadata["org_id"] = orgID
+	}
+	recordAudit(ctx, uc.authUC.audit, &entities.AuditEvent{
+		Action:    action,
+		SubjectID: subjectID,
+		Metadata:  metadata,
+	})
+}
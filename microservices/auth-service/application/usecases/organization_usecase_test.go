@@ -0,0 +1,421 @@
+package usecases_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// memoryOrganizationRepository meniru filter tenant repository Postgres
+type memoryOrganizationRepository struct {
+	orgs        map[string]*entities.Organization
+	members     map[string]map[string]*entities.Membership // org -> user -> membership
+	invitations map[string]*entities.OrgInvitation
+}
+
+func newMemoryOrganizationRepository() *memoryOrganizationRepository {
+	return &memoryOrganizationRepository{
+		orgs:        make(map[string]*entities.Organization),
+		members:     make(map[string]map[string]*entities.Membership),
+		invitations: make(map[string]*entities.OrgInvitation),
+	}
+}
+
+func (r *memoryOrganizationRepository) tenant(ctx context.Context) (map[string]*entities.Membership, string, error) {
+	orgID, ok := entities.TenantFromContext(ctx)
+	if !ok {
+		return nil, "", entities.ErrTenantRequired
+	}
+	if r.members[orgID] == nil {
+		r.members[orgID] = make(map[string]*entities.Membership)
+	}
+	return r.members[orgID], orgID, nil
+}
+
+func (r *memoryOrganizationRepository) CreateOrganization(ctx context.Context, org *entities.Organization, owner *entities.Membership) error {
+	r.orgs[org.ID] = org
+	copied := *owner
+	r.members[org.ID] = map[string]*entities.Membership{owner.UserID: &copied}
+	return nil
+}
+
+func (r *memoryOrganizationRepository) FindOrganization(ctx context.Context, id string) (*entities.Organization, error) {
+	return r.orgs[id], nil
+}
+
+func (r *memoryOrganizationRepository) ListMembershipsByUser(ctx context.Context, userID string) ([]*entities.Membership, error) {
+	var result []*entities.Membership
+	for _, members := range r.members {
+		if m, ok := members[userID]; ok {
+			copied := *m
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryOrganizationRepository) FindInvitationByToken(ctx context.Context, tokenHash string) (*entities.OrgInvitation, error) {
+	for _, inv := range r.invitations {
+		if inv.TokenHash == tokenHash {
+			copied := *inv
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryOrganizationRepository) FindMember(ctx context.Context, userID string) (*entities.Membership, error) {
+	members, _, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := members[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *m
+	return &copied, nil
+}
+
+func (r *memoryOrganizationRepository) ListMembers(ctx context.Context) ([]*entities.Membership, error) {
+	members, _, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*entities.Membership
+	for _, m := range members {
+		copied := *m
+		result = append(result, &copied)
+	}
+	return result, nil
+}
+
+func (r *memoryOrganizationRepository) AddMember(ctx context.Context, m *entities.Membership) error {
+	members, orgID, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := members[m.UserID]; ok {
+		return entities.ErrAlreadyOrgMember
+	}
+	m.OrgID = orgID
+	copied := *m
+	members[m.UserID] = &copied
+	return nil
+}
+
+func (r *memoryOrganizationRepository) SetMemberRole(ctx context.Context, userID string, role entities.OrgRole) error {
+	members, _, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	m, ok := members[userID]
+	if !ok {
+		return entities.ErrNotOrgMember
+	}
+	m.Role = role
+	return nil
+}
+
+func (r *memoryOrganizationRepository) RemoveMember(ctx context.Context, userID string) error {
+	members, _, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := members[userID]; !ok {
+		return entities.ErrNotOrgMember
+	}
+	delete(members, userID)
+	return nil
+}
+
+func (r *memoryOrganizationRepository) CountMembersWithRole(ctx context.Context, role entities.OrgRole) (int, error) {
+	members, _, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, m := range members {
+		if m.Role == role {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memoryOrganizationRepository) CreateInvitation(ctx context.Context, inv *entities.OrgInvitation) error {
+	_, orgID, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	inv.OrgID = orgID
+	copied := *inv
+	r.invitations[inv.ID] = &copied
+	return nil
+}
+
+func (r *memoryOrganizationRepository) MarkInvitationAccepted(ctx context.Context, id string, acceptedAt time.Time) error {
+	_, orgID, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	inv, ok := r.invitations[id]
+	if !ok || inv.OrgID != orgID || inv.AcceptedAt != nil {
+		return entities.ErrInvalidToken
+	}
+	inv.AcceptedAt = &acceptedAt
+	return nil
+}
+
+// newClinic membuat organisasi dengan owner psikolog, mengembalikan ctx tenant
+func newClinic(t *testing.T, uc *usecases.OrganizationUseCase, mockUserRepo *MockUserRepository, owner *entities.User) context.Context {
+	mockUserRepo.On("FindByID", mock.Anything, owner.ID).Return(owner, nil)
+	org, err := uc.CreateOrganization(context.Background(), owner.ID, "Klinik Sehat Jiwa")
+	require.NoError(t, err)
+	return entities.ContextWithTenant(context.Background(), org.ID)
+}
+
+func TestOrganizationUseCase_CreateOrganization(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryOrganizationRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewOrganizationUseCase(authUC, repo, new(MockNotifier), "")
+
+	client := &entities.User{ID: "client-1", Email: "client@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, client.ID).Return(client, nil)
+	_, err := uc.CreateOrganization(context.Background(), client.ID, "Klinik Saya")
+	assert.Equal(t, entities.ErrUnauthorized, err)
+
+	_, err = uc.CreateOrganization(context.Background(), client.ID, "   ")
+	assert.Equal(t, entities.ErrInvalidOrganizationName, err)
+
+	psy := &entities.User{ID: "psy-1", Email: "psy@example.com", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	ctx := newClinic(t, uc, mockUserRepo, psy)
+
+	orgs, err := uc.ListMyOrganizations(context.Background(), psy.ID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, entities.OrgRoleOwner, orgs[0].Role)
+
+	// Owner terakhir tidak boleh keluar
+	assert.Equal(t, entities.ErrLastOrgOwner, uc.RemoveMember(ctx, psy.ID, psy.ID))
+}
+
+func TestOrganizationUseCase_RequiresTenant(t *testing.T) {
+	authUC := usecases.NewAuthUseCase(new(MockUserRepository), new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewOrganizationUseCase(authUC, newMemoryOrganizationRepository(), new(MockNotifier), "")
+
+	_, err := uc.InviteMember(context.Background(), "psy-1", "staff@example.com", entities.OrgRoleStaff)
+	assert.Equal(t, entities.ErrTenantRequired, err)
+
+	_, err = uc.ListMembers(context.Background(), "psy-1")
+	assert.Equal(t, entities.ErrTenantRequired, err)
+}
+
+func TestOrganizationUseCase_InviteAndAccept(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryOrganizationRepository()
+	mockNotifier := new(MockNotifier)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewOrganizationUseCase(authUC, repo, mockNotifier, "https://app.example.com/org/invitation")
+
+	owner := &entities.User{ID: "psy-1", Email: "owner@example.com", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	ctx := newClinic(t, uc, mockUserRepo, owner)
+
+	mockUserRepo.On("FindByEmail", mock.Anything, "staff@example.com").Return((*entities.User)(nil), nil)
+	var sent *entities.Notification
+	mockNotifier.On("Notify", mock.Anything, mock.AnythingOfType("*entities.Notification")).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(*entities.Notification) }).Return(nil)
+
+	_, err := uc.InviteMember(ctx, owner.ID, "staff@example.com", entities.OrgRole("janitor"))
+	assert.Equal(t, entities.ErrInvalidOrgRole, err)
+
+	_, err = uc.InviteMember(ctx, owner.ID, "staff@example.com", entities.OrgRoleStaff)
+	require.NoError(t, err)
+	require.NotNil(t, sent)
+	assert.Equal(t, entities.NotificationOrgInvitation, sent.Kind)
+	assert.Equal(t, "Klinik Sehat Jiwa", sent.Data["org_name"])
+	link, err := url.Parse(sent.Data["link"])
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+
+	other := &entities.User{ID: "other-1", Email: "other@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, other.ID).Return(other, nil)
+	_, err = uc.AcceptInvitation(context.Background(), other.ID, token)
+	assert.Equal(t, entities.ErrInvitationEmailMismatch, err)
+
+	staff := &entities.User{ID: "staff-1", Email: "staff@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, staff.ID).Return(staff, nil)
+	membership, err := uc.AcceptInvitation(context.Background(), staff.ID, token)
+	require.NoError(t, err)
+	assert.Equal(t, entities.OrgRoleStaff, membership.Role)
+
+	_, err = uc.AcceptInvitation(context.Background(), staff.ID, token)
+	assert.Equal(t, entities.ErrInvalidToken, err)
+
+	members, err := uc.ListMembers(ctx, staff.ID)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+
+	// Staff tidak boleh mengelola member, member lain organisasi tidak terlihat dari tenant lain
+	_, err = uc.InviteMember(ctx, staff.ID, "new@example.com", entities.OrgRoleStaff)
+	assert.Equal(t, entities.ErrUnauthorized, err)
+	otherTenant := entities.ContextWithTenant(context.Background(), "another-clinic")
+	_, err = uc.ListMembers(otherTenant, staff.ID)
+	assert.Equal(t, entities.ErrNotOrgMember, err)
+
+	// Staff boleh keluar sendiri
+	assert.NoError(t, uc.RemoveMember(ctx, staff.ID, staff.ID))
+}
+
+func TestOrganizationUseCase_OwnerRoleProtected(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryOrganizationRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil)
+	uc := usecases.NewOrganizationUseCase(authUC, repo, new(MockNotifier), "")
+
+	owner := &entities.User{ID: "psy-1", Email: "owner@example.com", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	ctx := newClinic(t, uc, mockUserRepo, owner)
+	require.NoError(t, repo.AddMember(ctx, &entities.Membership{UserID: "manager-1", Role: entities.OrgRoleManager}))
+
+	assert.Equal(t, entities.ErrUnauthorized, uc.SetMemberRole(ctx, "manager-1", owner.ID, entities.OrgRoleStaff))
+	assert.Equal(t, entities.ErrUnauthorized, uc.SetMemberRole(ctx, "manager-1", "manager-1", entities.OrgRoleOwner))
+	assert.Equal(t, entities.ErrUnauthorized, uc.RemoveMember(ctx, "manager-1", owner.ID))
+	assert.Equal(t, entities.ErrLastOrgOwner, uc.SetMemberRole(ctx, owner.ID, owner.ID, entities.OrgRoleManager))
+
+	// Setelah ada owner kedua, owner pertama boleh turun menjadi manager
+	require.NoError(t, uc.SetMemberRole(ctx, owner.ID, "manager-1", entities.OrgRoleOwner))
+	assert.NoError(t, uc.SetMemberRole(ctx, owner.ID, owner.ID, entities.OrgRoleManager))
+}
+
+func TestOrganizationUseCase_SetMemberRole_EmitsRoleChanged(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockOutbox := new(MockOutboxRepository)
+	repo := newMemoryOrganizationRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithOutbox(usecases.NewOutbox(&fakeTransactor{}, mockOutbox)),
+	)
+	uc := usecases.NewOrganizationUseCase(authUC, repo, new(MockNotifier), "")
+
+	var emitted []entities.DomainEventType
+	mockOutbox.On("Enqueue", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		for _, e := range args.Get(1).([]*entities.DomainEvent) {
+			emitted = append(emitted, e.Type)
+		}
+	}).Return(nil)
+
+	owner := &entities.User{ID: "psy-1", Email: "owner@example.com", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	ctx := newClinic(t, uc, mockUserRepo, owner)
+	require.NoError(t, repo.AddMember(ctx, &entities.Membership{UserID: "staff-1", Role: entities.OrgRoleStaff}))
+	emitted = nil
+
+	require.NoError(t, uc.SetMemberRole(ctx, owner.ID, "staff-1", entities.OrgRoleManager))
+	assert.Equal(t, []entities.DomainEventType{entities.EventOrgMemberRoleChanged}, emitted)
+}
+
+func TestAuthUseCase_SwitchOrganization(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	repo := newMemoryOrganizationRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithOrganizationRepository(repo),
+	)
+	uc := usecases.NewOrganizationUseCase(authUC, repo, new(MockNotifier), "")
+
+	psy := &entities.User{ID: "psy-1", Email: "psy@example.com", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	ctx := newClinic(t, uc, mockUserRepo, psy)
+	orgID, _ := entities.TenantFromContext(ctx)
+
+	jwtAuth := auth.NewJWTAuth("test-secret")
+	refreshToken, err := jwtAuth.GenerateRefreshToken()
+	require.NoError(t, err)
+	claims, err := jwtAuth.ValidateRefreshToken(refreshToken)
+	require.NoError(t, err)
+
+	mockTokenRepo.On("GetUserIDByTokenID", mock.Anything, claims.ID).Return(psy.ID, nil)
+	mockTokenRepo.On("IsTokenRevoked", mock.Anything, claims.ID).Return(false)
+	mockTokenRepo.On("GetSession", mock.Anything, claims.ID).Return(&entities.Session{TokenID: claims.ID, UserID: psy.ID}, nil)
+
+	// Bukan member organisasi: token lama tidak dicabut
+	_, _, err = authUC.SwitchOrganization(context.Background(), psy.ID, refreshToken, "another-clinic")
+	assert.Equal(t, entities.ErrNotOrgMember, err)
+	mockTokenRepo.AssertNotCalled(t, "RevokeToken", mock.Anything, claims.ID)
+
+	// Refresh token milik user lain ditolak
+	_, _, err = authUC.SwitchOrganization(context.Background(), "someone-else", refreshToken, orgID)
+	assert.Equal(t, entities.ErrInvalidToken, err)
+
+	mockTokenRepo.On("RevokeToken", mock.Anything, claims.ID).Return(nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.MatchedBy(func(s *entities.Session) bool {
+		return s.UserID == psy.ID && s.OrgID == orgID
+	})).Return(nil)
+
+	accessToken, _, err := authUC.SwitchOrganization(context.Background(), psy.ID, refreshToken, orgID)
+	require.NoError(t, err)
+	accessClaims, err := jwtAuth.ValidateToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, orgID, accessClaims.OrgID)
+	assert.Equal(t, string(entities.OrgRoleOwner), accessClaims.OrgRole)
+}
+
+func TestAuthUseCase_Reauthenticate_KeepsActiveOrganization(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	repo := newMemoryOrganizationRepository()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithOrganizationRepository(repo),
+	)
+	uc := usecases.NewOrganizationUseCase(authUC, repo, new(MockNotifier), "")
+
+	hash, err := auth.Argon2Hash("password123")
+	require.NoError(t, err)
+	psy := &entities.User{ID: "psy-1", Email: "psy@example.com", PasswordHash: hash, Role: entities.PsychologistRole, Status: entities.StatusActive}
+	ctx := newClinic(t, uc, mockUserRepo, psy)
+	orgID, _ := entities.TenantFromContext(ctx)
+
+	token, _, err := authUC.Reauthenticate(context.Background(), psy.ID, orgID, "password123")
+	require.NoError(t, err)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, orgID, claims.OrgID)
+	assert.Equal(t, string(entities.OrgRoleOwner), claims.OrgRole)
+
+	// Organisasi yang bukan milik user tidak ikut ke token elevated
+	_, _, err = authUC.Reauthenticate(context.Background(), psy.ID, "another-clinic", "password123")
+	assert.Equal(t, entities.ErrNotOrgMember, err)
+}
+
+func TestAuthUseCase_RefreshToken_RemovedMemberLosesTenantSession(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithOrganizationRepository(newMemoryOrganizationRepository()),
+	)
+
+	user := &entities.User{ID: "staff-1", Email: "staff@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	jwtAuth := auth.NewJWTAuth("test-secret")
+	refreshToken, err := jwtAuth.GenerateRefreshToken()
+	require.NoError(t, err)
+	claims, err := jwtAuth.ValidateRefreshToken(refreshToken)
+	require.NoError(t, err)
+
+	mockTokenRepo.On("GetUserIDByTokenID", mock.Anything, claims.ID).Return(user.ID, nil)
+	mockTokenRepo.On("IsTokenRevoked", mock.Anything, claims.ID).Return(false)
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	mockTokenRepo.On("GetSession", mock.Anything, claims.ID).Return(&entities.Session{TokenID: claims.ID, UserID: user.ID, OrgID: "clinic-1"}, nil)
+	mockTokenRepo.On("RevokeToken", mock.Anything, claims.ID).Return(nil)
+
+	_, _, err = authUC.RefreshToken(context.Background(), refreshToken)
+
+	assert.Equal(t, entities.ErrNotOrgMember, err)
+	mockTokenRepo.AssertCalled(t, "RevokeToken", mock.Anything, claims.ID)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+}
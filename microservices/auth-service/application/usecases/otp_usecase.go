@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	otpDigits      = 6
+	otpTTL         = 5 * time.Minute
+	otpMaxAttempts = 5
+	// Batas percobaan per challenge tidak berarti jika challenge baru bisa diminta terus
+	otpMaxIssues   = 5
+	otpIssueWindow = 15 * time.Minute
+)
+
+// OTPUseCase menerbitkan dan memverifikasi kode sekali pakai yang dikirim lewat SMS atau email.
+// Dipakai sebagai faktor MFA/step-up dan untuk verifikasi nomor telepon.
+type OTPUseCase struct {
+	repo  repositories.OTPRepository
+	sms   services.SMSSender
+	email services.Notifier
+}
+
+// NewOTPUseCase : kode email dikirim lewat antrean notifikasi agar memakai template sesuai bahasa user
+func NewOTPUseCase(repo repositories.OTPRepository, sms services.SMSSender, email services.Notifier) *OTPUseCase {
+	return &OTPUseCase{repo: repo, sms: sms, email: email}
+}
+
+// Issue melengkapi challenge (ID, hash kode, masa berlaku), menyimpannya lalu mengirim kode
+// ke Destination. Kode tidak pernah disimpan maupun dikembalikan. Penerbitan dibatasi per
+// user dan tujuan (ErrOTPRateLimited), apa pun tujuan challenge-nya.
+func (uc *OTPUseCase) Issue(ctx context.Context, challenge *entities.OTPChallenge) error {
+	if !challenge.Channel.IsValid() {
+		return entities.ErrInvalidOTPChannel
+	}
+	key := auth.HashOpaqueToken(challenge.UserID + "\x00" + strings.ToLower(strings.TrimSpace(challenge.Destination)))
+	n, err := uc.repo.CountIssue(ctx, key, otpIssueWindow)
+	if err != nil {
+		return err
+	}
+	if n > otpMaxIssues {
+		return entities.ErrOTPRateLimited
+	}
+	code, err := auth.GenerateNumericCode(otpDigits)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	challenge.ID = auth.GenerateUUID()
+	challenge.CodeHash = auth.HashOTPCode(challenge.ID, code)
+	challenge.CreatedAt = now
+	challenge.ExpiresAt = now.Add(otpTTL)
+
+	if err := uc.repo.StoreOTP(ctx, challenge, otpTTL); err != nil {
+		return err
+	}
+	if err := uc.send(ctx, challenge, code); err != nil {
+		zap.L().Warn("failed to send otp", zap.String("user_id", challenge.UserID), zap.String("channel", string(challenge.Channel)), zap.Error(err))
+		_, _ = uc.repo.DeleteOTP(ctx, challenge.ID)
+		return err
+	}
+	return nil
+}
+
+func (uc *OTPUseCase) send(ctx context.Context, challenge *entities.OTPChallenge, code string) error {
+	if challenge.Channel == entities.OTPChannelSMS {
+		message := fmt.Sprintf("Kode verifikasi Anda: %s. Berlaku %d menit, jangan berikan kode ini kepada siapa pun.", code, int(otpTTL.Minutes()))
+		return uc.sms.SendSMS(ctx, challenge.Destination, message)
+	}
+	return uc.email.Notify(ctx, &entities.Notification{
+		Kind:      entities.NotificationVerificationCode,
+		UserID:    challenge.UserID,
+		Recipient: challenge.Destination,
+		Data: map[string]string{
+			"code":               code,
+			"expires_in_minutes": strconv.Itoa(int(otpTTL.Minutes())),
+		},
+	})
+}
+
+// Verify memeriksa kode untuk challenge dengan tujuan tertentu. Challenge dihapus setelah
+// berhasil atau setelah melewati batas percobaan.
+func (uc *OTPUseCase) Verify(ctx context.Context, challengeID, code string, purpose entities.OTPPurpose) (*entities.OTPChallenge, error) {
+	challenge, err := uc.repo.FindOTP(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil || challenge.Purpose != purpose {
+		return nil, entities.ErrInvalidOTP
+	}
+
+	attempts, err := uc.repo.IncrementOTPAttempts(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if attempts == 0 {
+		return nil, entities.ErrInvalidOTP
+	}
+	if attempts > otpMaxAttempts {
+		_, _ = uc.repo.DeleteOTP(ctx, challengeID)
+		return nil, entities.ErrOTPAttemptsExceeded
+	}
+	if !auth.VerifyOTPCode(challengeID, code, challenge.CodeHash) {
+		return nil, entities.ErrInvalidOTP
+	}
+
+	// Hanya satu request yang berhasil menghapus, request paralel dengan kode yang sama ditolak
+	deleted, err := uc.repo.DeleteOTP(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, entities.ErrInvalidOTP
+	}
+	return challenge, nil
+}
@@ -0,0 +1,370 @@
+package usecases_test
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// memoryOTPRepository meniru perilaku Redis (counter percobaan, hapus sekali) tanpa server
+type memoryOTPRepository struct {
+	mu         sync.Mutex
+	challenges map[string]*entities.OTPChallenge
+	attempts   map[string]int
+	issues     map[string]int
+}
+
+func newMemoryOTPRepository() *memoryOTPRepository {
+	return &memoryOTPRepository{challenges: map[string]*entities.OTPChallenge{}, attempts: map[string]int{}, issues: map[string]int{}}
+}
+
+func (r *memoryOTPRepository) StoreOTP(ctx context.Context, challenge *entities.OTPChallenge, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := *challenge
+	r.challenges[challenge.ID] = &c
+	return nil
+}
+
+func (r *memoryOTPRepository) FindOTP(ctx context.Context, id string) (*entities.OTPChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.challenges[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (r *memoryOTPRepository) IncrementOTPAttempts(ctx context.Context, id string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.challenges[id]; !ok {
+		return 0, nil
+	}
+	r.attempts[id]++
+	return r.attempts[id], nil
+}
+
+func (r *memoryOTPRepository) DeleteOTP(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.challenges[id]
+	delete(r.challenges, id)
+	return ok, nil
+}
+
+// CountIssue tanpa window: test tidak melewati batas waktu
+func (r *memoryOTPRepository) CountIssue(ctx context.Context, key string, window time.Duration) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.issues[key]++
+	return r.issues[key], nil
+}
+
+// outboxSender menyimpan pesan terakhir per tujuan, pengganti spool directory di test
+type outboxSender struct {
+	messages map[string]string
+}
+
+func newOutboxSender() *outboxSender {
+	return &outboxSender{messages: map[string]string{}}
+}
+
+func (s *outboxSender) SendSMS(ctx context.Context, to, message string) error {
+	s.messages[to] = message
+	return nil
+}
+
+func (s *outboxSender) Notify(ctx context.Context, notification *entities.Notification) error {
+	s.messages[notification.Recipient] = notification.Data["code"]
+	return nil
+}
+
+var otpCodePattern = regexp.MustCompile(`\b[0-9]{6}\b`)
+
+func (s *outboxSender) code(to string) string {
+	return otpCodePattern.FindString(s.messages[to])
+}
+
+func TestOTPUseCase_IssueAndVerify(t *testing.T) {
+	repo := newMemoryOTPRepository()
+	sender := newOutboxSender()
+	uc := usecases.NewOTPUseCase(repo, sender, sender)
+
+	challenge := &entities.OTPChallenge{
+		UserID:      "user-123",
+		Purpose:     entities.OTPPurposePhoneVerification,
+		Channel:     entities.OTPChannelSMS,
+		Destination: "+6281234567890",
+	}
+	assert.NoError(t, uc.Issue(context.Background(), challenge))
+	assert.NotEmpty(t, challenge.ID)
+
+	code := sender.code("+6281234567890")
+	assert.Len(t, code, 6)
+	// Kode tidak tersimpan dalam bentuk asli
+	stored, _ := repo.FindOTP(context.Background(), challenge.ID)
+	assert.NotContains(t, stored.CodeHash, code)
+	assert.Equal(t, auth.HashOTPCode(challenge.ID, code), stored.CodeHash)
+
+	// Tujuan berbeda ditolak
+	_, err := uc.Verify(context.Background(), challenge.ID, code, entities.OTPPurposeLogin)
+	assert.Equal(t, entities.ErrInvalidOTP, err)
+
+	verified, err := uc.Verify(context.Background(), challenge.ID, code, entities.OTPPurposePhoneVerification)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-123", verified.UserID)
+
+	// Sekali pakai
+	_, err = uc.Verify(context.Background(), challenge.ID, code, entities.OTPPurposePhoneVerification)
+	assert.Equal(t, entities.ErrInvalidOTP, err)
+}
+
+func TestOTPUseCase_Verify_AttemptsExceeded(t *testing.T) {
+	repo := newMemoryOTPRepository()
+	sender := newOutboxSender()
+	uc := usecases.NewOTPUseCase(repo, sender, sender)
+
+	challenge := &entities.OTPChallenge{
+		UserID:      "user-123",
+		Purpose:     entities.OTPPurposeLogin,
+		Channel:     entities.OTPChannelEmail,
+		Destination: "user@example.com",
+	}
+	assert.NoError(t, uc.Issue(context.Background(), challenge))
+	code := sender.code("user@example.com")
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	for i := 0; i < 5; i++ {
+		_, err := uc.Verify(context.Background(), challenge.ID, wrong, entities.OTPPurposeLogin)
+		assert.Equal(t, entities.ErrInvalidOTP, err)
+	}
+	_, err := uc.Verify(context.Background(), challenge.ID, code, entities.OTPPurposeLogin)
+	assert.Equal(t, entities.ErrOTPAttemptsExceeded, err)
+
+	// Challenge dihapus, kode benar pun tidak berlaku lagi
+	_, err = uc.Verify(context.Background(), challenge.ID, code, entities.OTPPurposeLogin)
+	assert.Equal(t, entities.ErrInvalidOTP, err)
+}
+
+func TestOTPUseCase_Issue_RateLimitedPerDestination(t *testing.T) {
+	sender := newOutboxSender()
+	uc := usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)
+	issue := func(userID, destination string) error {
+		return uc.Issue(context.Background(), &entities.OTPChallenge{
+			UserID:      userID,
+			Purpose:     entities.OTPPurposeStepUp,
+			Channel:     entities.OTPChannelEmail,
+			Destination: destination,
+		})
+	}
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, issue("user-123", "user@example.com"))
+	}
+	sender.messages = map[string]string{}
+	// Batas berlaku lintas tujuan challenge (login, step-up), kode tidak dikirim lagi
+	assert.Equal(t, entities.ErrOTPRateLimited, issue("user-123", "User@example.com"))
+	assert.Empty(t, sender.messages)
+
+	assert.NoError(t, issue("user-456", "user@example.com"))
+	assert.NoError(t, issue("user-123", "other@example.com"))
+}
+
+func TestAuthUseCase_Login_MFAEnabled(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	sender := newOutboxSender()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)),
+	)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	verifiedAt := time.Now().Add(-24 * time.Hour)
+	user := &entities.User{
+		ID: "user-123", Email: "user@example.com", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive,
+		Phone: "+6281234567890", PhoneVerifiedAt: &verifiedAt, MFAChannel: entities.OTPChannelSMS,
+	}
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+
+	_, _, err = authUC.Login(context.Background(), user.Email, "password123")
+
+	mfa, ok := err.(*entities.MFARequiredError)
+	assert.True(t, ok)
+	assert.Equal(t, entities.OTPChannelSMS, mfa.Channel)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+
+	_, _, err = authUC.VerifyMFA(context.Background(), mfa.ChallengeID, "not-the-code")
+	assert.Equal(t, entities.ErrInvalidOTP, err)
+
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.MatchedBy(func(s *entities.Session) bool {
+		return s.UserID == user.ID && s.Auth.ACR() == entities.ACRMultiFactor
+	})).Return(nil)
+
+	accessToken, refreshToken, err := authUC.VerifyMFA(context.Background(), mfa.ChallengeID, sender.code(user.Phone))
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, refreshToken)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pwd", "sms", "mfa"}, claims.AMR)
+	assert.Equal(t, entities.ACRMultiFactor, claims.ACR)
+	mockTokenRepo.AssertExpectations(t)
+}
+
+func TestAuthUseCase_Login_HighRiskSendsEmailCode(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockHistory := new(MockLoginHistoryRepository)
+	sender := newOutboxSender()
+	policy := usecases.DefaultLoginRiskPolicy()
+	policy.RequireStepUp = true
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithLoginRiskAssessor(usecases.NewLoginRiskAssessor(mockHistory, staticLocator{"2.2.2.2": london}, policy)),
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)),
+	)
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{ID: "user-123", Email: "user@example.com", PasswordHash: hash, Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockHistory.On("LastLogin", mock.Anything, "user-123").Return(&entities.LoginRecord{
+		UserID: "user-123", Location: jakarta, OccurredAt: time.Now().Add(-time.Hour),
+	}, nil)
+	mockHistory.On("FindDevice", mock.Anything, "user-123", mock.AnythingOfType("string")).Return(nil, nil)
+	mockHistory.On("HasLoginFromCountry", mock.Anything, "user-123", "GB").Return(false, nil)
+
+	_, _, err = authUC.Login(loginContext("2.2.2.2", "device-b"), user.Email, "password123")
+
+	mfa, ok := err.(*entities.MFARequiredError)
+	assert.True(t, ok)
+	assert.Equal(t, entities.OTPChannelEmail, mfa.Channel)
+	assert.NotEmpty(t, sender.code(user.Email))
+}
+
+func TestAuthUseCase_EmailVerification(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	sender := newOutboxSender()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil,
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)),
+	)
+
+	mockUserRepo.On("FindByEmail", mock.Anything, "new@example.com").Return((*entities.User)(nil), nil).Once()
+	mockUserRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*entities.User")).Return(nil)
+	user, err := authUC.Register(context.Background(), "new@example.com", "password123", entities.ClientRole)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusPendingVerification, user.Status)
+
+	// Login sebelum verifikasi tidak menerbitkan token, kode baru dikirim
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	_, _, err = authUC.Login(context.Background(), user.Email, "password123")
+	verification, ok := err.(*entities.EmailVerificationRequiredError)
+	assert.True(t, ok)
+	assert.ErrorIs(t, err, entities.ErrAccountPendingVerification)
+	mockTokenRepo.AssertNotCalled(t, "StoreToken")
+
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	assert.Equal(t, entities.ErrInvalidOTP, authUC.VerifyEmail(context.Background(), verification.ChallengeID, "not-the-code"))
+
+	mockUserRepo.On("MarkEmailVerified", mock.Anything, user.ID, mock.AnythingOfType("time.Time")).Return(nil).Once()
+	assert.NoError(t, authUC.VerifyEmail(context.Background(), verification.ChallengeID, sender.code(user.Email)))
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestAuthUseCase_ReauthenticateWithOTP(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	sender := newOutboxSender()
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithOTP(usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)),
+	)
+
+	user := &entities.User{ID: "user-123", Email: "user@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+
+	challengeID, channel, err := authUC.SendStepUpCode(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.OTPChannelEmail, channel)
+
+	// Kode step-up milik user lain tidak bisa dipakai
+	_, _, err = authUC.ReauthenticateWithOTP(context.Background(), "user-456", "", challengeID, sender.code(user.Email))
+	assert.Equal(t, entities.ErrInvalidOTP, err)
+
+	challengeID, _, err = authUC.SendStepUpCode(context.Background(), user.ID)
+	assert.NoError(t, err)
+	token, expiresIn, err := authUC.ReauthenticateWithOTP(context.Background(), user.ID, "", challengeID, sender.code(user.Email))
+
+	assert.NoError(t, err)
+	assert.Equal(t, auth.ElevatedTokenExpiry, expiresIn)
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"otp", "mfa"}, claims.AMR)
+}
+
+func TestMFAUseCase_PhoneVerificationAndChannel(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	sender := newOutboxSender()
+	otpUC := usecases.NewOTPUseCase(newMemoryOTPRepository(), sender, sender)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil, usecases.WithOTP(otpUC))
+	uc := usecases.NewMFAUseCase(authUC, otpUC)
+
+	user := &entities.User{ID: "user-123", Email: "user@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+
+	_, err := uc.StartPhoneVerification(context.Background(), user.ID, "081234567890", time.Now())
+	assert.Equal(t, entities.ErrInvalidPhone, err)
+
+	// Mengganti nomor telepon mensyaratkan autentikasi baru-baru ini
+	_, err = uc.StartPhoneVerification(context.Background(), user.ID, "+6281234567890", time.Now().Add(-time.Hour))
+	assert.Equal(t, entities.ErrStepUpRequired, err)
+	assert.Empty(t, sender.messages)
+
+	// SMS belum bisa dipilih sebelum nomor diverifikasi
+	err = uc.SetMFAChannel(context.Background(), user.ID, entities.OTPChannelSMS, time.Now())
+	assert.Equal(t, entities.ErrPhoneNotVerified, err)
+
+	id, err := uc.StartPhoneVerification(context.Background(), user.ID, "+6281234567890", time.Now())
+	assert.NoError(t, err)
+
+	err = uc.ConfirmPhoneVerification(context.Background(), user.ID, id, sender.code("+6281234567890"), time.Time{})
+	assert.Equal(t, entities.ErrStepUpRequired, err)
+
+	mockUserRepo.On("SetPhone", mock.Anything, user.ID, "+6281234567890", mock.AnythingOfType("time.Time")).Return(nil)
+	err = uc.ConfirmPhoneVerification(context.Background(), user.ID, id, sender.code("+6281234567890"), time.Now())
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+}
+
+func TestMFAUseCase_SetMFAChannel_RequiresRecentAuth(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	otpUC := usecases.NewOTPUseCase(newMemoryOTPRepository(), newOutboxSender(), newOutboxSender())
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil, usecases.WithOTP(otpUC))
+	uc := usecases.NewMFAUseCase(authUC, otpUC)
+
+	err := uc.SetMFAChannel(context.Background(), "user-123", "", time.Now().Add(-time.Hour))
+	assert.Equal(t, entities.ErrStepUpRequired, err)
+	mockUserRepo.AssertNotCalled(t, "SetMFAChannel")
+
+	user := &entities.User{ID: "user-123", Email: "user@example.com", MFAChannel: entities.OTPChannelSMS}
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	mockUserRepo.On("SetMFAChannel", mock.Anything, user.ID, entities.OTPChannelEmail).Return(nil)
+
+	err = uc.SetMFAChannel(context.Background(), user.ID, entities.OTPChannelEmail, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	mockUserRepo.AssertExpectations(t)
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+)
+
+// Outbox menyimpan domain event dalam transaksi yang sama dengan perubahan data user,
+// pengiriman ke broker dilakukan terpisah oleh OutboxRelay
+type Outbox struct {
+	transactor repositories.Transactor
+	repo       repositories.OutboxRepository
+}
+
+func NewOutbox(transactor repositories.Transactor, repo repositories.OutboxRepository) *Outbox {
+	return &Outbox{transactor: transactor, repo: repo}
+}
+
+// record menjalankan fn lalu menyimpan event dalam satu transaksi.
+// Tanpa outbox (nil) hanya fn yang dijalankan.
+func (o *Outbox) record(ctx context.Context, fn func(ctx context.Context) error, events ...*entities.DomainEvent) error {
+	if o == nil {
+		return fn(ctx)
+	}
+	return o.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		return o.repo.Enqueue(ctx, events...)
+	})
+}
+
+// enqueue untuk event yang tidak menyertai perubahan data (e.g. login)
+func (o *Outbox) enqueue(ctx context.Context, events ...*entities.DomainEvent) error {
+	if o == nil {
+		return nil
+	}
+	return o.repo.Enqueue(ctx, events...)
+}
+
+func newDomainEvent(eventType entities.DomainEventType, userID string, payload interface{}) *entities.DomainEvent {
+	// Payload berupa struct sederhana, Marshal tidak akan gagal
+	data, _ := json.Marshal(payload)
+	return &entities.DomainEvent{
+		ID:          auth.GenerateUUID(),
+		Type:        eventType,
+		AggregateID: userID,
+		Payload:     data,
+		OccurredAt:  time.Now().UTC(),
+	}
+}
@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	outboxBatchSize  = 100
+	outboxClaimLease = time.Minute
+	outboxMinBackoff = time.Second
+	outboxMaxBackoff = time.Hour
+
+	// Event terkirim disimpan selama outboxRetention untuk penelusuran, lalu dihapus
+	// paling sering sekali per outboxPurgeInterval
+	outboxRetention      = 7 * 24 * time.Hour
+	outboxPurgeInterval  = time.Hour
+	outboxPurgeBatchSize = 1000
+)
+
+// OutboxRelay mengirim event dari outbox ke broker (at-least-once).
+// Beberapa instance boleh berjalan bersamaan karena event di-claim dengan lease.
+type OutboxRelay struct {
+	repo      repositories.OutboxRepository
+	broker    services.EventBroker
+	logger    *zap.Logger
+	lastPurge time.Time
+}
+
+func NewOutboxRelay(repo repositories.OutboxRepository, broker services.EventBroker, logger *zap.Logger) *OutboxRelay {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &OutboxRelay{repo: repo, broker: broker, logger: logger}
+}
+
+// Run memanggil RelayOnce lewat runPeriodically sampai ctx dibatalkan
+func (r *OutboxRelay) Run(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, r.logger, "outbox relay failed", func(ctx context.Context) (bool, error) {
+		n, err := r.RelayOnce(ctx)
+		return n == outboxBatchSize, err
+	})
+}
+
+// RelayOnce mengirim satu batch dan mengembalikan jumlah event yang di-claim.
+// Event yang gagal dikirim dijadwalkan ulang dengan exponential backoff.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	r.purgePublished(ctx)
+
+	events, err := r.repo.ClaimPending(ctx, outboxBatchSize, outboxClaimLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if err := r.broker.Publish(ctx, event); err != nil {
+			r.logger.Warn("failed to publish outbox event",
+				zap.String("event_id", event.ID),
+				zap.String("type", string(event.Type)),
+				zap.Int("attempts", event.Attempts+1),
+				zap.Error(err),
+			)
+			next := time.Now().UTC().Add(outboxBackoff(event.Attempts + 1))
+			if err := r.repo.MarkFailed(ctx, event.ID, err.Error(), next); err != nil {
+				return len(events), err
+			}
+			continue
+		}
+
+		// Jika gagal di sini event akan dikirim ulang setelah lease habis, broker melakukan deduplikasi
+		if err := r.repo.MarkPublished(ctx, event.ID, time.Now().UTC()); err != nil {
+			return len(events), err
+		}
+	}
+	return len(events), nil
+}
+
+// purgePublished menghapus event terkirim yang melewati outboxRetention. Kegagalan hanya
+// di-log dan dicoba lagi pada interval berikutnya, pengiriman event tetap berjalan.
+func (r *OutboxRelay) purgePublished(ctx context.Context) {
+	now := time.Now().UTC()
+	if now.Sub(r.lastPurge) < outboxPurgeInterval {
+		return
+	}
+	r.lastPurge = now
+
+	purged := 0
+	for {
+		n, err := r.repo.PurgePublished(ctx, now.Add(-outboxRetention), outboxPurgeBatchSize)
+		if err != nil {
+			r.logger.Warn("outbox purge failed", zap.Error(err))
+			return
+		}
+		purged += n
+		if n < outboxPurgeBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+	if purged > 0 {
+		r.logger.Info("published outbox events purged", zap.Int("count", purged))
+	}
+}
+
+// outboxBackoff : 1s, 2s, 4s, ... maksimal 1 jam
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxMinBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,157 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Enqueue(ctx context.Context, events ...*entities.DomainEvent) error {
+	args := m.Called(ctx, events)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.DomainEvent, error) {
+	args := m.Called(ctx, limit, lease)
+	return args.Get(0).([]*entities.DomainEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	args := m.Called(ctx, id, publishedAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) PurgePublished(ctx context.Context, publishedBefore time.Time, limit int) (int, error) {
+	args := m.Called(ctx, publishedBefore, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+type MockEventBroker struct {
+	mock.Mock
+}
+
+func (m *MockEventBroker) Publish(ctx context.Context, event *entities.DomainEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+// fakeTransactor menjalankan fn langsung dan mencatat jumlah transaksi
+type fakeTransactor struct {
+	calls int
+}
+
+func (t *fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.calls++
+	return fn(ctx)
+}
+
+func TestOutboxRelay_RelayOnce(t *testing.T) {
+	mockRepo := new(MockOutboxRepository)
+	mockBroker := new(MockEventBroker)
+	relay := usecases.NewOutboxRelay(mockRepo, mockBroker, nil)
+
+	ok := &entities.DomainEvent{ID: "evt-1", Type: entities.EventUserRegistered}
+	failing := &entities.DomainEvent{ID: "evt-2", Type: entities.EventUserLoggedIn, Attempts: 3}
+	mockRepo.On("PurgePublished", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+	mockRepo.On("ClaimPending", mock.Anything, mock.Anything, mock.Anything).Return([]*entities.DomainEvent{ok, failing}, nil)
+	mockBroker.On("Publish", mock.Anything, ok).Return(nil)
+	mockBroker.On("Publish", mock.Anything, failing).Return(errors.New("broker down"))
+	mockRepo.On("MarkPublished", mock.Anything, "evt-1", mock.Anything).Return(nil)
+
+	// Attempt ke-4 : backoff 8 detik
+	before := time.Now().UTC()
+	mockRepo.On("MarkFailed", mock.Anything, "evt-2", "broker down", mock.MatchedBy(func(next time.Time) bool {
+		return !next.Before(before.Add(8*time.Second)) && next.Before(before.Add(9*time.Second))
+	})).Return(nil)
+
+	n, err := relay.RelayOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	mockRepo.AssertExpectations(t)
+	mockBroker.AssertExpectations(t)
+}
+
+func TestOutboxRelay_PurgesPublishedEventsOncePerInterval(t *testing.T) {
+	mockRepo := new(MockOutboxRepository)
+	relay := usecases.NewOutboxRelay(mockRepo, new(MockEventBroker), nil)
+
+	// Batch penuh langsung dilanjutkan sampai sisa event lama habis
+	retention := mock.MatchedBy(func(before time.Time) bool {
+		age := time.Since(before)
+		return age >= 7*24*time.Hour && age < 7*24*time.Hour+time.Minute
+	})
+	mockRepo.On("PurgePublished", mock.Anything, retention, 1000).Return(1000, nil).Once()
+	mockRepo.On("PurgePublished", mock.Anything, retention, 1000).Return(12, nil).Once()
+	mockRepo.On("ClaimPending", mock.Anything, mock.Anything, mock.Anything).Return([]*entities.DomainEvent{}, nil)
+
+	for i := 0; i < 2; i++ {
+		_, err := relay.RelayOnce(context.Background())
+		assert.NoError(t, err)
+	}
+
+	mockRepo.AssertNumberOfCalls(t, "PurgePublished", 2)
+	mockRepo.AssertNumberOfCalls(t, "ClaimPending", 2)
+}
+
+func TestAuthUseCase_Register_EnqueuesEventInTransaction(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockOutboxRepo := new(MockOutboxRepository)
+	transactor := &fakeTransactor{}
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithOutbox(usecases.NewOutbox(transactor, mockOutboxRepo)),
+	)
+
+	mockUserRepo.On("FindByEmail", mock.Anything, "new@example.com").Return(nil, nil)
+	mockUserRepo.On("CreateUser", mock.Anything, mock.Anything).Return(nil)
+	mockOutboxRepo.On("Enqueue", mock.Anything, mock.MatchedBy(func(events []*entities.DomainEvent) bool {
+		return len(events) == 1 && events[0].Type == entities.EventUserRegistered && events[0].ID != ""
+	})).Return(nil)
+
+	user, err := authUC.Register(context.Background(), "new@example.com", "password123", entities.ClientRole)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, transactor.calls)
+	mockOutboxRepo.AssertExpectations(t)
+
+	var payload entities.UserRegisteredPayload
+	events := mockOutboxRepo.Calls[0].Arguments.Get(1).([]*entities.DomainEvent)
+	assert.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+	assert.Equal(t, user.ID, payload.UserID)
+	assert.Equal(t, user.ID, events[0].AggregateID)
+	assert.NotContains(t, string(events[0].Payload), "new@example.com")
+}
+
+func TestAuthUseCase_Register_OutboxFailureFailsRegistration(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockOutboxRepo := new(MockOutboxRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, new(MockTokenRepository), "test-secret", nil,
+		usecases.WithOutbox(usecases.NewOutbox(&fakeTransactor{}, mockOutboxRepo)),
+	)
+
+	mockUserRepo.On("FindByEmail", mock.Anything, "new@example.com").Return(nil, nil)
+	mockUserRepo.On("CreateUser", mock.Anything, mock.Anything).Return(nil)
+	mockOutboxRepo.On("Enqueue", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	_, err := authUC.Register(context.Background(), "new@example.com", "password123", entities.ClientRole)
+
+	// Transaksi di-rollback sehingga user tidak tersimpan tanpa event-nya
+	assert.EqualError(t, err, "db down")
+}
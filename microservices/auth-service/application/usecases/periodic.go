@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// runPeriodically menjalankan step setiap interval sampai ctx dibatalkan. Selama step
+// melaporkan batch penuh (more), step langsung dipanggil lagi tanpa menunggu ticker.
+// Error hanya di-log dengan pesan failure; putaran berikutnya mencoba lagi.
+func runPeriodically(ctx context.Context, interval time.Duration, logger *zap.Logger, failure string, step func(context.Context) (more bool, err error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		more, err := step(ctx)
+		if err != nil {
+			logger.Warn(failure, zap.Error(err))
+		}
+		if err == nil && more && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/repositories"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const piiBatchSize = 100
+
+// PIIEncryptor menjalankan pemeliharaan enkripsi PII di background: mengenkripsi baris users
+// yang masih plaintext (beserta blind index email lama yang belum dinormalisasi) dan
+// membungkus ulang data key setelah master key dirotasi.
+// Beberapa instance boleh berjalan bersamaan karena baris dikunci dengan SKIP LOCKED.
+type PIIEncryptor struct {
+	repo   repositories.PIIRepository
+	logger *zap.Logger
+}
+
+func NewPIIEncryptor(repo repositories.PIIRepository, logger *zap.Logger) *PIIEncryptor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &PIIEncryptor{repo: repo, logger: logger}
+}
+
+// Run memanggil ProcessOnce lewat runPeriodically sampai ctx dibatalkan
+func (e *PIIEncryptor) Run(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, e.logger, "pii encryption failed", e.ProcessOnce)
+}
+
+// ProcessOnce memproses satu batch plaintext dan satu batch re-wrap, full bernilai true
+// jika salah satunya penuh (masih ada sisa)
+func (e *PIIEncryptor) ProcessOnce(ctx context.Context) (full bool, err error) {
+	encrypted, failed, err := e.repo.EncryptPlaintext(ctx, piiBatchSize)
+	if err != nil {
+		return false, err
+	}
+	if failed > 0 {
+		e.logger.Warn("pii encryption skipped rows, see users.pii_encryption_error", zap.Int("failed", failed))
+	}
+	rewrapped, err := e.repo.RewrapKeys(ctx, piiBatchSize)
+	if err != nil {
+		return false, err
+	}
+	return encrypted+failed == piiBatchSize || rewrapped == piiBatchSize, nil
+}
@@ -0,0 +1,68 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+)
+
+type MockPIIRepository struct {
+	mock.Mock
+}
+
+func (m *MockPIIRepository) EncryptPlaintext(ctx context.Context, limit int) (int, int, error) {
+	args := m.Called(ctx, limit)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (m *MockPIIRepository) RewrapKeys(ctx context.Context, limit int) (int, error) {
+	args := m.Called(ctx, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func TestPIIEncryptor_ProcessOnce(t *testing.T) {
+	ctx := context.Background()
+
+	// Batch re-wrap penuh: masih ada data key yang memakai master key lama
+	repo := new(MockPIIRepository)
+	repo.On("EncryptPlaintext", mock.Anything, 100).Return(3, 0, nil).Once()
+	repo.On("RewrapKeys", mock.Anything, 100).Return(100, nil).Once()
+	full, err := usecases.NewPIIEncryptor(repo, nil).ProcessOnce(ctx)
+	require.NoError(t, err)
+	assert.True(t, full)
+
+	repo.On("EncryptPlaintext", mock.Anything, 100).Return(0, 0, nil).Once()
+	repo.On("RewrapKeys", mock.Anything, 100).Return(12, nil).Once()
+	full, err = usecases.NewPIIEncryptor(repo, nil).ProcessOnce(ctx)
+	require.NoError(t, err)
+	assert.False(t, full)
+	repo.AssertExpectations(t)
+}
+
+// Baris yang gagal tidak menghentikan job dan ikut dihitung agar batch berikutnya langsung berjalan
+func TestPIIEncryptor_ProcessOnce_RowFailuresSkipped(t *testing.T) {
+	repo := new(MockPIIRepository)
+	repo.On("EncryptPlaintext", mock.Anything, 100).Return(98, 2, nil).Once()
+	repo.On("RewrapKeys", mock.Anything, 100).Return(0, nil).Once()
+
+	full, err := usecases.NewPIIEncryptor(repo, nil).ProcessOnce(context.Background())
+	require.NoError(t, err)
+	assert.True(t, full)
+	repo.AssertExpectations(t)
+}
+
+func TestPIIEncryptor_ProcessOnce_EncryptFails(t *testing.T) {
+	repo := new(MockPIIRepository)
+	repo.On("EncryptPlaintext", mock.Anything, 100).Return(0, 0, errors.New("db down"))
+
+	full, err := usecases.NewPIIEncryptor(repo, nil).ProcessOnce(context.Background())
+	assert.Error(t, err)
+	assert.False(t, full)
+	repo.AssertNotCalled(t, "RewrapKeys", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// publishRevocation menyiarkan event ke service lain. Revocation di Redis auth-service sudah terjadi,
+// jadi kegagalan publish hanya di-log (service lain tetap dibatasi umur access token).
+func publishRevocation(ctx context.Context, publisher services.RevocationPublisher, event *entities.RevocationEvent) {
+	if publisher == nil {
+		return
+	}
+	event.ID = auth.GenerateUUID()
+	event.OccurredAt = time.Now().UTC()
+	if err := publisher.PublishRevocation(ctx, event); err != nil {
+		zap.L().Warn("failed to publish revocation event",
+			zap.String("type", string(event.Type)),
+			zap.String("user_id", event.UserID),
+			zap.Error(err),
+		)
+	}
+}
+
+// userRevocation : event yang membatalkan semua access token user yang terbit sebelum sekarang
+func userRevocation(eventType entities.RevocationEventType, userID string) *entities.RevocationEvent {
+	issuedBefore := entities.RevocationWatermark(time.Now().UTC())
+	return &entities.RevocationEvent{
+		Type:         eventType,
+		UserID:       userID,
+		IssuedBefore: &issuedBefore,
+	}
+}
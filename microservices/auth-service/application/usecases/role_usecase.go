@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"strings"
+)
+
+// RoleUseCase mengelola role, permission dan penugasan role ke user (RBAC)
+type RoleUseCase struct {
+	userRepo  repositories.UserRepository
+	roleRepo  repositories.RoleRepository
+	tokenRepo repositories.TokenRepository
+	publisher services.RevocationPublisher
+	audit     services.AuditLogger
+}
+
+// RoleOption untuk dependency opsional RoleUseCase
+type RoleOption func(*RoleUseCase)
+
+// WithRoleAuditLogger mencatat perubahan role dan permission ke audit log
+func WithRoleAuditLogger(audit services.AuditLogger) RoleOption {
+	return func(uc *RoleUseCase) { uc.audit = audit }
+}
+
+// tokenRepo dan publisher boleh nil (e.g. CLI bootstrap), perubahan role lalu berlaku saat token di-refresh
+func NewRoleUseCase(userRepo repositories.UserRepository, roleRepo repositories.RoleRepository, tokenRepo repositories.TokenRepository, publisher services.RevocationPublisher, opts ...RoleOption) *RoleUseCase {
+	uc := &RoleUseCase{
+		userRepo:  userRepo,
+		roleRepo:  roleRepo,
+		tokenRepo: tokenRepo,
+		publisher: publisher,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+func (uc *RoleUseCase) ListRoles(ctx context.Context) ([]*entities.RoleDefinition, error) {
+	return uc.roleRepo.ListRoles(ctx)
+}
+
+func (uc *RoleUseCase) SetRolePermissions(ctx context.Context, role entities.Role, permissions []entities.Permission) error {
+	if err := uc.ensureRole(ctx, role); err != nil {
+		return err
+	}
+	if err := uc.roleRepo.SetRolePermissions(ctx, role, permissions); err != nil {
+		return err
+	}
+	// Claim permission di token pemegang role sudah basi
+	holders, err := uc.roleRepo.ListRoleHolders(ctx, role)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(permissions))
+	for i, p := range permissions {
+		names[i] = string(p)
+	}
+	recordAudit(ctx, uc.audit, &entities.AuditEvent{
+		Action:    entities.AuditRolePermissionsSet,
+		SubjectID: string(role),
+		Metadata:  map[string]string{"permissions": strings.Join(names, ",")},
+	})
+	for _, userID := range holders {
+		if err := uc.invalidateClaims(ctx, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *RoleUseCase) ListUserRoles(ctx context.Context, userID string) ([]entities.Role, error) {
+	if _, err := uc.findUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return uc.roleRepo.GetUserRoles(ctx, userID)
+}
+
+// AssignRole tidak bisa memberi role service, sama seperti AdminUseCase.SetRole
+func (uc *RoleUseCase) AssignRole(ctx context.Context, userID string, role entities.Role) error {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if role == entities.ServiceRole || user.IsServiceAccount() {
+		return entities.ErrInvalidRole
+	}
+	if err := uc.ensureRole(ctx, role); err != nil {
+		return err
+	}
+	if err := uc.roleRepo.AssignRole(ctx, userID, role); err != nil {
+		return err
+	}
+	return uc.roleChanged(ctx, entities.AuditRoleAssigned, userID, role)
+}
+
+// RevokeRole tidak bisa mencabut role utama, ubah role utama terlebih dahulu
+func (uc *RoleUseCase) RevokeRole(ctx context.Context, userID string, role entities.Role) error {
+	user, err := uc.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Role == role {
+		return entities.ErrPrimaryRole
+	}
+	if err := uc.roleRepo.RevokeRole(ctx, userID, role); err != nil {
+		return err
+	}
+	return uc.roleChanged(ctx, entities.AuditRoleRevoked, userID, role)
+}
+
+// roleChanged membatalkan access token lama agar claim role/permission segera diperbarui
+func (uc *RoleUseCase) roleChanged(ctx context.Context, action entities.AuditAction, userID string, role entities.Role) error {
+	recordAudit(ctx, uc.audit, &entities.AuditEvent{
+		Action:    action,
+		SubjectID: userID,
+		Metadata:  map[string]string{"role": string(role)},
+	})
+	return uc.invalidateClaims(ctx, userID)
+}
+
+func (uc *RoleUseCase) invalidateClaims(ctx context.Context, userID string) error {
+	if uc.tokenRepo != nil {
+		if err := uc.tokenRepo.InvalidateAccessTokens(ctx, userID); err != nil {
+			return err
+		}
+	}
+	publishRevocation(ctx, uc.publisher, userRevocation(entities.RevocationRoleChanged, userID))
+	return nil
+}
+
+func (uc *RoleUseCase) findUser(ctx context.Context, userID string) (*entities.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entities.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (uc *RoleUseCase) ensureRole(ctx context.Context, role entities.Role) error {
+	def, err := uc.roleRepo.FindRole(ctx, role)
+	if err != nil {
+		return err
+	}
+	if def == nil {
+		return entities.ErrRoleNotFound
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type MockRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepository) ListRoles(ctx context.Context) ([]*entities.RoleDefinition, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*entities.RoleDefinition), args.Error(1)
+}
+
+func (m *MockRoleRepository) FindRole(ctx context.Context, name entities.Role) (*entities.RoleDefinition, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RoleDefinition), args.Error(1)
+}
+
+func (m *MockRoleRepository) SetRolePermissions(ctx context.Context, name entities.Role, permissions []entities.Permission) error {
+	args := m.Called(ctx, name, permissions)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) GetUserRoles(ctx context.Context, userID string) ([]entities.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]entities.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) AssignRole(ctx context.Context, userID string, role entities.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) RevokeRole(ctx context.Context, userID string, role entities.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) ListRoleHolders(ctx context.Context, role entities.Role) ([]string, error) {
+	args := m.Called(ctx, role)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRoleRepository) GetPermissions(ctx context.Context, roles []entities.Role) ([]entities.Permission, error) {
+	args := m.Called(ctx, roles)
+	return args.Get(0).([]entities.Permission), args.Error(1)
+}
+
+func TestRoleUseCase_AssignRole_UnknownRole(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRoleRepo := new(MockRoleRepository)
+	uc := usecases.NewRoleUseCase(mockUserRepo, mockRoleRepo, nil, nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole}, nil)
+	mockRoleRepo.On("FindRole", mock.Anything, entities.Role("superuser")).Return(nil, nil)
+
+	err := uc.AssignRole(context.Background(), "user-123", "superuser")
+
+	assert.Equal(t, entities.ErrRoleNotFound, err)
+	mockRoleRepo.AssertNotCalled(t, "AssignRole")
+}
+
+func TestRoleUseCase_AssignRole_ServiceRole(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRoleRepo := new(MockRoleRepository)
+	uc := usecases.NewRoleUseCase(mockUserRepo, mockRoleRepo, nil, nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.ClientRole}, nil)
+
+	err := uc.AssignRole(context.Background(), "user-123", entities.ServiceRole)
+
+	assert.Equal(t, entities.ErrInvalidRole, err)
+	mockRoleRepo.AssertNotCalled(t, "AssignRole")
+}
+
+func TestRoleUseCase_SetRolePermissions_InvalidatesHolders(t *testing.T) {
+	mockRoleRepo := new(MockRoleRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockPublisher := new(MockRevocationPublisher)
+	uc := usecases.NewRoleUseCase(new(MockUserRepository), mockRoleRepo, mockTokenRepo, mockPublisher)
+
+	perms := []entities.Permission{"notes:read"}
+	mockRoleRepo.On("FindRole", mock.Anything, entities.PsychologistRole).Return(&entities.RoleDefinition{Name: entities.PsychologistRole}, nil)
+	mockRoleRepo.On("SetRolePermissions", mock.Anything, entities.PsychologistRole, perms).Return(nil)
+	mockRoleRepo.On("ListRoleHolders", mock.Anything, entities.PsychologistRole).Return([]string{"psy-1", "psy-2"}, nil)
+	mockTokenRepo.On("InvalidateAccessTokens", mock.Anything, mock.Anything).Return(nil)
+	mockPublisher.On("PublishRevocation", mock.Anything, mock.MatchedBy(func(e *entities.RevocationEvent) bool {
+		return e.Type == entities.RevocationRoleChanged
+	})).Return(nil)
+
+	require.NoError(t, uc.SetRolePermissions(context.Background(), entities.PsychologistRole, perms))
+
+	mockTokenRepo.AssertCalled(t, "InvalidateAccessTokens", mock.Anything, "psy-1")
+	mockTokenRepo.AssertCalled(t, "InvalidateAccessTokens", mock.Anything, "psy-2")
+	mockPublisher.AssertNumberOfCalls(t, "PublishRevocation", 2)
+}
+
+func TestRoleUseCase_RevokeRole_PrimaryRole(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockRoleRepo := new(MockRoleRepository)
+	uc := usecases.NewRoleUseCase(mockUserRepo, mockRoleRepo, nil, nil)
+
+	mockUserRepo.On("FindByID", mock.Anything, "user-123").Return(&entities.User{ID: "user-123", Role: entities.PsychologistRole}, nil)
+
+	err := uc.RevokeRole(context.Background(), "user-123", entities.PsychologistRole)
+
+	assert.Equal(t, entities.ErrPrimaryRole, err)
+	mockRoleRepo.AssertNotCalled(t, "RevokeRole")
+}
+
+func TestAuthUseCase_Login_PermissionClaims(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockRoleRepo := new(MockRoleRepository)
+	authUC := usecases.NewAuthUseCase(mockUserRepo, mockTokenRepo, "test-secret", nil, usecases.WithRoleRepository(mockRoleRepo))
+
+	hash, err := auth.Argon2Hash("password123")
+	assert.NoError(t, err)
+	user := &entities.User{
+		ID:           "user-123",
+		Email:        "support@example.com",
+		PasswordHash: hash,
+		Role:         entities.ClientRole,
+		Roles:        []entities.Role{entities.ClientRole, entities.SupportRole},
+		Status:       entities.StatusActive,
+	}
+
+	mockUserRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	mockRoleRepo.On("GetPermissions", mock.Anything, user.Roles).Return([]entities.Permission{entities.PermUsersRead}, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(user.ID)).Return(nil)
+
+	accessToken, _, err := authUC.Login(context.Background(), user.Email, "password123")
+	assert.NoError(t, err)
+
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(accessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"client", "support"}, claims.Roles)
+	assert.True(t, claims.HasPermission(string(entities.PermUsersRead)))
+}
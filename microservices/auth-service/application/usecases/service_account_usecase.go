@@ -0,0 +1,213 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Domain email untuk service account, tidak pernah menerima email sungguhan
+const serviceAccountEmailDomain = "@service.internal"
+
+// ServiceAccountUseCase mengelola akun mesin dan API key-nya untuk komunikasi antar service
+type ServiceAccountUseCase struct {
+	userRepo   repositories.UserRepository
+	apiKeyRepo repositories.APIKeyRepository
+	jwtAuth    *auth.JWTAuth
+	audit      services.AuditLogger
+	logger     *zap.Logger
+}
+
+// ServiceAccountOption untuk dependency opsional ServiceAccountUseCase
+type ServiceAccountOption func(*ServiceAccountUseCase)
+
+// WithServiceAccountAuditLogger mencatat pembuatan service account dan siklus hidup API key
+// ke audit log, actor diambil dari context
+func WithServiceAccountAuditLogger(audit services.AuditLogger) ServiceAccountOption {
+	return func(uc *ServiceAccountUseCase) { uc.audit = audit }
+}
+
+func NewServiceAccountUseCase(userRepo repositories.UserRepository, apiKeyRepo repositories.APIKeyRepository, jwtSecret string, logger *zap.Logger, opts ...ServiceAccountOption) *ServiceAccountUseCase {
+	uc := &ServiceAccountUseCase{
+		userRepo:   userRepo,
+		apiKeyRepo: apiKeyRepo,
+		jwtAuth:    auth.NewJWTAuth(jwtSecret),
+		logger:     logger,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+func (uc *ServiceAccountUseCase) CreateServiceAccount(ctx context.Context, name string) (*entities.User, error) {
+	email := strings.ToLower(name) + serviceAccountEmailDomain
+	if existing, _ := uc.userRepo.FindByEmail(ctx, email); existing != nil {
+		return nil, entities.ErrEmailExists
+	}
+
+	account := &entities.User{
+		ID:        auth.GenerateUUID(),
+		Email:     email,
+		Role:      entities.ServiceRole,
+		Status:    entities.StatusActive,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := uc.userRepo.CreateUser(ctx, account); err != nil {
+		return nil, err
+	}
+	uc.auditAction(ctx, entities.AuditServiceAccountCreated, account.ID, map[string]string{"name": name})
+	return account, nil
+}
+
+// CreateAPIKey mengembalikan plaintext key yang hanya ditampilkan sekali
+func (uc *ServiceAccountUseCase) CreateAPIKey(ctx context.Context, serviceAccountID string, scopes []string, ttl time.Duration) (string, *entities.APIKey, error) {
+	plain, key, err := uc.createAPIKey(ctx, serviceAccountID, scopes, ttl)
+	if err != nil {
+		return "", nil, err
+	}
+	uc.auditAction(ctx, entities.AuditAPIKeyCreated, key.ServiceAccountID, keyMetadata(key))
+	return plain, key, nil
+}
+
+func (uc *ServiceAccountUseCase) createAPIKey(ctx context.Context, serviceAccountID string, scopes []string, ttl time.Duration) (string, *entities.APIKey, error) {
+	account, err := uc.userRepo.FindByID(ctx, serviceAccountID)
+	if err != nil || account == nil || !account.IsServiceAccount() {
+		return "", nil, entities.ErrUserNotFound
+	}
+
+	plain, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	key := &entities.APIKey{
+		ID:               auth.GenerateUUID(),
+		ServiceAccountID: account.ID,
+		Prefix:           prefix,
+		KeyHash:          hash,
+		Scopes:           scopes,
+		CreatedAt:        time.Now().UTC(),
+	}
+	if ttl > 0 {
+		expiresAt := key.CreatedAt.Add(ttl)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.apiKeyRepo.CreateAPIKey(ctx, key); err != nil {
+		return "", nil, err
+	}
+	return plain, key, nil
+}
+
+func (uc *ServiceAccountUseCase) ListAPIKeys(ctx context.Context, serviceAccountID string) ([]*entities.APIKey, error) {
+	return uc.apiKeyRepo.ListByServiceAccount(ctx, serviceAccountID)
+}
+
+// RotateAPIKey membuat key baru dengan scope yang sama lalu mencabut key lama
+func (uc *ServiceAccountUseCase) RotateAPIKey(ctx context.Context, keyID string, ttl time.Duration) (string, *entities.APIKey, error) {
+	old, err := uc.apiKeyRepo.FindByID(ctx, keyID)
+	if err != nil {
+		return "", nil, err
+	}
+	if old == nil || old.RevokedAt != nil {
+		return "", nil, entities.ErrAPIKeyNotFound
+	}
+
+	plain, key, err := uc.createAPIKey(ctx, old.ServiceAccountID, old.Scopes, ttl)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := uc.apiKeyRepo.RevokeAPIKey(ctx, old.ID, time.Now().UTC()); err != nil {
+		return "", nil, err
+	}
+	metadata := keyMetadata(key)
+	metadata["previous_key_id"] = old.ID
+	uc.auditAction(ctx, entities.AuditAPIKeyRotated, key.ServiceAccountID, metadata)
+	return plain, key, nil
+}
+
+func (uc *ServiceAccountUseCase) RevokeAPIKey(ctx context.Context, keyID string) error {
+	key, err := uc.apiKeyRepo.FindByID(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return entities.ErrAPIKeyNotFound
+	}
+	if err := uc.apiKeyRepo.RevokeAPIKey(ctx, key.ID, time.Now().UTC()); err != nil {
+		return err
+	}
+	uc.auditAction(ctx, entities.AuditAPIKeyRevoked, key.ServiceAccountID, map[string]string{"key_id": key.ID})
+	return nil
+}
+
+// auditAction : subject adalah service account pemilik key
+func (uc *ServiceAccountUseCase) auditAction(ctx context.Context, action entities.AuditAction, serviceAccountID string, metadata map[string]string) {
+	recordAudit(ctx, uc.audit, &entities.AuditEvent{
+		Action:    action,
+		SubjectID: serviceAccountID,
+		Metadata:  metadata,
+	})
+}
+
+// keyMetadata : hanya ID, prefix dan scope; key maupun hash-nya tidak pernah dicatat
+func keyMetadata(key *entities.APIKey) map[string]string {
+	return map[string]string{
+		"key_id": key.ID,
+		"prefix": key.Prefix,
+		"scopes": strings.Join(key.Scopes, ","),
+	}
+}
+
+// ExchangeClientCredentials : grant client_credentials, client_id = ID service account, client_secret = API key.
+// Jika scopes kosong, token mendapat semua scope milik key.
+func (uc *ServiceAccountUseCase) ExchangeClientCredentials(ctx context.Context, clientID, clientSecret string, scopes []string) (string, time.Duration, []string, error) {
+	prefix := auth.ParseAPIKeyPrefix(clientSecret)
+	if prefix == "" {
+		return "", 0, nil, entities.ErrInvalidClient
+	}
+
+	key, err := uc.apiKeyRepo.FindByPrefix(ctx, prefix)
+	if err != nil || key == nil {
+		return "", 0, nil, entities.ErrInvalidClient
+	}
+
+	now := time.Now().UTC()
+	if key.ServiceAccountID != clientID || !key.IsActive(now) || !auth.VerifyAPIKey(clientSecret, key.KeyHash) {
+		return "", 0, nil, entities.ErrInvalidClient
+	}
+
+	// Service account yang disuspend tidak boleh mendapatkan token baru
+	account, err := uc.userRepo.FindByID(ctx, key.ServiceAccountID)
+	if err != nil || account == nil {
+		return "", 0, nil, entities.ErrInvalidClient
+	}
+	if err := account.StatusError(); err != nil {
+		return "", 0, nil, entities.ErrInvalidClient
+	}
+
+	if len(scopes) == 0 {
+		scopes = key.Scopes
+	} else if !key.HasScopes(scopes) {
+		return "", 0, nil, entities.ErrInvalidScope
+	}
+
+	token, expiresIn, err := uc.jwtAuth.GenerateServiceToken(key.ServiceAccountID, scopes)
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	if err := uc.apiKeyRepo.TouchLastUsed(ctx, key.ID, now); err != nil && uc.logger != nil {
+		uc.logger.Warn("failed to update api key last_used_at", zap.Error(err))
+	}
+
+	return token, expiresIn, scopes, nil
+}
@@ -0,0 +1,197 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, key *entities.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) FindByID(ctx context.Context, id string) (*entities.APIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) ListByServiceAccount(ctx context.Context, serviceAccountID string) ([]*entities.APIKey, error) {
+	args := m.Called(ctx, serviceAccountID)
+	return args.Get(0).([]*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	args := m.Called(ctx, id, revokedAt)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	args := m.Called(ctx, id, usedAt)
+	return args.Error(0)
+}
+
+func newStoredAPIKey(t *testing.T, scopes []string) (string, *entities.APIKey) {
+	plain, prefix, hash, err := auth.GenerateAPIKey()
+	assert.NoError(t, err)
+	return plain, &entities.APIKey{
+		ID:               "key-1",
+		ServiceAccountID: "svc-1",
+		Prefix:           prefix,
+		KeyHash:          hash,
+		Scopes:           scopes,
+		CreatedAt:        time.Now(),
+	}
+}
+
+func TestServiceAccountUseCase_RotateAPIKey_Audited(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	mockAudit := new(MockAuditLogger)
+	uc := usecases.NewServiceAccountUseCase(mockUserRepo, mockAPIKeyRepo, "test-secret", nil, usecases.WithServiceAccountAuditLogger(mockAudit))
+
+	_, old := newStoredAPIKey(t, []string{"bookings:read"})
+	mockAPIKeyRepo.On("FindByID", mock.Anything, old.ID).Return(old, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "svc-1").Return(&entities.User{ID: "svc-1", Role: entities.ServiceRole, Status: entities.StatusActive}, nil)
+	mockAPIKeyRepo.On("CreateAPIKey", mock.Anything, mock.AnythingOfType("*entities.APIKey")).Return(nil)
+	mockAPIKeyRepo.On("RevokeAPIKey", mock.Anything, old.ID, mock.Anything).Return(nil)
+	mockAudit.On("Record", mock.Anything, mock.AnythingOfType("*entities.AuditEvent")).Return(nil)
+
+	plain, key, err := uc.RotateAPIKey(context.Background(), old.ID, 0)
+
+	assert.NoError(t, err)
+	// Hanya satu event rotasi, tanpa event pembuatan key terpisah, dan key tidak ikut tercatat
+	mockAudit.AssertNumberOfCalls(t, "Record", 1)
+	event := mockAudit.Calls[0].Arguments.Get(1).(*entities.AuditEvent)
+	assert.Equal(t, entities.AuditAPIKeyRotated, event.Action)
+	assert.Equal(t, "svc-1", event.SubjectID)
+	assert.Equal(t, key.ID, event.Metadata["key_id"])
+	assert.Equal(t, old.ID, event.Metadata["previous_key_id"])
+	for _, v := range event.Metadata {
+		assert.NotContains(t, v, plain)
+	}
+}
+
+func TestServiceAccountUseCase_ExchangeClientCredentials_Success(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	uc := usecases.NewServiceAccountUseCase(mockUserRepo, mockAPIKeyRepo, "test-secret", nil)
+
+	plain, key := newStoredAPIKey(t, []string{"bookings:read", "notifications:send"})
+	mockAPIKeyRepo.On("FindByPrefix", mock.Anything, key.Prefix).Return(key, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "svc-1").Return(&entities.User{ID: "svc-1", Role: entities.ServiceRole, Status: entities.StatusActive}, nil)
+	mockAPIKeyRepo.On("TouchLastUsed", mock.Anything, key.ID, mock.Anything).Return(nil)
+
+	token, expiresIn, scopes, err := uc.ExchangeClientCredentials(context.Background(), "svc-1", plain, []string{"bookings:read"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, expiresIn)
+	assert.Equal(t, []string{"bookings:read"}, scopes)
+
+	claims, err := auth.NewJWTAuth("test-secret").ValidateToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "svc-1", claims.UserID)
+	assert.Equal(t, string(entities.ServiceRole), claims.Role)
+	assert.Equal(t, []string{"bookings:read"}, claims.Scopes)
+}
+
+func TestServiceAccountUseCase_ExchangeClientCredentials_WrongSecret(t *testing.T) {
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	uc := usecases.NewServiceAccountUseCase(new(MockUserRepository), mockAPIKeyRepo, "test-secret", nil)
+
+	_, key := newStoredAPIKey(t, []string{"bookings:read"})
+	mockAPIKeyRepo.On("FindByPrefix", mock.Anything, key.Prefix).Return(key, nil)
+
+	_, _, _, err := uc.ExchangeClientCredentials(context.Background(), "svc-1", "psk_"+key.Prefix+"_forged", nil)
+
+	assert.Equal(t, entities.ErrInvalidClient, err)
+	mockAPIKeyRepo.AssertNotCalled(t, "TouchLastUsed")
+}
+
+func TestServiceAccountUseCase_ExchangeClientCredentials_RevokedKey(t *testing.T) {
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	uc := usecases.NewServiceAccountUseCase(new(MockUserRepository), mockAPIKeyRepo, "test-secret", nil)
+
+	plain, key := newStoredAPIKey(t, []string{"bookings:read"})
+	revokedAt := time.Now()
+	key.RevokedAt = &revokedAt
+	mockAPIKeyRepo.On("FindByPrefix", mock.Anything, key.Prefix).Return(key, nil)
+
+	_, _, _, err := uc.ExchangeClientCredentials(context.Background(), "svc-1", plain, nil)
+
+	assert.Equal(t, entities.ErrInvalidClient, err)
+}
+
+func TestServiceAccountUseCase_ExchangeClientCredentials_ScopeNotAllowed(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	uc := usecases.NewServiceAccountUseCase(mockUserRepo, mockAPIKeyRepo, "test-secret", nil)
+
+	plain, key := newStoredAPIKey(t, []string{"bookings:read"})
+	mockAPIKeyRepo.On("FindByPrefix", mock.Anything, key.Prefix).Return(key, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "svc-1").Return(&entities.User{ID: "svc-1", Role: entities.ServiceRole, Status: entities.StatusActive}, nil)
+
+	_, _, _, err := uc.ExchangeClientCredentials(context.Background(), "svc-1", plain, []string{"bookings:write"})
+
+	assert.Equal(t, entities.ErrInvalidScope, err)
+}
+
+func TestServiceAccountUseCase_ExchangeClientCredentials_SuspendedAccount(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	uc := usecases.NewServiceAccountUseCase(mockUserRepo, mockAPIKeyRepo, "test-secret", nil)
+
+	plain, key := newStoredAPIKey(t, []string{"bookings:read"})
+	mockAPIKeyRepo.On("FindByPrefix", mock.Anything, key.Prefix).Return(key, nil)
+	mockUserRepo.On("FindByID", mock.Anything, "svc-1").Return(&entities.User{ID: "svc-1", Role: entities.ServiceRole, Status: entities.StatusSuspended}, nil)
+
+	_, _, _, err := uc.ExchangeClientCredentials(context.Background(), "svc-1", plain, nil)
+
+	assert.Equal(t, entities.ErrInvalidClient, err)
+	mockAPIKeyRepo.AssertNotCalled(t, "TouchLastUsed")
+}
+
+func TestServiceAccountUseCase_RotateAPIKey(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockAPIKeyRepo := new(MockAPIKeyRepository)
+	uc := usecases.NewServiceAccountUseCase(mockUserRepo, mockAPIKeyRepo, "test-secret", nil)
+
+	_, old := newStoredAPIKey(t, []string{"bookings:read"})
+	account := &entities.User{ID: "svc-1", Role: entities.ServiceRole}
+
+	mockAPIKeyRepo.On("FindByID", mock.Anything, old.ID).Return(old, nil)
+	mockUserRepo.On("FindByID", mock.Anything, account.ID).Return(account, nil)
+	mockAPIKeyRepo.On("CreateAPIKey", mock.Anything, mock.MatchedBy(func(k *entities.APIKey) bool {
+		return k.ServiceAccountID == account.ID && k.Prefix != old.Prefix
+	})).Return(nil)
+	mockAPIKeyRepo.On("RevokeAPIKey", mock.Anything, old.ID, mock.Anything).Return(nil)
+
+	plain, key, err := uc.RotateAPIKey(context.Background(), old.ID, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, key.Prefix, auth.ParseAPIKeyPrefix(plain))
+	assert.Equal(t, old.Scopes, key.Scopes)
+	mockAPIKeyRepo.AssertExpectations(t)
+}
@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+)
+
+// SocialAuthUseCase menangani login federasi melalui identity provider eksternal
+type SocialAuthUseCase struct {
+	authUC       *AuthUseCase
+	identityRepo repositories.IdentityRepository
+	providers    map[string]services.IdentityProvider
+}
+
+func NewSocialAuthUseCase(authUC *AuthUseCase, identityRepo repositories.IdentityRepository, providers ...services.IdentityProvider) *SocialAuthUseCase {
+	uc := &SocialAuthUseCase{
+		authUC:       authUC,
+		identityRepo: identityRepo,
+		providers:    make(map[string]services.IdentityProvider),
+	}
+	for _, p := range providers {
+		uc.providers[p.Name()] = p
+	}
+	return uc
+}
+
+func (uc *SocialAuthUseCase) AuthCodeURL(ctx context.Context, provider, state, nonce string) (string, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return "", entities.ErrUnknownProvider
+	}
+	return p.AuthCodeURL(ctx, state, nonce)
+}
+
+// Login menukar authorization code dengan identitas eksternal lalu menerbitkan token kita sendiri.
+//
+// Aturan account linking:
+//   - identitas yang sudah terhubung langsung login sebagai user tersebut
+//   - email yang sudah terdaftar hanya di-link jika provider menyatakan email terverifikasi
+//   - selain itu dibuat user baru dengan role client
+func (uc *SocialAuthUseCase) Login(ctx context.Context, provider, code, nonce string) (string, string, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return "", "", entities.ErrUnknownProvider
+	}
+
+	external, err := p.Exchange(ctx, code, nonce)
+	if err != nil {
+		return "", "", entities.ErrInvalidCredentials
+	}
+
+	user, err := uc.resolveUser(ctx, external)
+	if err != nil {
+		return "", "", err
+	}
+	if err := user.StatusError(); err != nil {
+		return "", "", err
+	}
+
+	return uc.authUC.completeLogin(ctx, user, entities.AuthMethodFederated, provider)
+}
+
+func (uc *SocialAuthUseCase) resolveUser(ctx context.Context, external *entities.ExternalIdentity) (*entities.User, error) {
+	identity, err := uc.identityRepo.FindByProviderSubject(ctx, external.Provider, external.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity != nil {
+		user, err := uc.authUC.userRepo.FindByID(ctx, identity.UserID)
+		if err != nil || user == nil {
+			return nil, entities.ErrUserNotFound
+		}
+		return user, nil
+	}
+
+	// Tanpa email terverifikasi kita tidak bisa membuktikan kepemilikan akun
+	if external.Email == "" || !external.EmailVerified {
+		return nil, entities.ErrEmailNotVerified
+	}
+
+	user, err := uc.authUC.userRepo.FindByEmail(ctx, external.Email)
+	if err != nil {
+		return nil, err
+	}
+	var events []*entities.DomainEvent
+	isNew := user == nil
+	if isNew {
+		// Email sudah diverifikasi provider
+		now := time.Now().UTC()
+		user = &entities.User{
+			ID:              auth.GenerateUUID(),
+			Email:           external.Email,
+			Role:            entities.ClientRole,
+			Status:          entities.StatusActive,
+			EmailVerifiedAt: &now,
+			CreatedAt:       now,
+		}
+		events = append(events, newDomainEvent(entities.EventUserRegistered, user.ID, entities.UserRegisteredPayload{
+			UserID:   user.ID,
+			Role:     user.Role,
+			Provider: external.Provider,
+		}))
+	}
+
+	// User baru dan identitasnya dibuat dalam satu transaksi bersama event-nya
+	if err := uc.authUC.outbox.record(ctx, func(ctx context.Context) error {
+		if isNew {
+			if err := uc.authUC.userRepo.CreateUser(ctx, user); err != nil {
+				return err
+			}
+		}
+		return uc.identityRepo.CreateIdentity(ctx, &entities.UserIdentity{
+			ID:        auth.GenerateUUID(),
+			UserID:    user.ID,
+			Provider:  external.Provider,
+			Subject:   external.Subject,
+			Email:     external.Email,
+			CreatedAt: time.Now().UTC(),
+		})
+	}, events...); err != nil {
+		return nil, err
+	}
+	if isNew {
+		uc.authUC.auditSelf(ctx, entities.AuditRegister, user.ID, map[string]string{
+			"role":     string(user.Role),
+			"provider": external.Provider,
+		})
+	}
+
+	return user, nil
+}
@@ -0,0 +1,154 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/oidc"
+	"microservices/auth-service/infrastructure/oidc/oidctest"
+)
+
+type MockIdentityRepository struct {
+	mock.Mock
+}
+
+func (m *MockIdentityRepository) CreateIdentity(ctx context.Context, identity *entities.UserIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+func (m *MockIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*entities.UserIdentity, error) {
+	args := m.Called(ctx, provider, subject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.UserIdentity), args.Error(1)
+}
+
+func (m *MockIdentityRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.UserIdentity, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*entities.UserIdentity), args.Error(1)
+}
+
+func newSocialAuthUseCase(p *oidctest.Provider, userRepo *MockUserRepository, tokenRepo *MockTokenRepository, identityRepo *MockIdentityRepository) *usecases.SocialAuthUseCase {
+	authUC := usecases.NewAuthUseCase(userRepo, tokenRepo, "test-secret", nil)
+	client := oidc.NewClient(oidc.Config{
+		Name:         "google",
+		Issuer:       p.Issuer(),
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "https://app.example.com/callback",
+	})
+	return usecases.NewSocialAuthUseCase(authUC, identityRepo, client)
+}
+
+func TestSocialAuthUseCase_Login_NewUser(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+	p.RegisterCode("code-1", oidctest.Identity{Subject: "sub-1", Email: "new@example.com", EmailVerified: true, Nonce: "n"})
+
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockIdentityRepo := new(MockIdentityRepository)
+	socialUC := newSocialAuthUseCase(p, mockUserRepo, mockTokenRepo, mockIdentityRepo)
+
+	mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "google", "sub-1").Return(nil, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "new@example.com").Return(nil, nil)
+	mockUserRepo.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.Email == "new@example.com" && u.Role == entities.ClientRole
+	})).Return(nil)
+	mockIdentityRepo.On("CreateIdentity", mock.Anything, mock.MatchedBy(func(i *entities.UserIdentity) bool {
+		return i.Provider == "google" && i.Subject == "sub-1"
+	})).Return(nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, mock.AnythingOfType("*entities.Session")).Return(nil)
+
+	accessToken, refreshToken, err := socialUC.Login(context.Background(), "google", "code-1", "n")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, accessToken)
+	assert.NotEmpty(t, refreshToken)
+	mockUserRepo.AssertExpectations(t)
+	mockIdentityRepo.AssertExpectations(t)
+}
+
+func TestSocialAuthUseCase_Login_LinkedIdentity(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+	p.RegisterCode("code-1", oidctest.Identity{Subject: "sub-1", Nonce: "n"})
+
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockIdentityRepo := new(MockIdentityRepository)
+	socialUC := newSocialAuthUseCase(p, mockUserRepo, mockTokenRepo, mockIdentityRepo)
+
+	user := &entities.User{ID: "user-123", Email: "user@example.com", Role: entities.ClientRole, Status: entities.StatusActive}
+	mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "google", "sub-1").
+		Return(&entities.UserIdentity{UserID: user.ID, Provider: "google", Subject: "sub-1"}, nil)
+	mockUserRepo.On("FindByID", mock.Anything, user.ID).Return(user, nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(user.ID)).Return(nil)
+
+	_, _, err := socialUC.Login(context.Background(), "google", "code-1", "n")
+
+	assert.NoError(t, err)
+	mockIdentityRepo.AssertNotCalled(t, "CreateIdentity")
+	mockUserRepo.AssertNotCalled(t, "CreateUser")
+}
+
+func TestSocialAuthUseCase_Login_ExistingEmailUnverified(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+	p.RegisterCode("code-1", oidctest.Identity{Subject: "sub-1", Email: "user@example.com", EmailVerified: false, Nonce: "n"})
+
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockIdentityRepo := new(MockIdentityRepository)
+	socialUC := newSocialAuthUseCase(p, mockUserRepo, mockTokenRepo, mockIdentityRepo)
+
+	mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "google", "sub-1").Return(nil, nil)
+
+	_, _, err := socialUC.Login(context.Background(), "google", "code-1", "n")
+
+	assert.Equal(t, entities.ErrEmailNotVerified, err)
+	mockIdentityRepo.AssertNotCalled(t, "CreateIdentity")
+}
+
+func TestSocialAuthUseCase_Login_LinksExistingEmail(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+	p.RegisterCode("code-1", oidctest.Identity{Subject: "sub-1", Email: "user@example.com", EmailVerified: true, Nonce: "n"})
+
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockTokenRepository)
+	mockIdentityRepo := new(MockIdentityRepository)
+	socialUC := newSocialAuthUseCase(p, mockUserRepo, mockTokenRepo, mockIdentityRepo)
+
+	user := &entities.User{ID: "user-123", Email: "user@example.com", Role: entities.PsychologistRole, Status: entities.StatusActive}
+	mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "google", "sub-1").Return(nil, nil)
+	mockUserRepo.On("FindByEmail", mock.Anything, "user@example.com").Return(user, nil)
+	mockIdentityRepo.On("CreateIdentity", mock.Anything, mock.MatchedBy(func(i *entities.UserIdentity) bool {
+		return i.UserID == user.ID
+	})).Return(nil)
+	mockTokenRepo.On("StoreToken", mock.Anything, sessionFor(user.ID)).Return(nil)
+
+	_, _, err := socialUC.Login(context.Background(), "google", "code-1", "n")
+
+	assert.NoError(t, err)
+	mockUserRepo.AssertNotCalled(t, "CreateUser")
+	mockIdentityRepo.AssertExpectations(t)
+}
+
+func TestSocialAuthUseCase_Login_UnknownProvider(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+
+	socialUC := newSocialAuthUseCase(p, new(MockUserRepository), new(MockTokenRepository), new(MockIdentityRepository))
+
+	_, _, err := socialUC.Login(context.Background(), "facebook", "code-1", "n")
+
+	assert.Equal(t, entities.ErrUnknownProvider, err)
+}
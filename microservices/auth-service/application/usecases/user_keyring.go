@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/repositories"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+// UserKeyring mengenkripsi data pribadi yang disimpan di luar baris users (e.g. arsip export)
+// dengan kunci milik user. Saat akun dihapus kunci dimusnahkan, sehingga salinan yang
+// tertinggal (backup, replika) ikut tidak bisa dibaca.
+type UserKeyring struct {
+	repo repositories.UserKeyRepository
+}
+
+func NewUserKeyring(repo repositories.UserKeyRepository) *UserKeyring {
+	return &UserKeyring{repo: repo}
+}
+
+// Seal membuat kunci user saat pertama kali dibutuhkan. Kunci user yang sudah dihapus tidak
+// dibuat ulang: ErrUserKeyDestroyed.
+func (k *UserKeyring) Seal(ctx context.Context, userID string, plaintext []byte) ([]byte, error) {
+	key, err := k.repo.EnsureKey(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return auth.Seal(key, plaintext)
+}
+
+// Open mengembalikan ErrUserKeyDestroyed jika kunci user sudah dimusnahkan
+func (k *UserKeyring) Open(ctx context.Context, userID string, ciphertext []byte) ([]byte, error) {
+	key, err := k.repo.FindKey(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, entities.ErrUserKeyDestroyed
+	}
+	return auth.Open(key, ciphertext)
+}
+
+// Destroy memusnahkan kunci user (crypto-shredding)
+func (k *UserKeyring) Destroy(ctx context.Context, userID string) error {
+	return k.repo.DestroyKey(ctx, userID)
+}
@@ -1,10 +1,18 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"microservices/auth-service/application/usecases"
 	"microservices/auth-service/config"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/geoip"
+	"microservices/auth-service/infrastructure/kms"
 	"microservices/auth-service/infrastructure/logger"
+	"microservices/auth-service/infrastructure/messaging"
+	"microservices/auth-service/infrastructure/notification"
+	"microservices/auth-service/infrastructure/oidc"
 	"microservices/auth-service/infrastructure/persistence"
 	"microservices/auth-service/interfaces/middleware"
 	"microservices/auth-service/interfaces/rpc"
@@ -35,6 +43,13 @@ func main() {
 	}
 	defer db.Close()
 
+	// Hanya dipakai untuk pseudonimisasi audit log oleh worker penghapusan akun
+	erasureDB, err := sql.Open("postgres", cfg.ErasureDBURL)
+	if err != nil {
+		zap.L().Fatal("failed to connect to erasure database", zap.Error(err))
+	}
+	defer erasureDB.Close()
+
 	// Setup Redis
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     cfg.RedisURL,
@@ -43,10 +58,156 @@ func main() {
 	})
 	defer redisClient.Close()
 
+	// Envelope encryption PII: master key dari file KMS lokal
+	if created, err := kms.EnsureKeyFile(cfg.KMSKeyFile); err != nil {
+		zap.L().Fatal("failed to create master key file", zap.Error(err))
+	} else if created {
+		zap.L().Warn("created new master key file", zap.String("path", cfg.KMSKeyFile))
+	}
+	masterKeys, err := kms.NewFileKeyManager(cfg.KMSKeyFile)
+	if err != nil {
+		zap.L().Fatal("failed to load master keys", zap.Error(err))
+	}
+	piiCipher := persistence.NewPIICipher(masterKeys, []byte(cfg.BlindIndexKey))
+
+	// Tanda tangan arsip export data, diverifikasi user dengan public key yang dipublikasikan
+	if created, err := kms.EnsureSigningKeyFile(cfg.ExportSigningKeyFile); err != nil {
+		zap.L().Fatal("failed to create export signing key file", zap.Error(err))
+	} else if created {
+		zap.L().Warn("created new export signing key file", zap.String("path", cfg.ExportSigningKeyFile))
+	}
+	exportSigner, err := kms.NewFileSigner(cfg.ExportSigningKeyFile)
+	if err != nil {
+		zap.L().Fatal("failed to load export signing keys", zap.Error(err))
+	}
+
 	// Dependency injection
-	userRepo := persistence.NewPostgresUserRepository(db)
+	userRepo := persistence.NewPostgresUserRepository(db, piiCipher)
 	tokenRepo := persistence.NewRedisTokenRepository(redisClient)
-	authUC := usecases.NewAuthUseCase(userRepo, tokenRepo, cfg.JWTSecret, zap.L())
+	identityRepo := persistence.NewPostgresIdentityRepository(db)
+	roleRepo := persistence.NewPostgresRoleRepository(db)
+	resetRepo := persistence.NewRedisPasswordResetRepository(redisClient)
+	credentialRepo := persistence.NewPostgresCredentialRepository(db)
+	revocationPublisher := messaging.NewRedisRevocationPublisher(redisClient, cfg.RevocationChannel)
+	outboxRepo := persistence.NewPostgresOutboxRepository(db)
+	outbox := usecases.NewOutbox(persistence.NewPostgresTransactor(db), outboxRepo)
+	auditRepo := persistence.NewPostgresAuditRepository(db, erasureDB)
+	auditUC := usecases.NewAuditUseCase(auditRepo)
+
+	// Penilaian risiko login, lokasi hanya tersedia jika database GeoIP dikonfigurasi
+	var geoLocator services.GeoLocator
+	if cfg.GeoIPDBPath != "" {
+		locator, err := geoip.NewMaxMindLocator(cfg.GeoIPDBPath)
+		if err != nil {
+			zap.L().Fatal("failed to open geoip database", zap.Error(err))
+		}
+		defer locator.Close()
+		geoLocator = locator
+	}
+	riskPolicy := usecases.DefaultLoginRiskPolicy()
+	riskPolicy.HighRiskThreshold = cfg.LoginRiskThreshold
+	riskPolicy.RequireStepUp = cfg.StepUpOnHighRisk
+	riskAssessor := usecases.NewLoginRiskAssessor(persistence.NewPostgresLoginHistoryRepository(db), geoLocator, riskPolicy)
+
+	// Belum ada provider SMS, kode OTP SMS ditulis ke spool directory
+	spool, err := notification.NewSpoolSender(cfg.SpoolDir)
+	if err != nil {
+		zap.L().Fatal("failed to create spool directory", zap.Error(err))
+	}
+
+	// Notifikasi email masuk antrean dan dikirim dispatcher di background
+	notificationRepo := persistence.NewPostgresNotificationRepository(db)
+	notifier := usecases.NewNotificationQueue(notificationRepo)
+	renderer, err := notification.NewTemplateRenderer(cfg.NotificationDefaultLocale)
+	if err != nil {
+		zap.L().Fatal("failed to load notification templates", zap.Error(err))
+	}
+	var transport services.MessageTransport
+	switch cfg.NotificationTransport {
+	case "smtp":
+		transport = notification.NewSMTPTransport(cfg.SMTPAddr, cfg.SMTPFrom, cfg.SMTPUsername, cfg.SMTPPassword)
+	case "file":
+		transport = spool
+	case "log":
+		transport = notification.NewLogTransport(zap.L())
+	default:
+		zap.L().Fatal("unknown notification transport", zap.String("transport", cfg.NotificationTransport))
+	}
+
+	guardianRepo := persistence.NewPostgresGuardianRepository(db)
+	orgRepo := persistence.NewPostgresOrganizationRepository(db, piiCipher)
+	clientInvitationRepo := persistence.NewPostgresClientInvitationRepository(db)
+	careRepo := persistence.NewPostgresCareRelationshipRepository(db)
+	consentRepo := persistence.NewPostgresConsentRepository(db)
+	dataExportRepo := persistence.NewPostgresDataExportRepository(db)
+	otpUC := usecases.NewOTPUseCase(persistence.NewRedisOTPRepository(redisClient), spool, notifier)
+
+	authUC := usecases.NewAuthUseCase(userRepo, tokenRepo, cfg.JWTSecret, zap.L(),
+		usecases.WithRoleRepository(roleRepo),
+		usecases.WithPasswordResetRepository(resetRepo),
+		usecases.WithCredentialRepository(credentialRepo),
+		usecases.WithRevocationPublisher(revocationPublisher),
+		usecases.WithOutbox(outbox),
+		usecases.WithAuditLogger(auditUC),
+		usecases.WithLoginRiskAssessor(riskAssessor),
+		usecases.WithOTP(otpUC),
+		usecases.WithNotifier(notifier),
+		usecases.WithGuardianRepository(guardianRepo),
+		usecases.WithOrganizationRepository(orgRepo),
+		usecases.WithConsentRepository(consentRepo),
+	)
+	mfaUC := usecases.NewMFAUseCase(authUC, otpUC)
+	roleUC := usecases.NewRoleUseCase(userRepo, roleRepo, tokenRepo, revocationPublisher,
+		usecases.WithRoleAuditLogger(auditUC),
+	)
+	adminUC := usecases.NewAdminUseCase(userRepo, tokenRepo, resetRepo, roleRepo, revocationPublisher,
+		usecases.WithAdminOutbox(outbox),
+		usecases.WithAdminAuditLogger(auditUC),
+		usecases.WithAdminNotifier(notifier, cfg.PasswordResetURL),
+	)
+	credentialUC := usecases.NewCredentialUseCase(userRepo, credentialRepo,
+		usecases.WithCredentialAuditLogger(auditUC),
+	)
+
+	// Identity provider eksternal (Google, Apple, ...)
+	var providers []services.IdentityProvider
+	for _, p := range cfg.OIDCProviders {
+		providers = append(providers, oidc.NewClient(oidc.Config{
+			Name:         p.Name,
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+		}))
+	}
+	socialUC := usecases.NewSocialAuthUseCase(authUC, identityRepo, providers...)
+
+	magicLinkUC := usecases.NewMagicLinkUseCase(authUC, persistence.NewRedisMagicLinkRepository(redisClient), notifier, cfg.MagicLinkURL)
+	guardianUC := usecases.NewGuardianUseCase(authUC, guardianRepo, notifier, cfg.GuardianConsentURL)
+	orgUC := usecases.NewOrganizationUseCase(authUC, orgRepo, notifier, cfg.OrgInvitationURL)
+	clientInvitationUC := usecases.NewClientInvitationUseCase(authUC, clientInvitationRepo, careRepo, notifier, cfg.ClientInvitationURL)
+	careUC := usecases.NewCareUseCase(authUC, careRepo)
+	consentUC := usecases.NewConsentUseCase(authUC, consentRepo)
+	keyring := usecases.NewUserKeyring(persistence.NewPostgresUserKeyRepository(db, piiCipher))
+	dataExportUC := usecases.NewDataExportUseCase(authUC, dataExportRepo, keyring, identityRepo, consentRepo, auditUC, exportSigner, zap.L())
+	accountDeletionUC := usecases.NewAccountDeletionUseCase(authUC, persistence.NewPostgresErasureRepository(db), auditRepo, keyring, zap.L())
+
+	// Relay outbox -> Redis Streams, berhenti saat proses selesai
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	relay := usecases.NewOutboxRelay(outboxRepo, messaging.NewRedisStreamBroker(redisClient, cfg.EventStream), zap.L())
+	go relay.Run(relayCtx, cfg.OutboxRelayInterval)
+	dispatcher := usecases.NewNotificationDispatcher(notificationRepo, renderer, transport, zap.L())
+	go dispatcher.Run(relayCtx, cfg.NotificationDispatchInterval)
+	go dataExportUC.Run(relayCtx, cfg.DataExportInterval)
+	go accountDeletionUC.Run(relayCtx, cfg.AccountErasureInterval)
+	go credentialUC.Run(relayCtx, cfg.CredentialExpiryInterval)
+	go usecases.NewPIIEncryptor(persistence.NewPostgresPIIRepository(db, piiCipher), zap.L()).Run(relayCtx, cfg.PIIEncryptionInterval)
+
+	apiKeyRepo := persistence.NewPostgresAPIKeyRepository(db)
+	serviceAccountUC := usecases.NewServiceAccountUseCase(userRepo, apiKeyRepo, cfg.JWTSecret, zap.L(),
+		usecases.WithServiceAccountAuditLogger(auditUC),
+	)
 
 	// Start gRPC server
 	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
@@ -56,11 +217,106 @@ func main() {
 
 	rateLimiter := middleware.NewRateLimiter(100)
 
+	// Method yang membutuhkan access token, selain ini publik
+	manageServiceAccounts := middleware.AccessRule{
+		Scopes:      []string{entities.ScopeManageServiceAccounts},
+		Permissions: []string{string(entities.PermServiceAccountsManage)},
+	}
+	manageRoles := middleware.AccessRule{Permissions: []string{string(entities.PermRolesManage)}}
+	readUsers := middleware.AccessRule{
+		Scopes:      []string{entities.ScopeReadUsers},
+		Permissions: []string{string(entities.PermUsersRead)},
+	}
+	manageUsers := middleware.AccessRule{Permissions: []string{string(entities.PermUsersManage)}}
+	reviewCredentials := middleware.AccessRule{Permissions: []string{string(entities.PermCredentialsReview)}}
+	readAudit := middleware.AccessRule{Permissions: []string{string(entities.PermAuditRead)}}
+	manageConsents := middleware.AccessRule{Permissions: []string{string(entities.PermConsentsManage)}}
+	authInterceptor := middleware.NewAuthInterceptor(authUC, map[string]middleware.AccessRule{
+		v1.ServiceAccountService_CreateServiceAccount_FullMethodName:     manageServiceAccounts,
+		v1.ServiceAccountService_CreateAPIKey_FullMethodName:             manageServiceAccounts,
+		v1.ServiceAccountService_ListAPIKeys_FullMethodName:              manageServiceAccounts,
+		v1.ServiceAccountService_RotateAPIKey_FullMethodName:             manageServiceAccounts,
+		v1.ServiceAccountService_RevokeAPIKey_FullMethodName:             manageServiceAccounts,
+		v1.AuthService_IntrospectToken_FullMethodName:                    {Scopes: []string{entities.ScopeIntrospectTokens}},
+		v1.AuthService_ChangePassword_FullMethodName:                     {},
+		v1.AuthService_Reauthenticate_FullMethodName:                     {},
+		v1.AuthService_SendStepUpCode_FullMethodName:                     {},
+		v1.MFAService_StartPhoneVerification_FullMethodName:              {},
+		v1.MFAService_ConfirmPhoneVerification_FullMethodName:            {},
+		v1.MFAService_SetMFAChannel_FullMethodName:                       {},
+		v1.GuardianService_AcceptGuardianship_FullMethodName:             {},
+		v1.GuardianService_ListWards_FullMethodName:                      {},
+		v1.GuardianService_GetWard_FullMethodName:                        {},
+		v1.GuardianService_SetWardStatus_FullMethodName:                  {},
+		v1.GuardianService_LogoutWard_FullMethodName:                     {},
+		v1.AuthService_SwitchOrganization_FullMethodName:                 {},
+		v1.OrganizationService_CreateOrganization_FullMethodName:         {},
+		v1.OrganizationService_ListMyOrganizations_FullMethodName:        {},
+		v1.OrganizationService_AcceptInvitation_FullMethodName:           {},
+		v1.OrganizationService_InviteMember_FullMethodName:               {},
+		v1.OrganizationService_ListMembers_FullMethodName:                {},
+		v1.OrganizationService_SetMemberRole_FullMethodName:              {},
+		v1.OrganizationService_RemoveMember_FullMethodName:               {},
+		v1.ClientInvitationService_CreateClientInvitation_FullMethodName: {},
+		v1.ClientInvitationService_ListClientInvitations_FullMethodName:  {},
+		v1.ClientInvitationService_RevokeClientInvitation_FullMethodName: {},
+		v1.CareService_RequestCare_FullMethodName:                        {},
+		v1.CareService_AcceptCare_FullMethodName:                         {},
+		v1.CareService_EndCare_FullMethodName:                            {},
+		v1.CareService_ListCareRelationships_FullMethodName:              {},
+		v1.CareService_CheckAccess_FullMethodName:                        {Scopes: []string{entities.ScopeCheckAccess}},
+		v1.ConsentService_ListConsents_FullMethodName:                    {},
+		v1.ConsentService_WithdrawConsent_FullMethodName:                 {},
+		v1.ConsentService_PublishConsentDocument_FullMethodName:          manageConsents,
+		v1.DataExportService_ExportMyData_FullMethodName:                 {},
+		v1.DataExportService_GetDataExport_FullMethodName:                {},
+		v1.DataExportService_DownloadDataExport_FullMethodName:           {},
+		v1.AccountService_DeleteAccount_FullMethodName:                   {},
+		v1.AccountService_CancelAccountDeletion_FullMethodName:           {},
+		v1.AdminService_ListRoles_FullMethodName:                         manageRoles,
+		v1.AdminService_SetRolePermissions_FullMethodName:                manageRoles,
+		v1.AdminService_ListUserRoles_FullMethodName:                     manageRoles,
+		v1.AdminService_AssignRole_FullMethodName:                        manageRoles,
+		v1.AdminService_RevokeRole_FullMethodName:                        manageRoles,
+		v1.AdminService_SetRole_FullMethodName:                           manageRoles,
+		v1.AdminService_SearchUsers_FullMethodName:                       readUsers,
+		v1.AdminService_GetUser_FullMethodName:                           readUsers,
+		v1.AdminService_SetUserStatus_FullMethodName:                     manageUsers,
+		v1.AdminService_DisableUser_FullMethodName:                       manageUsers,
+		v1.AdminService_EnableUser_FullMethodName:                        manageUsers,
+		v1.AdminService_ForceLogout_FullMethodName:                       manageUsers,
+		v1.AdminService_ForcePasswordReset_FullMethodName:                manageUsers,
+		v1.CredentialService_SubmitCredential_FullMethodName:             {},
+		v1.CredentialService_ListMyCredentials_FullMethodName:            {},
+		v1.CredentialService_ListCredentials_FullMethodName:              reviewCredentials,
+		v1.CredentialService_ApproveCredential_FullMethodName:            reviewCredentials,
+		v1.CredentialService_RejectCredential_FullMethodName:             reviewCredentials,
+		v1.AdminService_QueryAuditEvents_FullMethodName:                  readAudit,
+		v1.AdminService_ExportAuditEvents_FullMethodName:                 readAudit,
+		v1.AdminService_VerifyAuditLog_FullMethodName:                    readAudit,
+	})
+	requestInfo, err := middleware.NewRequestInfoInterceptor(cfg.TrustedProxies)
+	if err != nil {
+		zap.L().Fatal("invalid trusted proxy config", zap.Error(err))
+	}
+
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(rateLimiter.UnaryInterceptor()),
-		grpc.StreamInterceptor(rateLimiter.StreamInterceptor()),
+		// RequestInfo lebih dulu agar rate limiter memakai IP client yang sudah di-resolve
+		grpc.ChainUnaryInterceptor(requestInfo.UnaryInterceptor(), rateLimiter.UnaryInterceptor(), authInterceptor.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(requestInfo.StreamInterceptor(), rateLimiter.StreamInterceptor(), authInterceptor.StreamInterceptor()),
 	)
-	v1.RegisterAuthServiceServer(s, rpc.NewAuthHandler(authUC))
+	v1.RegisterAuthServiceServer(s, rpc.NewAuthHandler(authUC, socialUC, serviceAccountUC, magicLinkUC, guardianUC, clientInvitationUC))
+	v1.RegisterServiceAccountServiceServer(s, rpc.NewServiceAccountHandler(serviceAccountUC))
+	v1.RegisterAdminServiceServer(s, rpc.NewAdminHandler(roleUC, adminUC, auditUC))
+	v1.RegisterCredentialServiceServer(s, rpc.NewCredentialHandler(credentialUC))
+	v1.RegisterMFAServiceServer(s, rpc.NewMFAHandler(mfaUC))
+	v1.RegisterGuardianServiceServer(s, rpc.NewGuardianHandler(guardianUC))
+	v1.RegisterOrganizationServiceServer(s, rpc.NewOrganizationHandler(orgUC))
+	v1.RegisterClientInvitationServiceServer(s, rpc.NewClientInvitationHandler(clientInvitationUC))
+	v1.RegisterCareServiceServer(s, rpc.NewCareHandler(careUC))
+	v1.RegisterConsentServiceServer(s, rpc.NewConsentHandler(consentUC))
+	v1.RegisterDataExportServiceServer(s, rpc.NewDataExportHandler(dataExportUC))
+	v1.RegisterAccountServiceServer(s, rpc.NewAccountHandler(accountDeletionUC))
 
 	healthHandler := rpc.NewHealthHandler(db, *tokenRepo)
 	grpc_health_v1.RegisterHealthServer(s, healthHandler)
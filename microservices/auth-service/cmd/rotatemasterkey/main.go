@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"microservices/auth-service/config"
+	"microservices/auth-service/infrastructure/kms"
+)
+
+// Menambahkan master key baru ke file KMS lokal (AUTH_KMS_KEY_FILE) dan menjadikannya current.
+// auth-service membaca ulang file tersebut tanpa restart, lalu job enkripsi PII membungkus ulang
+// data key lama. Master key lama baru boleh dihapus dari file setelah re-wrap selesai.
+//
+// Dengan -export-signing, yang dirotasi adalah signing key arsip export
+// (AUTH_EXPORT_SIGNING_KEY_FILE). Key lama tetap dipublikasikan agar arsip lama masih bisa
+// diverifikasi.
+func main() {
+	exportSigning := flag.Bool("export-signing", false, "rotasi signing key arsip export, bukan master key")
+	flag.Parse()
+
+	cfg := config.Load()
+	if *exportSigning {
+		id, err := kms.RotateSigningKeyFile(cfg.ExportSigningKeyFile)
+		if err != nil {
+			log.Fatalf("failed to rotate export signing key: %v", err)
+		}
+		log.Printf("Export signing key %s is now current in %s", id, cfg.ExportSigningKeyFile)
+		return
+	}
+	id, err := kms.RotateKeyFile(cfg.KMSKeyFile)
+	if err != nil {
+		log.Fatalf("failed to rotate master key: %v", err)
+	}
+	log.Printf("Master key %s is now current in %s", id, cfg.KMSKeyFile)
+}
@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+const (
+	// Scope yang dibutuhkan untuk mengelola service account dan API key
+	ScopeManageServiceAccounts = string(PermServiceAccountsManage)
+	// Scope untuk service yang memvalidasi token lewat AuthService.IntrospectToken
+	ScopeIntrospectTokens = "tokens:introspect"
+	// Scope untuk service yang menanyakan hak akses data user lewat CareService.CheckAccess
+	ScopeCheckAccess = "access:check"
+	// Scope untuk consumer event (e.g. user.registered) yang mengambil data kontak user
+	// lewat AdminService.GetUser; event sendiri tidak membawa PII
+	ScopeReadUsers = string(PermUsersRead)
+)
+
+// APIKey adalah kredensial service account. Hanya hash yang disimpan,
+// plaintext key ditampilkan satu kali saat dibuat.
+type APIKey struct {
+	ID               string     `json:"id"`
+	ServiceAccountID string     `json:"service_account_id"`
+	Prefix           string     `json:"prefix"`
+	KeyHash          string     `json:"-"`
+	Scopes           []string   `json:"scopes"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+}
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// HasScopes memastikan semua scope yang diminta dimiliki key
+func (k *APIKey) HasScopes(scopes []string) bool {
+	allowed := make(map[string]bool, len(k.Scopes))
+	for _, s := range k.Scopes {
+		allowed[s] = true
+	}
+	for _, s := range scopes {
+		if !allowed[s] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,253 @@
+package entities
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditRegister              AuditAction = "user.register"
+	AuditLoginSucceeded        AuditAction = "auth.login_succeeded"
+	AuditLoginFailed           AuditAction = "auth.login_failed"
+	AuditTokenRefreshed        AuditAction = "auth.token_refreshed"
+	AuditLogout                AuditAction = "auth.logout"
+	AuditReauthenticated       AuditAction = "auth.reauthenticated"
+	AuditReauthenticateFailed  AuditAction = "auth.reauthenticate_failed"
+	AuditMagicLinkRequested    AuditAction = "auth.magic_link_requested"
+	AuditMFAChallengeSent      AuditAction = "auth.mfa_challenge_sent"
+	AuditMFAFailed             AuditAction = "auth.mfa_failed"
+	AuditPhoneVerified         AuditAction = "user.phone_verified"
+	AuditEmailVerified         AuditAction = "user.email_verified"
+	AuditMFAChannelChanged     AuditAction = "user.mfa_channel_changed"
+	AuditPasswordChanged       AuditAction = "user.password_changed"
+	AuditPasswordReset         AuditAction = "user.password_reset"
+	AuditRoleChanged           AuditAction = "role.changed"
+	AuditRoleAssigned          AuditAction = "role.assigned"
+	AuditRoleRevoked           AuditAction = "role.revoked"
+	AuditRolePermissionsSet    AuditAction = "role.permissions_set"
+	AuditUserStatusChanged     AuditAction = "admin.user_status_changed"
+	AuditForceLogout           AuditAction = "admin.force_logout"
+	AuditForcePasswordReset    AuditAction = "admin.force_password_reset"
+	AuditCredentialSubmitted   AuditAction = "credential.submitted"
+	AuditCredentialApproved    AuditAction = "credential.approved"
+	AuditCredentialRejected    AuditAction = "credential.rejected"
+	AuditServiceAccountCreated AuditAction = "service_account.created"
+	AuditAPIKeyCreated         AuditAction = "service_account.api_key_created"
+	AuditAPIKeyRotated         AuditAction = "service_account.api_key_rotated"
+	AuditAPIKeyRevoked         AuditAction = "service_account.api_key_revoked"
+	AuditGuardianLinked        AuditAction = "guardian.linked"
+	AuditWardStatusChanged     AuditAction = "guardian.ward_status_changed"
+	AuditWardLogout            AuditAction = "guardian.ward_logout"
+	AuditOrgCreated            AuditAction = "org.created"
+	AuditOrgMemberInvited      AuditAction = "org.member_invited"
+	AuditOrgMemberJoined       AuditAction = "org.member_joined"
+	AuditOrgMemberRoleChanged  AuditAction = "org.member_role_changed"
+	AuditOrgMemberRemoved      AuditAction = "org.member_removed"
+	AuditOrgSwitched           AuditAction = "auth.organization_switched"
+	AuditClientInvited         AuditAction = "client.invited"
+	AuditClientInviteRevoked   AuditAction = "client.invitation_revoked"
+	AuditClientInviteAccepted  AuditAction = "client.invitation_accepted"
+	AuditCareRequested         AuditAction = "care.requested"
+	AuditCareAccepted          AuditAction = "care.accepted"
+	AuditCareEnded             AuditAction = "care.ended"
+	AuditConsentRecorded       AuditAction = "consent.recorded"
+	AuditConsentWithdrawn      AuditAction = "consent.withdrawn"
+	AuditConsentPublished      AuditAction = "consent.document_published"
+	AuditDataExportRequested   AuditAction = "data_export.requested"
+	AuditDataExportDownloaded  AuditAction = "data_export.downloaded"
+	AuditDeletionRequested     AuditAction = "account.deletion_requested"
+	AuditDeletionCancelled     AuditAction = "account.deletion_cancelled"
+	AuditAccountErased         AuditAction = "account.erased"
+)
+
+// PseudonymPrefix : awalan pseudonym yang menggantikan user ID setelah akun dihapus
+const PseudonymPrefix = "erased:"
+
+// AuditEvent adalah catatan append-only. Setiap event menyimpan hash event sebelumnya
+// (PrevHash) sehingga perubahan atau penghapusan di tengah rantai bisa dideteksi.
+//
+// Field yang bisa dipseudonimkan (pelaku, subject, data request dan nilai metadata) di-hash
+// lewat commitment per field: HMAC(nonce acak, nama field || nilai). Pseudonimisasi mengganti
+// nilai dan menghapus nonce-nya, sehingga hash event tidak berubah dan nilai asli tidak bisa
+// ditebak dari commitment.
+type AuditEvent struct {
+	Seq        int64             `json:"seq"`
+	Action     AuditAction       `json:"action"`
+	ActorID    string            `json:"actor_id,omitempty"`   // pelaku, sama dengan subject untuk aksi user sendiri
+	SubjectID  string            `json:"subject_id,omitempty"` // user/role yang terdampak
+	IP         string            `json:"ip,omitempty"`
+	UserAgent  string            `json:"user_agent,omitempty"`
+	AppVersion string            `json:"app_version,omitempty"`
+	DeviceID   string            `json:"device_id,omitempty"`
+	RequestID  string            `json:"request_id,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	OccurredAt time.Time         `json:"occurred_at"`
+	PrevHash   string            `json:"prev_hash"`
+	Hash       string            `json:"hash"`
+	Redacted   bool              `json:"redacted,omitempty"` // dipseudonimkan setelah akun dihapus
+
+	// Commitments : nama field -> commitment, ikut di-hash. Nil untuk event sebelum migrasi
+	// 000022 yang hash-nya dihitung langsung dari nilai field.
+	Commitments map[string]string `json:"-"`
+	// Nonces : nama field -> nonce hex; field yang dipseudonimkan tidak punya nonce
+	Nonces map[string]string `json:"-"`
+}
+
+// AuditFilter : kriteria pencarian audit log. AfterSeq dipakai untuk export bertahap.
+type AuditFilter struct {
+	ActorID   string
+	SubjectID string
+	Action    AuditAction
+	From      *time.Time
+	To        *time.Time
+	AfterSeq  int64
+	Limit     int
+	Offset    int
+}
+
+// Commit mengisi Nonces dan Commitments untuk setiap field yang bisa dipseudonimkan,
+// dipanggil sekali sebelum ComputeHash saat event disimpan
+func (e *AuditEvent) Commit() error {
+	fields := e.redactableFields()
+	e.Nonces = make(map[string]string, len(fields))
+	e.Commitments = make(map[string]string, len(fields))
+	for name, value := range fields {
+		nonce := make([]byte, 16)
+		if _, err := rand.Read(nonce); err != nil {
+			return err
+		}
+		e.Nonces[name] = hex.EncodeToString(nonce)
+		e.Commitments[name] = commitField(nonce, name, value)
+	}
+	return nil
+}
+
+// redactableFields : nilai field yang bisa dipseudonimkan, metadata per key ("metadata.<key>")
+func (e *AuditEvent) redactableFields() map[string]string {
+	fields := map[string]string{
+		"actor_id":    e.ActorID,
+		"subject_id":  e.SubjectID,
+		"ip":          e.IP,
+		"user_agent":  e.UserAgent,
+		"app_version": e.AppVersion,
+		"device_id":   e.DeviceID,
+		"request_id":  e.RequestID,
+	}
+	for k, v := range e.Metadata {
+		fields["metadata."+k] = v
+	}
+	return fields
+}
+
+func commitField(nonce []byte, name, value string) string {
+	mac := hmac.New(sha256.New, nonce)
+	mac.Write([]byte(name))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyFields memeriksa nilai field terhadap commitment-nya. Field tanpa nonce hanya sah
+// pada event redacted dan nilainya harus kosong atau pseudonym.
+func (e *AuditEvent) verifyFields() bool {
+	if e.Commitments == nil {
+		return true
+	}
+	fields := e.redactableFields()
+	if len(fields) != len(e.Commitments) {
+		return false
+	}
+	for name, value := range fields {
+		commitment, ok := e.Commitments[name]
+		if !ok {
+			return false
+		}
+		nonceHex, ok := e.Nonces[name]
+		if !ok {
+			if !e.Redacted || (value != "" && !strings.HasPrefix(value, PseudonymPrefix)) {
+				return false
+			}
+			continue
+		}
+		nonce, err := hex.DecodeString(nonceHex)
+		if err != nil || !hmac.Equal([]byte(commitField(nonce, name, value)), []byte(commitment)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ComputeHash : sha256(PrevHash || JSON action, waktu dan Commitments). Seq tidak ikut karena
+// diberikan database, urutan sudah dijaga oleh PrevHash. OccurredAt dibulatkan ke presisi
+// Postgres (mikrodetik). Event lama tanpa Commitments memakai format sebelumnya.
+func (e *AuditEvent) ComputeHash() string {
+	occurredAt := e.OccurredAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+	var canonical []byte
+	if e.Commitments != nil {
+		canonical, _ = json.Marshal(struct {
+			Action      AuditAction       `json:"action"`
+			OccurredAt  string            `json:"occurred_at"`
+			Commitments map[string]string `json:"commitments"`
+		}{e.Action, occurredAt, e.Commitments})
+	} else {
+		canonical = e.legacyCanonical(occurredAt)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(e.PrevHash))
+	h.Write(canonical)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// legacyCanonical : JSON field event sebelum commitment. Field yang ditambahkan belakangan
+// memakai omitempty agar hash event lama tidak berubah.
+func (e *AuditEvent) legacyCanonical(occurredAt string) []byte {
+	metadata := e.Metadata
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	canonical, _ := json.Marshal(struct {
+		Action     AuditAction       `json:"action"`
+		ActorID    string            `json:"actor_id"`
+		SubjectID  string            `json:"subject_id"`
+		IP         string            `json:"ip"`
+		UserAgent  string            `json:"user_agent"`
+		RequestID  string            `json:"request_id"`
+		Metadata   map[string]string `json:"metadata"`
+		OccurredAt string            `json:"occurred_at"`
+		AppVersion string            `json:"app_version,omitempty"`
+		DeviceID   string            `json:"device_id,omitempty"`
+	}{
+		Action:     e.Action,
+		ActorID:    e.ActorID,
+		SubjectID:  e.SubjectID,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		RequestID:  e.RequestID,
+		Metadata:   metadata,
+		OccurredAt: occurredAt,
+		AppVersion: e.AppVersion,
+		DeviceID:   e.DeviceID,
+	})
+	return canonical
+}
+
+// VerifyAuditChain memeriksa events berurutan yang diawali prevHash.
+// Mengembalikan Seq event pertama yang rusak, atau 0 jika rantai utuh.
+// Hash setiap event dihitung ulang, termasuk event redacted.
+func VerifyAuditChain(prevHash string, events []*AuditEvent) int64 {
+	for _, e := range events {
+		if e.PrevHash != prevHash || e.ComputeHash() != e.Hash || !e.verifyFields() {
+			return e.Seq
+		}
+		prevHash = e.Hash
+	}
+	return 0
+}
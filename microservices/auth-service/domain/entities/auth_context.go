@@ -0,0 +1,54 @@
+package entities
+
+import "time"
+
+// AuthMethod : nilai claim amr (RFC 8176)
+type AuthMethod string
+
+const (
+	AuthMethodPassword  AuthMethod = "pwd"
+	AuthMethodFederated AuthMethod = "fed" // login lewat identity provider eksternal
+	AuthMethodOTP       AuthMethod = "otp"
+	AuthMethodSMS       AuthMethod = "sms"
+	AuthMethodMFA       AuthMethod = "mfa" // lebih dari satu faktor dipakai
+	// Magic link; tidak ada nilai baku di RFC 8176, mengikuti nilai yang umum dipakai IdP
+	AuthMethodEmailLink AuthMethod = "email"
+)
+
+// Assurance level untuk claim acr, mengikuti NIST SP 800-63B
+const (
+	ACRSingleFactor = "aal1"
+	ACRMultiFactor  = "aal2"
+)
+
+// AuthContext : kapan dan bagaimana user terakhir kali benar-benar membuktikan identitasnya.
+// Dibawa sepanjang sesi, refresh token tidak memperbarui AuthTime.
+type AuthContext struct {
+	AuthTime time.Time    `json:"auth_time"`
+	Methods  []AuthMethod `json:"amr,omitempty"`
+}
+
+func NewAuthContext(methods ...AuthMethod) AuthContext {
+	return AuthContext{AuthTime: time.Now().UTC(), Methods: methods}
+}
+
+func (a AuthContext) Has(method AuthMethod) bool {
+	for _, m := range a.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// ACR mengembalikan assurance level, kosong jika belum pernah autentikasi (sesi lama)
+func (a AuthContext) ACR() string {
+	switch {
+	case a.AuthTime.IsZero():
+		return ""
+	case a.Has(AuthMethodMFA):
+		return ACRMultiFactor
+	default:
+		return ACRSingleFactor
+	}
+}
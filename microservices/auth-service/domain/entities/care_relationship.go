@@ -0,0 +1,88 @@
+package entities
+
+import "time"
+
+// CareStatus : siklus hidup hubungan klien - psikolog
+type CareStatus string
+
+const (
+	CareRequested CareStatus = "requested" // diminta salah satu pihak, menunggu persetujuan pihak lain
+	CareActive    CareStatus = "active"
+	CareEnded     CareStatus = "ended"
+)
+
+// CareRelationship : psikolog yang sedang menangani klien. Service lain memakai hubungan
+// aktif untuk memutuskan apakah psikolog boleh membaca data klien (lihat CheckAccess).
+// Hubungan aktif hanya jika kedua pihak sudah menyetujui.
+type CareRelationship struct {
+	ID                      string
+	ClientID                string
+	PsychologistID          string
+	Status                  CareStatus
+	RequestedBy             string
+	CreatedAt               time.Time
+	ClientConsentedAt       *time.Time
+	PsychologistConsentedAt *time.Time
+	EndedAt                 *time.Time
+	EndedBy                 string
+	EndReason               string
+}
+
+// IsParty : userID adalah klien atau psikolog dalam hubungan ini
+func (r *CareRelationship) IsParty(userID string) bool {
+	return userID == r.ClientID || userID == r.PsychologistID
+}
+
+// Counterparty mengembalikan pihak lain dari userID
+func (r *CareRelationship) Counterparty(userID string) string {
+	if userID == r.ClientID {
+		return r.PsychologistID
+	}
+	return r.ClientID
+}
+
+// AccessAction : jenis akses ke data milik user lain yang diperiksa lewat CheckAccess
+type AccessAction string
+
+const (
+	AccessProfileRead   AccessAction = "profile:read"
+	AccessAccountManage AccessAction = "account:manage" // status akun dan sesi
+	AccessClinicalRead  AccessAction = "clinical:read"  // catatan sesi, asesmen, dsb.
+	AccessClinicalWrite AccessAction = "clinical:write"
+)
+
+func (a AccessAction) IsValid() bool {
+	switch a {
+	case AccessProfileRead, AccessAccountManage, AccessClinicalRead, AccessClinicalWrite:
+		return true
+	}
+	return false
+}
+
+// AccessGrant : dasar keputusan CheckAccess
+type AccessGrant string
+
+const (
+	GrantNone         AccessGrant = ""
+	GrantSelf         AccessGrant = "self"
+	GrantCare         AccessGrant = "care_relationship"
+	GrantGuardianship AccessGrant = "guardianship"
+)
+
+// AccessDecision : hasil CheckAccess. RelationshipID diisi untuk GrantCare.
+type AccessDecision struct {
+	Allowed        bool
+	Grant          AccessGrant
+	RelationshipID string
+}
+
+// CareAllows : psikolog yang menangani klien boleh membaca profil dan membaca/menulis data klinis,
+// tetapi tidak mengelola akun klien
+func CareAllows(action AccessAction) bool {
+	return action == AccessProfileRead || action == AccessClinicalRead || action == AccessClinicalWrite
+}
+
+// GuardianshipAllows : wali hanya mengelola akun anak, bukan konten klinis
+func GuardianshipAllows(action AccessAction) bool {
+	return action == AccessProfileRead || action == AccessAccountManage
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// ClientInvitation : undangan dari psikolog terverifikasi agar klien mendaftar lewat link.
+// Token undangan adalah JWT bertanda tangan; baris ini menjaga token hanya dipakai sekali
+// dan bisa dicabut sebelum dipakai.
+type ClientInvitation struct {
+	ID             string
+	PsychologistID string
+	Email          string
+	PreLinkCare    bool // permintaan care relationship dari psikolog dibuat saat klien mendaftar
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	AcceptedAt     *time.Time
+	ClientID       string // diisi saat undangan dipakai Register
+	RevokedAt      *time.Time
+}
+
+// IsUsableAt : belum dipakai, belum dicabut dan belum kedaluwarsa
+func (i *ClientInvitation) IsUsableAt(t time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && t.Before(i.ExpiresAt)
+}
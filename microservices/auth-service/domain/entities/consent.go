@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ConsentKind : jenis dokumen persetujuan
+type ConsentKind string
+
+const (
+	ConsentTerms                  ConsentKind = "terms"
+	ConsentPrivacyPolicy          ConsentKind = "privacy_policy"
+	ConsentClinicalDataProcessing ConsentKind = "clinical_data_processing"
+)
+
+func (k ConsentKind) IsValid() bool {
+	return k == ConsentTerms || k == ConsentPrivacyPolicy || k == ConsentClinicalDataProcessing
+}
+
+// ConsentDocument : satu versi dokumen persetujuan. Versi yang berlaku adalah versi
+// terbaru per kind; versi lama tetap disimpan sebagai bukti apa yang disetujui user.
+type ConsentDocument struct {
+	Kind        ConsentKind
+	Version     string
+	Title       string
+	URL         string // teks lengkap dokumen
+	Required    bool   // login diblokir sampai versi ini disetujui
+	Roles       []Role // kosong berarti berlaku untuk semua role
+	PublishedAt time.Time
+}
+
+// AppliesTo : dokumen berlaku untuk salah satu role user
+func (d *ConsentDocument) AppliesTo(user *User) bool {
+	if len(d.Roles) == 0 {
+		return true
+	}
+	for _, r := range d.Roles {
+		if user.HasRole(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConsentRecord : bukti user menyetujui satu versi dokumen. Record tidak pernah dihapus,
+// penarikan persetujuan hanya mengisi WithdrawnAt.
+type ConsentRecord struct {
+	ID          string      `json:"id"`
+	UserID      string      `json:"user_id"`
+	Kind        ConsentKind `json:"kind"`
+	Version     string      `json:"version"`
+	AcceptedAt  time.Time   `json:"accepted_at"`
+	IP          string      `json:"ip,omitempty"`
+	UserAgent   string      `json:"user_agent,omitempty"`
+	WithdrawnAt *time.Time  `json:"withdrawn_at,omitempty"`
+}
+
+// ConsentRequiredError dikembalikan login yang tertahan karena ada dokumen wajib versi baru
+// yang belum disetujui. Client menampilkan dokumen tersebut, memanggil RecordConsent dengan
+// Token lalu mengulang login.
+type ConsentRequiredError struct {
+	Token   string
+	Missing []*ConsentDocument
+}
+
+func (e *ConsentRequiredError) Error() string {
+	docs := make([]string, len(e.Missing))
+	for i, d := range e.Missing {
+		docs[i] = fmt.Sprintf("%s %s", d.Kind, d.Version)
+	}
+	return fmt.Sprintf("%v: %s", ErrConsentRequired, strings.Join(docs, ", "))
+}
+
+func (e *ConsentRequiredError) Is(target error) bool {
+	return target == ErrConsentRequired
+}
@@ -0,0 +1,80 @@
+package entities
+
+import "time"
+
+// DataExportStatus : status job export data user
+type DataExportStatus string
+
+const (
+	DataExportPending    DataExportStatus = "pending"
+	DataExportProcessing DataExportStatus = "processing"
+	DataExportCompleted  DataExportStatus = "completed"
+	DataExportFailed     DataExportStatus = "failed"
+	DataExportExpired    DataExportStatus = "expired" // arsip sudah dihapus setelah masa simpan
+)
+
+// DataExportRetention : lama arsip boleh diunduh sebelum dihapus
+const DataExportRetention = 7 * 24 * time.Hour
+
+// DataExport : job asinkron yang merangkum seluruh data milik user ke arsip ZIP bertanda tangan
+type DataExport struct {
+	ID          string
+	UserID      string
+	Status      DataExportStatus
+	RequestedAt time.Time
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+	Attempts    int
+	Error       string
+	Archive     []byte // hanya diisi FindExport dengan withArchive
+	SHA256      string // hex sha256 arsip, untuk memeriksa unduhan
+}
+
+// IsActive : job yang belum selesai, user tidak perlu membuat job baru
+func (e *DataExport) IsActive() bool {
+	return e.Status == DataExportPending || e.Status == DataExportProcessing
+}
+
+// DataExportDocument : isi data.json di dalam arsip. Tidak memuat secret (hash password,
+// kode OTP, hash API key); token sesi hanya diwakili ID-nya.
+type DataExportDocument struct {
+	FormatVersion int               `json:"format_version"`
+	GeneratedAt   time.Time         `json:"generated_at"`
+	ExportID      string            `json:"export_id"`
+	User          *User             `json:"user"`
+	Profile       DataExportProfile `json:"profile"`
+	MFA           DataExportMFA     `json:"mfa"`
+	Sessions      []*Session        `json:"sessions"`
+	Consents      []*ConsentRecord  `json:"consents"`
+	AuditEvents   []*AuditEvent     `json:"audit_events"`
+}
+
+// DataExportProfile : data profil di luar baris users
+type DataExportProfile struct {
+	Identities []*UserIdentity `json:"linked_identities"`
+}
+
+// DataExportMFA : metadata pendaftaran MFA tanpa secret
+type DataExportMFA struct {
+	Enabled         bool       `json:"enabled"`
+	Channel         OTPChannel `json:"channel,omitempty"`
+	Phone           string     `json:"phone,omitempty"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+}
+
+// DataExportSignature : isi data.json.sig, tanda tangan atas byte data.json
+type DataExportSignature struct {
+	Algorithm string `json:"algorithm"`
+	KeyID     string `json:"key_id"`
+	Signature []byte `json:"signature"` // base64 std di JSON
+}
+
+// SigningKey : public key penanda tangan arsip export yang dipublikasikan agar user bisa
+// memverifikasi data.json.sig tanpa bergantung pada auth-service
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	PublicKey []byte
+	CreatedAt time.Time
+}
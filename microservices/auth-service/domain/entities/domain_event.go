@@ -0,0 +1,102 @@
+package entities
+
+import "time"
+
+type DomainEventType string
+
+const (
+	EventUserRegistered       DomainEventType = "user.registered"
+	EventUserLoggedIn         DomainEventType = "user.logged_in"
+	EventPasswordChanged      DomainEventType = "user.password_changed"
+	EventUserSuspended        DomainEventType = "user.suspended"
+	EventLoginAlert           DomainEventType = "user.login_alert"
+	EventGuardianLinked       DomainEventType = "user.guardian_linked"
+	EventOrgMemberAdded       DomainEventType = "organization.member_added"
+	EventOrgMemberRoleChanged DomainEventType = "organization.member_role_changed"
+	EventOrgMemberRemoved     DomainEventType = "organization.member_removed"
+	EventCareLinked           DomainEventType = "care.linked"
+	EventCareEnded            DomainEventType = "care.ended"
+	EventUserErased           DomainEventType = "user.erased"
+)
+
+// DomainEvent ditulis ke tabel outbox dalam transaksi yang sama dengan perubahan data,
+// lalu dikirim ke broker oleh relay. Event bisa terkirim lebih dari sekali, consumer
+// melakukan deduplikasi berdasarkan ID.
+type DomainEvent struct {
+	ID            string
+	Type          DomainEventType
+	AggregateID   string // ID user
+	Payload       []byte // JSON, lihat *Payload di bawah
+	OccurredAt    time.Time
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	PublishedAt   *time.Time
+}
+
+// UserRegisteredPayload tidak membawa email atau PII lain karena stream bisa dibaca semua
+// consumer; consumer yang membutuhkannya memanggil AdminService.GetUser dengan scope users:read.
+type UserRegisteredPayload struct {
+	UserID   string `json:"user_id"`
+	Role     Role   `json:"role"`
+	Provider string `json:"provider,omitempty"` // kosong untuk registrasi email/password
+}
+
+type UserLoggedInPayload struct {
+	UserID string `json:"user_id"`
+	Method string `json:"method"` // "password" atau nama identity provider
+}
+
+type PasswordChangedPayload struct {
+	UserID string `json:"user_id"`
+	Reset  bool   `json:"reset"` // true jika lewat ResetPassword
+}
+
+type UserSuspendedPayload struct {
+	UserID string `json:"user_id"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// LoginAlertPayload dikonsumsi layanan notifikasi untuk memberi tahu user
+// tentang login dari device atau lokasi baru
+type LoginAlertPayload struct {
+	UserID    string       `json:"user_id"`
+	Reasons   []RiskReason `json:"reasons"`
+	RiskScore int          `json:"risk_score"`
+	IP        string       `json:"ip,omitempty"`
+	Country   string       `json:"country,omitempty"`
+	City      string       `json:"city,omitempty"`
+	UserAgent string       `json:"user_agent,omitempty"`
+	Blocked   bool         `json:"blocked"` // true jika login ditahan menunggu step-up
+}
+
+// GuardianLinkedPayload : wali menyetujui dan sejak itu mengelola akun klien di bawah umur
+type GuardianLinkedPayload struct {
+	MinorID      string `json:"minor_id"`
+	GuardianID   string `json:"guardian_id"`
+	Relationship string `json:"relationship"`
+}
+
+// OrgMembershipPayload : service lain memakai event ini untuk menyinkronkan akses data per tenant.
+// Saat role member berubah dikirim organization.member_role_changed dengan role baru.
+type OrgMembershipPayload struct {
+	OrgID  string  `json:"org_id"`
+	UserID string  `json:"user_id"`
+	Role   OrgRole `json:"role,omitempty"`
+}
+
+// CareLinkedPayload : psikolog mulai menangani (care.linked) atau berhenti menangani (care.ended) klien.
+// Service yang menyimpan cache CheckAccess sebaiknya membuang entry pasangan ini.
+type CareLinkedPayload struct {
+	RelationshipID string `json:"relationship_id"`
+	ClientID       string `json:"client_id"`
+	PsychologistID string `json:"psychologist_id"`
+}
+
+// UserErasedPayload : data pribadi user sudah dihapus dari auth-service. Service lain wajib
+// menghapus atau menganonimkan data miliknya; Pseudonym menggantikan user ID di audit log.
+type UserErasedPayload struct {
+	UserID    string    `json:"user_id"`
+	Pseudonym string    `json:"pseudonym"`
+	ErasedAt  time.Time `json:"erased_at"`
+}
@@ -3,11 +3,70 @@ package entities
 import "errors"
 
 var (
-	ErrEmailExists        = errors.New("email already exists")
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUnauthorized       = errors.New("unauthorized access")
-	ErrInternal           = errors.New("internal server error")
-	ErrInvalidToken       = errors.New("invalid token")
-	ErrTokenRevoked       = errors.New("token has been revoked")
+	ErrEmailExists                = errors.New("email already exists")
+	ErrInvalidCredentials         = errors.New("invalid credentials")
+	ErrUserNotFound               = errors.New("user not found")
+	ErrUnauthorized               = errors.New("unauthorized access")
+	ErrInternal                   = errors.New("internal server error")
+	ErrInvalidToken               = errors.New("invalid token")
+	ErrTokenRevoked               = errors.New("token has been revoked")
+	ErrUnknownProvider            = errors.New("unknown identity provider")
+	ErrEmailNotVerified           = errors.New("identity provider email is not verified")
+	ErrInvalidClient              = errors.New("invalid client credentials")
+	ErrInvalidScope               = errors.New("requested scope is not allowed")
+	ErrAPIKeyNotFound             = errors.New("api key not found")
+	ErrInvalidRole                = errors.New("invalid role")
+	ErrRoleNotFound               = errors.New("role not found")
+	ErrPrimaryRole                = errors.New("cannot revoke the user's primary role")
+	ErrAccountPendingVerification = errors.New("account is pending verification")
+	ErrGuardianConsentRequired    = errors.New("account is waiting for guardian consent")
+	ErrGuardianRequired           = errors.New("a guardian email is required for users under 18")
+	ErrInvalidDateOfBirth         = errors.New("invalid date of birth")
+	ErrGuardianshipNotFound       = errors.New("guardianship not found")
+	ErrGuardianEmailMismatch      = errors.New("consent must be given from the invited guardian's account")
+	ErrGuardianNotAdult           = errors.New("guardian must be an adult")
+	ErrAccountSuspended           = errors.New("account is suspended")
+	ErrAccountDeactivated         = errors.New("account is deactivated")
+	ErrInvalidStatusTransition    = errors.New("invalid account status transition")
+	ErrEmailPrefixTooShort        = errors.New("email prefix must be at least 3 characters")
+	ErrPasswordResetRequired      = errors.New("password reset required")
+	ErrNotPsychologist            = errors.New("user is not a psychologist")
+	ErrCredentialNotFound         = errors.New("credential not found")
+	ErrCredentialPending          = errors.New("a credential is already pending review")
+	ErrCredentialNotPending       = errors.New("credential is not pending review")
+	ErrCredentialSelfReview       = errors.New("reviewer cannot review their own credential")
+	ErrInvalidCredential          = errors.New("license number, jurisdiction and documents are required")
+	ErrOrganizationNotFound       = errors.New("organization not found")
+	ErrInvalidOrganizationName    = errors.New("organization name is required")
+	ErrNotOrgMember               = errors.New("user is not a member of this organization")
+	ErrAlreadyOrgMember           = errors.New("user is already a member of this organization")
+	ErrInvalidOrgRole             = errors.New("invalid organization role")
+	ErrTenantRequired             = errors.New("an active organization is required")
+	ErrLastOrgOwner               = errors.New("organization must keep at least one owner")
+	ErrInvitationEmailMismatch    = errors.New("invitation was sent to a different email")
+	ErrInvitationNotFound         = errors.New("invitation not found")
+	ErrInvalidInvitationExpiry    = errors.New("invitation expiry must be between 1 hour and 30 days")
+	ErrCareRelationshipNotFound   = errors.New("care relationship not found")
+	ErrCareRelationshipExists     = errors.New("a care relationship between these users is already open")
+	ErrInvalidCareParty           = errors.New("care relationships are between a client and a verified psychologist")
+	ErrInvalidAccessAction        = errors.New("invalid access action")
+	ErrConsentRequired            = errors.New("consent to the current version of required documents is needed")
+	ErrConsentDocumentNotFound    = errors.New("consent document not found")
+	ErrConsentVersionOutdated     = errors.New("a newer version of this document exists")
+	ErrConsentVersionExists       = errors.New("this document version is already published")
+	ErrConsentNotFound            = errors.New("no active consent for this document")
+	ErrInvalidConsentDocument     = errors.New("document kind, version and title are required")
+	ErrDataExportNotFound         = errors.New("data export not found")
+	ErrDataExportNotReady         = errors.New("data export is not ready for download")
+	ErrDataExportInProgress       = errors.New("a data export is already in progress")
+	ErrDeletionNotScheduled       = errors.New("account deletion is not scheduled")
+	ErrUserKeyDestroyed           = errors.New("user encryption key has been destroyed")
+	ErrStepUpRequired             = errors.New("step-up authentication required")
+	ErrInvalidOTP                 = errors.New("invalid or expired verification code")
+	ErrOTPAttemptsExceeded        = errors.New("too many verification attempts")
+	ErrInvalidPhone               = errors.New("phone number must be in E.164 format")
+	ErrPhoneNotVerified           = errors.New("phone number is not verified")
+	ErrInvalidOTPChannel          = errors.New("invalid verification channel")
+	ErrOTPRateLimited             = errors.New("too many verification codes requested, try again later")
+	ErrMagicLinkRateLimited       = errors.New("too many sign-in link requests for this email, try again later")
 )
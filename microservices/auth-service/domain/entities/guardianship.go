@@ -0,0 +1,46 @@
+package entities
+
+import "time"
+
+// GuardianshipStatus : siklus hidup hubungan wali - klien di bawah umur
+type GuardianshipStatus string
+
+const (
+	GuardianshipPending GuardianshipStatus = "pending" // undangan terkirim, wali belum menyetujui
+	GuardianshipActive  GuardianshipStatus = "active"
+	GuardianshipRevoked GuardianshipStatus = "revoked"
+)
+
+// GuardianRelationship : hubungan wali dengan klien
+type GuardianRelationship string
+
+const (
+	RelationshipParent        GuardianRelationship = "parent"
+	RelationshipLegalGuardian GuardianRelationship = "legal_guardian"
+)
+
+func (r GuardianRelationship) IsValid() bool {
+	return r == RelationshipParent || r == RelationshipLegalGuardian
+}
+
+// Guardianship menghubungkan akun wali dengan akun klien di bawah umur. Wali boleh melihat
+// profil dan mengelola akun (status, sesi), tetapi tidak membaca konten klinis.
+type Guardianship struct {
+	ID            string
+	MinorID       string
+	GuardianID    string // kosong selama undangan belum disetujui
+	GuardianEmail string // alamat tujuan undangan persetujuan
+	Relationship  GuardianRelationship
+	Status        GuardianshipStatus
+
+	ConsentTokenHash string
+	ConsentExpiresAt time.Time
+	CreatedAt        time.Time
+	ConsentedAt      *time.Time
+}
+
+// Ward : akun di bawah perwalian beserta hubungannya, untuk ditampilkan ke wali
+type Ward struct {
+	User         *User
+	Guardianship *Guardianship
+}
@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// UserIdentity : identitas eksternal (Google, Apple, ...) yang terhubung ke User
+type UserIdentity struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExternalIdentity adalah hasil verifikasi id_token dari identity provider
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strings"
+	"time"
+)
+
+// GeoLocation : hasil lookup GeoIP, akurasinya hanya sebatas kota
+type GeoLocation struct {
+	Country   string  `json:"country,omitempty"` // kode ISO 3166-1 alpha-2
+	City      string  `json:"city,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm menghitung jarak great-circle (haversine) ke lokasi lain
+func (g *GeoLocation) DistanceKm(other *GeoLocation) float64 {
+	lat1 := g.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - g.Longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// KnownDevice : device yang pernah dipakai login oleh user
+type KnownDevice struct {
+	UserID      string
+	Fingerprint string
+	UserAgent   string
+	AppVersion  string
+	LastIP      string
+	LastCountry string
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+// LoginRecord : riwayat login yang dipakai sebagai pembanding login berikutnya
+type LoginRecord struct {
+	UserID      string
+	Fingerprint string
+	IP          string
+	Location    *GeoLocation // nil jika IP tidak ditemukan di database GeoIP
+	RiskScore   int
+	RiskReasons []RiskReason
+	OccurredAt  time.Time
+}
+
+type RiskReason string
+
+const (
+	RiskNewDevice        RiskReason = "new_device"
+	RiskNewCountry       RiskReason = "new_country"
+	RiskImpossibleTravel RiskReason = "impossible_travel"
+)
+
+// LoginRisk : hasil penilaian satu percobaan login, Score 0-100
+type LoginRisk struct {
+	Score       int
+	Reasons     []RiskReason
+	Fingerprint string
+	Location    *GeoLocation
+	FirstLogin  bool // belum ada riwayat login, tidak ada pembanding
+}
+
+func (r *LoginRisk) Has(reason RiskReason) bool {
+	for _, rr := range r.Reasons {
+		if rr == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceFingerprint mengidentifikasi device dari x-device-id, atau user agent jika aplikasi
+// tidak mengirimkannya. Versi app sengaja tidak ikut agar update app tidak dianggap device baru.
+func DeviceFingerprint(info RequestInfo) string {
+	source := "ua:" + strings.ToLower(strings.TrimSpace(info.UserAgent))
+	if info.DeviceID != "" {
+		source = "device:" + info.DeviceID
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
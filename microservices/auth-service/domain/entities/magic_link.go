@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// MagicLink : token login sekali pakai yang dikirim lewat email. Hanya hash token yang disimpan,
+// dan token hanya bisa ditukar dari device yang memintanya.
+type MagicLink struct {
+	TokenHash         string    `json:"-"`
+	UserID            string    `json:"user_id"`
+	DeviceFingerprint string    `json:"device_fingerprint"`
+	CreatedAt         time.Time `json:"created_at"`
+}
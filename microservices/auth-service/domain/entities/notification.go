@@ -0,0 +1,54 @@
+package entities
+
+import "time"
+
+// NotificationKind menentukan template pesan yang dikirim ke user
+type NotificationKind string
+
+const (
+	NotificationMagicLink        NotificationKind = "magic_link"
+	NotificationPasswordReset    NotificationKind = "password_reset"
+	NotificationLoginAlert       NotificationKind = "login_alert"
+	NotificationVerificationCode NotificationKind = "verification_code"
+	NotificationGuardianConsent  NotificationKind = "guardian_consent"
+	NotificationOrgInvitation    NotificationKind = "org_invitation"
+	NotificationClientInvitation NotificationKind = "client_invitation"
+	NotificationAccountDeletion  NotificationKind = "account_deletion"
+)
+
+// NotificationStatus : posisi notifikasi di antrean kirim
+type NotificationStatus string
+
+const (
+	NotificationPending NotificationStatus = "pending"
+	NotificationSent    NotificationStatus = "sent"
+	NotificationDead    NotificationStatus = "dead" // gagal permanen / melewati batas percobaan
+)
+
+// DefaultLocale dipakai jika bahasa user tidak diketahui atau tidak punya template
+const DefaultLocale = "id"
+
+// Notification : pesan keluar ke satu user. Data berisi variabel template,
+// bisa memuat secret (e.g. token magic link) sehingga tidak boleh di-log mentah
+// dan dihapus dari antrean setelah terkirim.
+type Notification struct {
+	ID            string
+	Kind          NotificationKind
+	UserID        string
+	Recipient     string // alamat email tujuan
+	Locale        string
+	Data          map[string]string
+	Status        NotificationStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+// Message : notifikasi yang sudah dirender, siap dikirim transport
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"context"
+	"time"
+)
+
+// Organization : klinik atau praktik bersama tempat psikolog bekerja (tenant)
+type Organization struct {
+	ID        string
+	Name      string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// OrgRole : role user di dalam satu organisasi, terpisah dari role global
+type OrgRole string
+
+const (
+	OrgRoleOwner        OrgRole = "owner"
+	OrgRoleManager      OrgRole = "manager"
+	OrgRolePsychologist OrgRole = "psychologist"
+	OrgRoleStaff        OrgRole = "staff"
+)
+
+func (r OrgRole) IsValid() bool {
+	switch r {
+	case OrgRoleOwner, OrgRoleManager, OrgRolePsychologist, OrgRoleStaff:
+		return true
+	}
+	return false
+}
+
+// CanManageMembers : owner dan manager boleh mengundang, mengubah role dan mengeluarkan member
+func (r OrgRole) CanManageMembers() bool {
+	return r == OrgRoleOwner || r == OrgRoleManager
+}
+
+// Membership : keanggotaan user di organisasi. OrgName/Email hanya diisi saat listing.
+type Membership struct {
+	OrgID     string
+	OrgName   string
+	UserID    string
+	Email     string
+	Role      OrgRole
+	CreatedAt time.Time
+}
+
+// OrgInvitation : undangan bergabung ke organisasi, dikirim lewat email
+type OrgInvitation struct {
+	ID         string
+	OrgID      string
+	Email      string
+	Role       OrgRole
+	TokenHash  string
+	InvitedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+}
+
+type tenantKey struct{}
+
+// ContextWithTenant menandai organisasi aktif. Repository yang tenant-scoped
+// hanya membaca/menulis data organisasi ini.
+func ContextWithTenant(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, orgID)
+}
+
+// TenantFromContext mengembalikan false jika request tidak berjalan dalam konteks organisasi
+func TenantFromContext(ctx context.Context) (string, bool) {
+	orgID, ok := ctx.Value(tenantKey{}).(string)
+	return orgID, ok && orgID != ""
+}
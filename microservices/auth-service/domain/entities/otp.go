@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// OTPChannel : jalur pengiriman kode sekali pakai
+type OTPChannel string
+
+const (
+	OTPChannelSMS   OTPChannel = "sms"
+	OTPChannelEmail OTPChannel = "email"
+)
+
+// AuthMethod mengembalikan nilai amr untuk kode yang dikirim lewat channel ini
+func (c OTPChannel) AuthMethod() AuthMethod {
+	if c == OTPChannelSMS {
+		return AuthMethodSMS
+	}
+	return AuthMethodOTP
+}
+
+func (c OTPChannel) IsValid() bool {
+	return c == OTPChannelSMS || c == OTPChannelEmail
+}
+
+// OTPPurpose membatasi kode hanya bisa dipakai untuk alur yang memintanya
+type OTPPurpose string
+
+const (
+	OTPPurposeLogin             OTPPurpose = "login"
+	OTPPurposeStepUp            OTPPurpose = "step_up"
+	OTPPurposePhoneVerification OTPPurpose = "phone_verification"
+	OTPPurposeEmailVerification OTPPurpose = "email_verification"
+)
+
+// OTPChallenge : satu kode yang sudah dikirim dan menunggu diverifikasi.
+// Hanya hash kode yang disimpan.
+type OTPChallenge struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Purpose     OTPPurpose `json:"purpose"`
+	Channel     OTPChannel `json:"channel"`
+	Destination string     `json:"destination"` // nomor telepon atau email tujuan
+	CodeHash    string     `json:"code_hash"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+
+	// Untuk OTPPurposeLogin: faktor yang sudah lolos sebelum kode diminta dan metode login untuk audit
+	Methods     []AuthMethod `json:"methods,omitempty"`
+	LoginMethod string       `json:"login_method,omitempty"`
+}
+
+// MFARequiredError dikembalikan login yang belum selesai karena perlu faktor kedua
+// (MFA aktif atau login berisiko tinggi). Client melanjutkan dengan VerifyMFA.
+type MFARequiredError struct {
+	ChallengeID string
+	Channel     OTPChannel
+}
+
+func (e *MFARequiredError) Error() string {
+	return fmt.Sprintf("second factor required via %s", e.Channel)
+}
+
+// EmailVerificationRequiredError dikembalikan login akun pending_verification yang password-nya
+// valid. Kode baru sudah dikirim ke email user; client melanjutkan dengan VerifyEmail.
+type EmailVerificationRequiredError struct {
+	ChallengeID string
+}
+
+func (e *EmailVerificationRequiredError) Error() string {
+	return "email verification required"
+}
+
+func (e *EmailVerificationRequiredError) Unwrap() error {
+	return ErrAccountPendingVerification
+}
+
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// IsValidPhone memeriksa format E.164, e.g. +6281234567890
+func IsValidPhone(phone string) bool {
+	return phonePattern.MatchString(phone)
+}
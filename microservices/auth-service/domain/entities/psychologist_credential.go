@@ -0,0 +1,54 @@
+package entities
+
+import "time"
+
+// CredentialStatus : status verifikasi lisensi psikolog
+type CredentialStatus string
+
+const (
+	CredentialPending  CredentialStatus = "pending"
+	CredentialVerified CredentialStatus = "verified"
+	CredentialRejected CredentialStatus = "rejected"
+	CredentialExpired  CredentialStatus = "expired"
+)
+
+// CredentialPendingTTL : pengajuan yang tidak direview selama ini kedaluwarsa (status expired)
+// dan harus diajukan ulang, agar dokumen lama tidak disetujui berbulan-bulan kemudian
+const CredentialPendingTTL = 30 * 24 * time.Hour
+
+// PendingPsychologistRole hanya dipakai sebagai claim role untuk psikolog yang belum terverifikasi,
+// tidak pernah disimpan di tabel roles
+const PendingPsychologistRole Role = "psychologist_pending"
+
+// PsychologistCredential : pengajuan lisensi praktik (STR/SIPP) yang direview admin.
+// Perpanjangan lisensi dilakukan dengan mengajukan credential baru.
+//
+// Tabel credential adalah satu-satunya sumber status verifikasi: users.status psikolog tetap
+// active, dan tanpa credential yang valid role claim-nya hanya diturunkan menjadi
+// psychologist_pending saat token diterbitkan.
+type PsychologistCredential struct {
+	ID            string           `json:"id"`
+	UserID        string           `json:"user_id"`
+	LicenseNumber string           `json:"license_number"`
+	Jurisdiction  string           `json:"jurisdiction"`
+	DocumentRefs  []string         `json:"document_refs"` // referensi file di storage, bukan isi dokumen
+	Status        CredentialStatus `json:"status"`
+	ReviewedBy    string           `json:"reviewed_by,omitempty"`
+	ReviewNote    string           `json:"review_note,omitempty"`
+	SubmittedAt   time.Time        `json:"submitted_at"`
+	ReviewedAt    *time.Time       `json:"reviewed_at,omitempty"`
+	ExpiresAt     *time.Time       `json:"expires_at,omitempty"` // masa berlaku lisensi
+}
+
+// IsPending : pengajuan masih menunggu review dan belum melewati CredentialPendingTTL
+func (c *PsychologistCredential) IsPending(now time.Time) bool {
+	return c.Status == CredentialPending && now.Before(c.SubmittedAt.Add(CredentialPendingTTL))
+}
+
+// IsValid : credential terverifikasi dan lisensinya belum habis
+func (c *PsychologistCredential) IsValid(now time.Time) bool {
+	if c.Status != CredentialVerified {
+		return false
+	}
+	return c.ExpiresAt == nil || now.Before(*c.ExpiresAt)
+}
@@ -0,0 +1,21 @@
+package entities
+
+// Permission adalah hak akses granular yang dibawa di access token (claim "perms")
+type Permission string
+
+const (
+	PermUsersRead             Permission = "users:read"
+	PermUsersManage           Permission = "users:manage"
+	PermRolesManage           Permission = "roles:manage"
+	PermServiceAccountsManage Permission = "service_accounts:manage"
+	PermCredentialsReview     Permission = "credentials:review"
+	PermAuditRead             Permission = "audit:read"
+	PermConsentsManage        Permission = "consents:manage"
+)
+
+// RoleDefinition : role beserta permission yang dimilikinya
+type RoleDefinition struct {
+	Name        Role         `json:"name"`
+	Description string       `json:"description"`
+	Permissions []Permission `json:"permissions"`
+}
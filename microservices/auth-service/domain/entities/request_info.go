@@ -0,0 +1,26 @@
+package entities
+
+import "context"
+
+// RequestInfo : metadata client dan request gRPC untuk sesi, audit log dan rate limiter, diisi oleh middleware
+type RequestInfo struct {
+	IP         string
+	UserAgent  string
+	AppVersion string // header x-app-version dari aplikasi mobile/web
+	DeviceID   string // header x-device-id, dibuat aplikasi saat install; tidak dapat dipercaya penuh
+	Locale     string // bahasa utama dari accept-language, e.g. "id" atau "en"
+	RequestID  string
+	ActorID    string // user/service account pemilik access token, kosong untuk method publik
+}
+
+type requestInfoKey struct{}
+
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext mengembalikan nilai kosong jika tidak ada (e.g. dipanggil dari CLI)
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+// RevocationEventType : jenis event revocation untuk service yang memvalidasi JWT secara lokal
+type RevocationEventType string
+
+const (
+	RevocationUserLogout      RevocationEventType = "user.logout"     // satu access token (jti) dicabut
+	RevocationSessionsRevoked RevocationEventType = "session.revoked" // semua sesi user dicabut
+	RevocationUserSuspended   RevocationEventType = "user.suspended"  // akun tidak lagi active
+	RevocationRoleChanged     RevocationEventType = "role.changed"    // claim role/permission token lama sudah basi
+)
+
+// RevocationEvent dikirim ke service lain. Token dengan jti = TokenID, atau token milik
+// UserID yang diterbitkan sebelum IssuedBefore, harus ditolak.
+type RevocationEvent struct {
+	ID           string              `json:"id"`
+	Type         RevocationEventType `json:"type"`
+	UserID       string              `json:"user_id"`
+	TokenID      string              `json:"token_id,omitempty"`
+	ExpiresAt    *time.Time          `json:"expires_at,omitempty"` // sampai kapan TokenID perlu diingat
+	IssuedBefore *time.Time          `json:"issued_before,omitempty"`
+	OccurredAt   time.Time           `json:"occurred_at"`
+}
+
+// RevocationWatermark : batas iat untuk revocation per user. Claim iat berpresisi detik,
+// jadi dibulatkan ke atas agar token yang terbit di detik yang sama ikut tercabut.
+func RevocationWatermark(now time.Time) time.Time {
+	return now.Truncate(time.Second).Add(time.Second)
+}
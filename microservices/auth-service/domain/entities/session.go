@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+// Session : metadata client untuk satu refresh token, dibuat ulang setiap rotasi token
+type Session struct {
+	TokenID    string    `json:"token_id"`
+	UserID     string    `json:"user_id"`
+	IP         string    `json:"ip,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	AppVersion string    `json:"app_version,omitempty"`
+	DeviceID   string    `json:"device_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Auth context login awal, diteruskan ke access token hasil refresh
+	Auth AuthContext `json:"auth"`
+	// Organisasi aktif (SwitchOrganization), kosong untuk konteks pribadi
+	OrgID string `json:"org_id,omitempty"`
+}
+
+// NewSession membuat record sesi dari metadata request di context
+func NewSession(tokenID, userID string, info RequestInfo, authCtx AuthContext) *Session {
+	return &Session{
+		TokenID:    tokenID,
+		UserID:     userID,
+		IP:         info.IP,
+		UserAgent:  info.UserAgent,
+		AppVersion: info.AppVersion,
+		DeviceID:   info.DeviceID,
+		CreatedAt:  time.Now().UTC(),
+		Auth:       authCtx,
+	}
+}
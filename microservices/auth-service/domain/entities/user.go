@@ -5,18 +5,136 @@ import "time"
 type Role string
 
 const (
-	ClientRole       Role = "client"
-	PsychologistRole Role = "psychologist"
+	ClientRole        Role = "client"
+	PsychologistRole  Role = "psychologist"
+	ServiceRole       Role = "service" // akun mesin untuk komunikasi antar service
+	AdminRole         Role = "admin"
+	ClinicManagerRole Role = "clinic_manager"
+	SupportRole       Role = "support"
 )
 
+// IsSelfAssignable : role yang boleh dipilih sendiri saat Register,
+// role lain hanya bisa diberikan admin
+func (r Role) IsSelfAssignable() bool {
+	return r == ClientRole || r == PsychologistRole
+}
+
 type User struct {
-	ID           string    `json:"id"`
-	Email        string    `json:"email" validate:"email,required"`
-	PasswordHash string    `json:"-"`
-	Role         Role      `json:"role" validate:"required"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID                    string     `json:"id"`
+	Email                 string     `json:"email" validate:"email,required"`
+	PasswordHash          string     `json:"-"`
+	Role                  Role       `json:"role" validate:"required"` // role utama
+	Roles                 []Role     `json:"roles,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+	Status                UserStatus `json:"status"`
+	StatusReason          string     `json:"status_reason,omitempty"`
+	StatusChangedAt       *time.Time `json:"status_changed_at,omitempty"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	Phone                 string     `json:"phone,omitempty"` // E.164, hanya terisi setelah diverifikasi
+	PhoneVerifiedAt       *time.Time `json:"phone_verified_at,omitempty"`
+	MFAChannel            OTPChannel `json:"mfa_channel,omitempty"` // kosong berarti MFA tidak aktif
+	DateOfBirth           *time.Time `json:"date_of_birth,omitempty"`
+	EmailVerifiedAt       *time.Time `json:"email_verified_at,omitempty"`
+	DeletionScheduledAt   *time.Time `json:"deletion_scheduled_at,omitempty"` // akun dihapus permanen setelah waktu ini
+}
+
+// AccountDeletionGracePeriod : jeda antara permintaan hapus akun dan penghapusan permanen,
+// selama itu user masih bisa membatalkan
+const AccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// AgeOfMajority : di bawah umur ini akun klien wajib dikelola wali
+const AgeOfMajority = 18
+
+// AgeAt menghitung umur dalam tahun penuh, -1 jika tanggal lahir tidak diketahui
+func (u *User) AgeAt(t time.Time) int {
+	if u.DateOfBirth == nil {
+		return -1
+	}
+	dob := u.DateOfBirth.UTC()
+	t = t.UTC()
+	age := t.Year() - dob.Year()
+	if t.Month() < dob.Month() || (t.Month() == dob.Month() && t.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+// IsMinorAt : user tanpa tanggal lahir dianggap dewasa (akun lama)
+func (u *User) IsMinorAt(t time.Time) bool {
+	age := u.AgeAt(t)
+	return age >= 0 && age < AgeOfMajority
+}
+
+// UserFilter : kriteria pencarian user untuk admin
+type UserFilter struct {
+	Email         string // exact match, tidak membedakan huruf besar
+	EmailPrefix   string // minimal 3 karakter, tidak membedakan huruf besar
+	Role          Role
+	Status        UserStatus
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Limit         int
+	Offset        int
 }
 
 func (u *User) IsPsychologist() bool {
 	return u.Role == PsychologistRole
 }
+
+func (u *User) IsServiceAccount() bool {
+	return u.Role == ServiceRole
+}
+
+// AwaitingEmailVerification : email belum diverifikasi dan akun belum aktif. Klien di bawah umur
+// memverifikasi email sambil menunggu persetujuan wali.
+func (u *User) AwaitingEmailVerification() bool {
+	return u.EmailVerifiedAt == nil && (u.Status == StatusPendingVerification || u.Status == StatusPendingGuardianConsent)
+}
+
+// StatusError mengembalikan error jika status akun tidak mengizinkan login/refresh
+func (u *User) StatusError() error {
+	switch u.Status {
+	case StatusActive:
+		return nil
+	case StatusPendingVerification:
+		return ErrAccountPendingVerification
+	case StatusPendingGuardianConsent:
+		return ErrGuardianConsentRequired
+	case StatusSuspended:
+		return ErrAccountSuspended
+	case StatusDeactivated:
+		return ErrAccountDeactivated
+	}
+	// deleted atau status tidak dikenal diperlakukan seperti akun tidak ada
+	return ErrInvalidCredentials
+}
+
+func (u *User) MFAEnabled() bool {
+	return u.MFAChannel != ""
+}
+
+// OTPDestination mengembalikan tujuan pengiriman kode untuk channel tertentu
+func (u *User) OTPDestination(channel OTPChannel) (string, error) {
+	switch channel {
+	case OTPChannelEmail:
+		return u.Email, nil
+	case OTPChannelSMS:
+		if u.Phone == "" || u.PhoneVerifiedAt == nil {
+			return "", ErrPhoneNotVerified
+		}
+		return u.Phone, nil
+	}
+	return "", ErrInvalidOTPChannel
+}
+
+func (u *User) HasRole(role Role) bool {
+	if u.Role == role {
+		return true
+	}
+	for _, r := range u.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
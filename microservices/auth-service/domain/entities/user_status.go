@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+// UserStatus : siklus hidup akun user
+type UserStatus string
+
+const (
+	StatusPendingVerification    UserStatus = "pending_verification"
+	StatusPendingGuardianConsent UserStatus = "pending_guardian_consent" // klien di bawah umur menunggu persetujuan wali
+	StatusActive                 UserStatus = "active"
+	StatusSuspended              UserStatus = "suspended"   // dibekukan admin, bisa diaktifkan kembali
+	StatusDeactivated            UserStatus = "deactivated" // ditutup (permintaan user, klien meninggal, dsb)
+	StatusDeleted                UserStatus = "deleted"     // final, tidak bisa diubah lagi
+)
+
+// Transisi status yang diizinkan
+var statusTransitions = map[UserStatus][]UserStatus{
+	StatusPendingVerification:    {StatusActive, StatusDeactivated, StatusDeleted},
+	StatusPendingGuardianConsent: {StatusPendingVerification, StatusActive, StatusDeactivated, StatusDeleted},
+	StatusActive:                 {StatusSuspended, StatusDeactivated, StatusDeleted},
+	StatusSuspended:              {StatusActive, StatusDeactivated, StatusDeleted},
+	StatusDeactivated:            {StatusActive, StatusDeleted},
+}
+
+func (s UserStatus) IsValid() bool {
+	_, ok := statusTransitions[s]
+	return ok || s == StatusDeleted
+}
+
+func (s UserStatus) CanTransitionTo(next UserStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusChange : perubahan status beserta alasannya
+type StatusChange struct {
+	Status    UserStatus
+	Reason    string
+	ChangedAt time.Time
+}
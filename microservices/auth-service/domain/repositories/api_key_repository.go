@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *entities.APIKey) error
+	FindByID(ctx context.Context, id string) (*entities.APIKey, error)
+	FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error)
+	ListByServiceAccount(ctx context.Context, serviceAccountID string) ([]*entities.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+type AuditRepository interface {
+	// Append mengisi PrevHash, Hash dan Seq lalu menyimpan event
+	Append(ctx context.Context, event *entities.AuditEvent) error
+	// Query mengembalikan event terbaru lebih dulu beserta total hasil filter
+	Query(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, int, error)
+	// ListAfter mengembalikan event dengan Seq > filter.AfterSeq secara berurutan (export/verifikasi)
+	ListAfter(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, error)
+	// Pseudonymize mengganti userID dan email user dengan pseudonym pada event yang menyebut
+	// user tersebut, menghapus data request (IP, user agent, device) miliknya dan menandai
+	// event redacted tanpa mengubah Hash (nonce field yang diubah dihapus). Berjalan di
+	// transaksinya sendiri. Mengembalikan jumlah event yang diubah.
+	Pseudonymize(ctx context.Context, userID, email, pseudonym string) (int, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// CareRelationshipRepository menyimpan hubungan klien - psikolog
+type CareRelationshipRepository interface {
+	// CreateCareRelationship ikut transaksi di ctx jika ada (lihat Transactor).
+	// ErrCareRelationshipExists jika pasangan ini sudah punya hubungan requested/active.
+	CreateCareRelationship(ctx context.Context, rel *entities.CareRelationship) error
+	// FindCareRelationship mengembalikan nil jika tidak ada
+	FindCareRelationship(ctx context.Context, id string) (*entities.CareRelationship, error)
+	// FindOpen mengembalikan hubungan requested/active antara klien dan psikolog, nil jika tidak ada
+	FindOpen(ctx context.Context, clientID, psychologistID string) (*entities.CareRelationship, error)
+	// ListByUser mengembalikan hubungan di mana userID menjadi klien atau psikolog, terbaru lebih dulu
+	ListByUser(ctx context.Context, userID string) ([]*entities.CareRelationship, error)
+	// UpdateCareRelationship menyimpan status, persetujuan dan data pengakhiran jika status
+	// di database masih from; ErrCareRelationshipNotFound jika sudah berubah
+	UpdateCareRelationship(ctx context.Context, rel *entities.CareRelationship, from entities.CareStatus) error
+}
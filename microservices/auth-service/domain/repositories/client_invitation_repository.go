@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// ClientInvitationRepository menyimpan undangan psikolog ke calon klien
+type ClientInvitationRepository interface {
+	CreateInvitation(ctx context.Context, inv *entities.ClientInvitation) error
+	// FindInvitation mengembalikan nil jika undangan tidak ada
+	FindInvitation(ctx context.Context, id string) (*entities.ClientInvitation, error)
+	ListByPsychologist(ctx context.Context, psychologistID string) ([]*entities.ClientInvitation, error)
+	// AcceptInvitation ikut transaksi di ctx; ErrInvalidToken jika undangan sudah dipakai atau dicabut
+	AcceptInvitation(ctx context.Context, id, clientID string, acceptedAt time.Time) error
+	// RevokeInvitation hanya berlaku untuk undangan milik psychologistID yang belum dipakai
+	RevokeInvitation(ctx context.Context, id, psychologistID string, revokedAt time.Time) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// ConsentRepository menyimpan versi dokumen persetujuan dan bukti persetujuan user
+type ConsentRepository interface {
+	// PublishDocument mengembalikan ErrConsentVersionExists jika kind+version sudah ada
+	PublishDocument(ctx context.Context, doc *entities.ConsentDocument) error
+	// ListCurrentDocuments mengembalikan versi terbaru setiap kind
+	ListCurrentDocuments(ctx context.Context) ([]*entities.ConsentDocument, error)
+	CreateRecord(ctx context.Context, record *entities.ConsentRecord) error
+	// ListRecords mengembalikan seluruh riwayat persetujuan user, terbaru lebih dulu
+	ListRecords(ctx context.Context, userID string) ([]*entities.ConsentRecord, error)
+	// WithdrawRecords menarik semua persetujuan aktif user untuk kind, mengembalikan jumlah record
+	WithdrawRecords(ctx context.Context, userID string, kind entities.ConsentKind, withdrawnAt time.Time) (int, error)
+}
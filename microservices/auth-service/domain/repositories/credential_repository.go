@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// CredentialRepository menyimpan pengajuan lisensi psikolog
+type CredentialRepository interface {
+	CreateCredential(ctx context.Context, cred *entities.PsychologistCredential) error
+	FindByID(ctx context.Context, id string) (*entities.PsychologistCredential, error)
+	// ListByUserID diurutkan dari pengajuan terbaru
+	ListByUserID(ctx context.Context, userID string) ([]*entities.PsychologistCredential, error)
+	ListByStatus(ctx context.Context, status entities.CredentialStatus, limit, offset int) ([]*entities.PsychologistCredential, error)
+	UpdateReview(ctx context.Context, cred *entities.PsychologistCredential) error
+	// ExpireCredentials menandai credential verified yang masa berlakunya lewat dan pengajuan
+	// pending yang melewati CredentialPendingTTL sebagai expired, mengembalikan jumlahnya
+	ExpireCredentials(ctx context.Context, now time.Time) (int, error)
+}
@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// DataExportRepository : antrean job export data user beserta arsip hasilnya
+type DataExportRepository interface {
+	// CreateExport mengembalikan ErrDataExportInProgress jika user masih punya job aktif
+	CreateExport(ctx context.Context, export *entities.DataExport) error
+	// FindExport mengembalikan nil, nil jika tidak ada; Archive hanya dimuat jika withArchive
+	FindExport(ctx context.Context, id string, withArchive bool) (*entities.DataExport, error)
+	// FindActiveByUser mengembalikan job pending/processing milik user, nil jika tidak ada
+	FindActiveByUser(ctx context.Context, userID string) (*entities.DataExport, error)
+	// ClaimPending mengambil job yang siap diproses (termasuk yang lease-nya habis karena worker
+	// mati) dan memegangnya selama lease
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.DataExport, error)
+	CompleteExport(ctx context.Context, id string, archive []byte, sha256 string, completedAt, expiresAt time.Time) error
+	// MarkFailed mengembalikan job ke antrean untuk dicoba lagi pada retryAt
+	MarkFailed(ctx context.Context, id, lastError string, retryAt time.Time) error
+	MarkDead(ctx context.Context, id, lastError string) error
+	// PurgeExpired menghapus arsip yang masa simpannya habis, mengembalikan jumlah job
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
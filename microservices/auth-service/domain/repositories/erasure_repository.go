@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// ErasureRepository : penghapusan permanen data pribadi user (hak untuk dihapus)
+type ErasureRepository interface {
+	// ListDue mengembalikan ID user yang jadwal penghapusannya sudah lewat
+	ListDue(ctx context.Context, now time.Time, limit int) ([]string, error)
+	// EraseUser menganonimkan baris users (ID tetap ada agar relasi tidak putus) dan
+	// menghapus data pribadi di tabel lain; email (plaintext) dipakai untuk mencocokkan undangan.
+	// Audit log ditangani AuditRepository.Pseudonymize.
+	EraseUser(ctx context.Context, userID, email string, erasedAt time.Time) error
+}
+
+// UserKeyRepository menyimpan kunci enkripsi per user. Menghapus kunci membuat semua data
+// yang dienkripsi dengannya tidak bisa dibaca lagi (crypto-shredding).
+type UserKeyRepository interface {
+	// FindKey mengembalikan nil, nil jika user belum punya kunci atau kuncinya sudah dihapus
+	FindKey(ctx context.Context, userID string) ([]byte, error)
+	// EnsureKey mengembalikan kunci user, membuatnya jika belum ada. Kunci yang sudah ada tidak
+	// ditimpa (pemanggilan bersamaan mendapat kunci yang sama) dan user yang sudah dihapus
+	// (kuncinya sudah di-shred) atau tidak ada mendapat ErrUserKeyDestroyed.
+	EnsureKey(ctx context.Context, userID string) ([]byte, error)
+	DestroyKey(ctx context.Context, userID string) error
+}
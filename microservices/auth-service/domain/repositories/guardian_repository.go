@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// GuardianRepository menyimpan hubungan wali - klien di bawah umur
+type GuardianRepository interface {
+	// CreateGuardianship ikut transaksi di ctx jika ada (lihat Transactor)
+	CreateGuardianship(ctx context.Context, g *entities.Guardianship) error
+	// FindByConsentToken mengembalikan nil jika token tidak dikenal
+	FindByConsentToken(ctx context.Context, tokenHash string) (*entities.Guardianship, error)
+	// ActivateGuardianship mengisi wali dan menghapus token persetujuan
+	ActivateGuardianship(ctx context.Context, id, guardianID string, consentedAt time.Time) error
+	// FindActive mengembalikan nil jika guardianID bukan wali aktif minorID
+	FindActive(ctx context.Context, guardianID, minorID string) (*entities.Guardianship, error)
+	ListActiveByGuardian(ctx context.Context, guardianID string) ([]*entities.Guardianship, error)
+}
@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+type IdentityRepository interface {
+	CreateIdentity(ctx context.Context, identity *entities.UserIdentity) error
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*entities.UserIdentity, error)
+	ListByUserID(ctx context.Context, userID string) ([]*entities.UserIdentity, error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// LoginHistoryRepository menyimpan device yang dikenal dan riwayat login untuk penilaian risiko
+type LoginHistoryRepository interface {
+	// FindDevice mengembalikan nil, nil jika device belum pernah dipakai user
+	FindDevice(ctx context.Context, userID, fingerprint string) (*entities.KnownDevice, error)
+	// UpsertDevice membuat device baru atau memperbarui last_seen
+	UpsertDevice(ctx context.Context, device *entities.KnownDevice) error
+	// LastLogin mengembalikan nil, nil jika user belum pernah login
+	LastLogin(ctx context.Context, userID string) (*entities.LoginRecord, error)
+	HasLoginFromCountry(ctx context.Context, userID, country string) (bool, error)
+	RecordLogin(ctx context.Context, record *entities.LoginRecord) error
+}
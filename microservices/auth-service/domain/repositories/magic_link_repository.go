@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// MagicLinkRepository menyimpan token magic link (dalam bentuk hash) sampai dipakai atau expired
+type MagicLinkRepository interface {
+	StoreMagicLink(ctx context.Context, link *entities.MagicLink, ttl time.Duration) error
+	// ConsumeMagicLink mengembalikan link dan menghapusnya, nil jika tidak ada / sudah dipakai
+	ConsumeMagicLink(ctx context.Context, tokenHash string) (*entities.MagicLink, error)
+	// CountRequest mencatat satu permintaan untuk penerima (hash email) dan mengembalikan jumlah
+	// permintaan dalam window, termasuk permintaan ini
+	CountRequest(ctx context.Context, recipientHash string, window time.Duration) (int, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// NotificationRepository : antrean kirim notifikasi yang persisten
+type NotificationRepository interface {
+	Enqueue(ctx context.Context, notification *entities.Notification) error
+	// ClaimPending mengambil notifikasi yang siap dikirim dan menunda next_attempt_at sebesar lease
+	// agar tidak diambil dispatcher lain selama sedang dikirim
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.Notification, error)
+	// MarkSent dan MarkDead juga menghapus Data, yang bisa memuat token atau kode
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+	MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+	MarkDead(ctx context.Context, id string, lastError string) error
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// OrganizationRepository menyimpan organisasi, member dan undangan.
+//
+// Method member dan undangan bersifat tenant-scoped: organisasi diambil dari
+// entities.TenantFromContext, bukan dari parameter, dan mengembalikan
+// entities.ErrTenantRequired jika ctx tidak membawa tenant. Dengan begitu query
+// tidak mungkin membaca atau mengubah data organisasi lain.
+type OrganizationRepository interface {
+	// CreateOrganization menyimpan organisasi beserta owner pertamanya, ikut transaksi di ctx jika ada
+	CreateOrganization(ctx context.Context, org *entities.Organization, owner *entities.Membership) error
+	// FindOrganization mengembalikan nil jika tidak ada
+	FindOrganization(ctx context.Context, id string) (*entities.Organization, error)
+	// ListMembershipsByUser : satu-satunya query lintas tenant, hanya keanggotaan milik userID
+	ListMembershipsByUser(ctx context.Context, userID string) ([]*entities.Membership, error)
+	// FindInvitationByToken mencari lintas tenant karena token sudah menentukan organisasinya; nil jika tidak ada
+	FindInvitationByToken(ctx context.Context, tokenHash string) (*entities.OrgInvitation, error)
+
+	// FindMember mengembalikan nil jika userID bukan member tenant
+	FindMember(ctx context.Context, userID string) (*entities.Membership, error)
+	ListMembers(ctx context.Context) ([]*entities.Membership, error)
+	// AddMember mengembalikan ErrAlreadyOrgMember jika user sudah menjadi member
+	AddMember(ctx context.Context, m *entities.Membership) error
+	SetMemberRole(ctx context.Context, userID string, role entities.OrgRole) error
+	RemoveMember(ctx context.Context, userID string) error
+	CountMembersWithRole(ctx context.Context, role entities.OrgRole) (int, error)
+	CreateInvitation(ctx context.Context, inv *entities.OrgInvitation) error
+	// MarkInvitationAccepted mengembalikan ErrInvalidToken jika undangan sudah dipakai
+	MarkInvitationAccepted(ctx context.Context, id string, acceptedAt time.Time) error
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+// OTPRepository menyimpan challenge OTP beserta jumlah percobaan verifikasi sampai expired
+type OTPRepository interface {
+	StoreOTP(ctx context.Context, challenge *entities.OTPChallenge, ttl time.Duration) error
+	// FindOTP mengembalikan nil jika challenge tidak ada / sudah expired
+	FindOTP(ctx context.Context, id string) (*entities.OTPChallenge, error)
+	// IncrementOTPAttempts menambah counter percobaan secara atomik, 0 jika challenge sudah tidak ada
+	IncrementOTPAttempts(ctx context.Context, id string) (int, error)
+	// DeleteOTP mengembalikan false jika challenge sudah dihapus request lain
+	DeleteOTP(ctx context.Context, id string) (bool, error)
+	// CountIssue mencatat satu penerbitan untuk key (hash user + tujuan) dan mengembalikan jumlah
+	// penerbitan dalam window yang dimulai dari penerbitan pertama
+	CountIssue(ctx context.Context, key string, window time.Duration) (int, error)
+}
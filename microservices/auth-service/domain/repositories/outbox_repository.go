@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+type OutboxRepository interface {
+	// Enqueue ikut transaksi di ctx jika ada (lihat Transactor)
+	Enqueue(ctx context.Context, events ...*entities.DomainEvent) error
+	// ClaimPending mengambil event yang siap dikirim dan menunda next_attempt_at sebesar lease
+	// agar tidak diambil relay lain selama sedang dikirim
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.DomainEvent, error)
+	MarkPublished(ctx context.Context, id string, publishedAt time.Time) error
+	MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+	// PurgePublished menghapus maksimal limit event yang terkirim sebelum publishedBefore,
+	// mengembalikan jumlah yang dihapus
+	PurgePublished(ctx context.Context, publishedBefore time.Time, limit int) (int, error)
+}
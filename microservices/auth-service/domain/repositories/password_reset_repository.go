@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordResetRepository menyimpan token reset password sekali pakai (dalam bentuk hash)
+type PasswordResetRepository interface {
+	StoreResetToken(ctx context.Context, tokenHash, userID string, ttl time.Duration) error
+	// ConsumeResetToken mengembalikan userID dan menghapus token, string kosong jika tidak ada
+	ConsumeResetToken(ctx context.Context, tokenHash string) (string, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import "context"
+
+// PIIRepository : pemeliharaan enkripsi PII yang dijalankan bertahap oleh job di background
+type PIIRepository interface {
+	// EncryptPlaintext mengenkripsi baris users yang masih menyimpan PII plaintext
+	// (dibuat sebelum enkripsi diaktifkan) dan menghitung ulang blind index email yang dibuat
+	// sebelum normalisasi. Baris yang gagal dicatat dan dilewati tanpa
+	// menggagalkan batch; error hanya untuk kegagalan batch itu sendiri.
+	EncryptPlaintext(ctx context.Context, limit int) (encrypted, failed int, err error)
+	// RewrapKeys membungkus ulang data key yang belum memakai master key terbaru
+	// (setelah rotasi), mengembalikan jumlah kunci yang diproses
+	RewrapKeys(ctx context.Context, limit int) (int, error)
+}
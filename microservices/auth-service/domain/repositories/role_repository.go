@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+type RoleRepository interface {
+	ListRoles(ctx context.Context) ([]*entities.RoleDefinition, error)
+	FindRole(ctx context.Context, name entities.Role) (*entities.RoleDefinition, error)
+	SetRolePermissions(ctx context.Context, name entities.Role, permissions []entities.Permission) error
+	GetUserRoles(ctx context.Context, userID string) ([]entities.Role, error)
+	AssignRole(ctx context.Context, userID string, role entities.Role) error
+	RevokeRole(ctx context.Context, userID string, role entities.Role) error
+	// ListRoleHolders mengembalikan ID user yang memegang role (termasuk sebagai role utama)
+	ListRoleHolders(ctx context.Context, role entities.Role) ([]string, error)
+	// GetPermissions mengembalikan gabungan permission (tanpa duplikat) dari roles
+	GetPermissions(ctx context.Context, roles []entities.Role) ([]entities.Permission, error)
+}
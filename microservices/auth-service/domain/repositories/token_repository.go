@@ -2,11 +2,27 @@ package repositories
 
 import (
 	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
 )
 
 type TokenRepository interface {
-	StoreToken(ctx context.Context, tokenID, userID string) error
+	// StoreToken menyimpan refresh token (session.TokenID) beserta metadata client-nya
+	StoreToken(ctx context.Context, session *entities.Session) error
+	// GetSession mengembalikan nil, nil jika metadata sesi tidak ada (refresh token lama)
+	GetSession(ctx context.Context, tokenID string) (*entities.Session, error)
+	// ListUserSessions mengembalikan sesi user yang masih berlaku (e.g. untuk export data)
+	ListUserSessions(ctx context.Context, userID string) ([]*entities.Session, error)
 	IsTokenRevoked(ctx context.Context, tokenID string) bool
 	RevokeToken(ctx context.Context, tokenID string) error
 	GetUserIDByTokenID(ctx context.Context, tokenID string) (string, error)
+	// RevokeAllUserTokens mencabut semua refresh token milik user dan memasang watermark
+	// sehingga access token yang diterbitkan sebelumnya ikut tidak berlaku
+	RevokeAllUserTokens(ctx context.Context, userID string) error
+	// InvalidateAccessTokens hanya memasang watermark, refresh token tetap berlaku (e.g. role berubah)
+	InvalidateAccessTokens(ctx context.Context, userID string) error
+	// DenyAccessToken memasukkan jti ke denylist sampai token expired
+	DenyAccessToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	// IsAccessTokenRevoked memeriksa denylist jti dan watermark user
+	IsAccessTokenRevoked(ctx context.Context, tokenID, userID string, issuedAt time.Time) (bool, error)
 }
@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// Transactor menjalankan fn dalam satu transaksi database. Repository yang dipanggil
+// dengan ctx milik fn ikut transaksi tersebut; transaksi di-rollback jika fn error.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
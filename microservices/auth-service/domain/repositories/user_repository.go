@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"microservices/auth-service/domain/entities"
+	"time"
 )
 
 // UserRepository : Interface untuk abstract database
@@ -10,4 +11,20 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *entities.User) error
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
 	FindByID(ctx context.Context, id string) (*entities.User, error)
-}
\ No newline at end of file
+	// SearchUsers mengembalikan satu halaman hasil beserta total user yang cocok
+	SearchUsers(ctx context.Context, filter entities.UserFilter) ([]*entities.User, int, error)
+	// SetStatus mengubah status akun beserta alasan dan waktunya
+	SetStatus(ctx context.Context, id string, change entities.StatusChange) error
+	SetPasswordResetRequired(ctx context.Context, id string, required bool) error
+	UpdatePassword(ctx context.Context, id, passwordHash string) error
+	// UpdateRole mengganti role utama di users dan user_roles; role utama lama ikut dicabut
+	UpdateRole(ctx context.Context, id string, role entities.Role) error
+	// SetPhone menyimpan nomor telepon yang sudah diverifikasi
+	SetPhone(ctx context.Context, id, phone string, verifiedAt time.Time) error
+	// MarkEmailVerified mencatat waktu verifikasi email dan mengaktifkan akun yang masih pending_verification
+	MarkEmailVerified(ctx context.Context, id string, verifiedAt time.Time) error
+	// SetMFAChannel mengaktifkan MFA lewat channel tertentu, channel kosong menonaktifkan
+	SetMFAChannel(ctx context.Context, id string, channel entities.OTPChannel) error
+	// SetDeletionSchedule menjadwalkan penghapusan akun, nil membatalkan jadwal
+	SetDeletionSchedule(ctx context.Context, id string, at *time.Time) error
+}
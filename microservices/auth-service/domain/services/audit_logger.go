@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// AuditLogger mencatat aksi yang relevan untuk keamanan/kepatuhan.
+// Implementasi melengkapi IP, user agent, request ID dan actor dari context.
+type AuditLogger interface {
+	Record(ctx context.Context, event *entities.AuditEvent) error
+}
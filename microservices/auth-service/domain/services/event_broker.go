@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// EventBroker mengirim domain event ke message broker (Redis Streams, NATS JetStream, ...).
+// Subject/stream ditentukan dari event.Type. Implementasi sebaiknya memakai event.ID sebagai
+// message ID untuk deduplikasi (e.g. header Nats-Msg-Id), relay bisa mengirim ulang event yang sama.
+type EventBroker interface {
+	Publish(ctx context.Context, event *entities.DomainEvent) error
+}
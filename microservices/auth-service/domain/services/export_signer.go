@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// ExportSigner menandatangani arsip export dengan private key yang tidak pernah keluar dari
+// KMS. Public key (termasuk key lama setelah rotasi) dipublikasikan lewat PublicKeys.
+type ExportSigner interface {
+	// Algorithm : nama algoritma tanda tangan, e.g. "Ed25519"
+	Algorithm() string
+	Sign(ctx context.Context, data []byte) (keyID string, signature []byte, err error)
+	PublicKeys(ctx context.Context) ([]*entities.SigningKey, error)
+}
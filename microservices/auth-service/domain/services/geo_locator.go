@@ -0,0 +1,8 @@
+package services
+
+import "microservices/auth-service/domain/entities"
+
+// GeoLocator memetakan IP ke lokasi. Mengembalikan nil, nil jika IP tidak dikenal (e.g. IP privat).
+type GeoLocator interface {
+	Locate(ip string) (*entities.GeoLocation, error)
+}
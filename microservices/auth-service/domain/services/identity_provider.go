@@ -0,0 +1,14 @@
+package services
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// IdentityProvider : abstraksi untuk login federasi ("Sign in with Google/Apple")
+type IdentityProvider interface {
+	Name() string
+	// AuthCodeURL gagal jika konfigurasi provider (discovery) tidak bisa diambil
+	AuthCodeURL(ctx context.Context, state, nonce string) (string, error)
+	Exchange(ctx context.Context, code, nonce string) (*entities.ExternalIdentity, error)
+}
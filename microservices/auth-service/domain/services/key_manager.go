@@ -0,0 +1,13 @@
+package services
+
+import "context"
+
+// KeyManager membungkus (wrap) data key dengan master key yang tidak pernah keluar dari KMS.
+// Data key terbungkus disimpan bersama keyID master key yang dipakai, sehingga setelah
+// rotasi data key lama masih bisa dibuka lalu dibungkus ulang dengan master key terbaru.
+type KeyManager interface {
+	// CurrentKeyID : master key yang dipakai WrapKey
+	CurrentKeyID() string
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// Notifier mengirim pesan ke user (email, SMS, ...)
+type Notifier interface {
+	Notify(ctx context.Context, notification *entities.Notification) error
+}
+
+// MessageRenderer mengubah notifikasi menjadi pesan sesuai template dan bahasa user
+type MessageRenderer interface {
+	Render(notification *entities.Notification) (*entities.Message, error)
+}
+
+// MessageTransport mengirim pesan yang sudah dirender (SMTP, file, log)
+type MessageTransport interface {
+	Send(ctx context.Context, message *entities.Message) error
+}
@@ -0,0 +1,8 @@
+package services
+
+import "context"
+
+// SMSSender mengirim SMS lewat provider (Twilio, dsb)
+type SMSSender interface {
+	SendSMS(ctx context.Context, to, message string) error
+}
@@ -0,0 +1,11 @@
+package services
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+)
+
+// RevocationPublisher menyiarkan event revocation ke service lain (Redis pub/sub)
+type RevocationPublisher interface {
+	PublishRevocation(ctx context.Context, event *entities.RevocationEvent) error
+}
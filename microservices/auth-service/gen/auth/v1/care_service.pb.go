@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/care_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CareRelationship struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipId          string                 `protobuf:"bytes,1,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	ClientId                string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	PsychologistId          string                 `protobuf:"bytes,3,opt,name=psychologist_id,json=psychologistId,proto3" json:"psychologist_id,omitempty"`
+	Status                  string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "requested", "active", "ended"
+	RequestedBy             string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt               int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                             // unix seconds
+	ClientConsentedAt       int64                  `protobuf:"varint,7,opt,name=client_consented_at,json=clientConsentedAt,proto3" json:"client_consented_at,omitempty"`                   // unix seconds, 0 jika belum
+	PsychologistConsentedAt int64                  `protobuf:"varint,8,opt,name=psychologist_consented_at,json=psychologistConsentedAt,proto3" json:"psychologist_consented_at,omitempty"` // unix seconds, 0 jika belum
+	EndedAt                 int64                  `protobuf:"varint,9,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`                                                   // unix seconds, 0 jika belum berakhir
+	EndedBy                 string                 `protobuf:"bytes,10,opt,name=ended_by,json=endedBy,proto3" json:"ended_by,omitempty"`
+	EndReason               string                 `protobuf:"bytes,11,opt,name=end_reason,json=endReason,proto3" json:"end_reason,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CareRelationship) Reset() {
+	*x = CareRelationship{}
+	mi := &file_proto_care_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CareRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CareRelationship) ProtoMessage() {}
+
+func (x *CareRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CareRelationship.ProtoReflect.Descriptor instead.
+func (*CareRelationship) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CareRelationship) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *CareRelationship) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CareRelationship) GetPsychologistId() string {
+	if x != nil {
+		return x.PsychologistId
+	}
+	return ""
+}
+
+func (x *CareRelationship) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CareRelationship) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CareRelationship) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CareRelationship) GetClientConsentedAt() int64 {
+	if x != nil {
+		return x.ClientConsentedAt
+	}
+	return 0
+}
+
+func (x *CareRelationship) GetPsychologistConsentedAt() int64 {
+	if x != nil {
+		return x.PsychologistConsentedAt
+	}
+	return 0
+}
+
+func (x *CareRelationship) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *CareRelationship) GetEndedBy() string {
+	if x != nil {
+		return x.EndedBy
+	}
+	return ""
+}
+
+func (x *CareRelationship) GetEndReason() string {
+	if x != nil {
+		return x.EndReason
+	}
+	return ""
+}
+
+type RequestCareRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CounterpartyId string                 `protobuf:"bytes,1,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RequestCareRequest) Reset() {
+	*x = RequestCareRequest{}
+	mi := &file_proto_care_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCareRequest) ProtoMessage() {}
+
+func (x *RequestCareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCareRequest.ProtoReflect.Descriptor instead.
+func (*RequestCareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestCareRequest) GetCounterpartyId() string {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return ""
+}
+
+type RequestCareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *CareRelationship      `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestCareResponse) Reset() {
+	*x = RequestCareResponse{}
+	mi := &file_proto_care_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestCareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCareResponse) ProtoMessage() {}
+
+func (x *RequestCareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCareResponse.ProtoReflect.Descriptor instead.
+func (*RequestCareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestCareResponse) GetRelationship() *CareRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type AcceptCareRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipId string                 `protobuf:"bytes,1,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcceptCareRequest) Reset() {
+	*x = AcceptCareRequest{}
+	mi := &file_proto_care_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCareRequest) ProtoMessage() {}
+
+func (x *AcceptCareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCareRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcceptCareRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+type AcceptCareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *CareRelationship      `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCareResponse) Reset() {
+	*x = AcceptCareResponse{}
+	mi := &file_proto_care_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCareResponse) ProtoMessage() {}
+
+func (x *AcceptCareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCareResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptCareResponse) GetRelationship() *CareRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type EndCareRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipId string                 `protobuf:"bytes,1,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // opsional, maksimal 500 karakter
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EndCareRequest) Reset() {
+	*x = EndCareRequest{}
+	mi := &file_proto_care_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCareRequest) ProtoMessage() {}
+
+func (x *EndCareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCareRequest.ProtoReflect.Descriptor instead.
+func (*EndCareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EndCareRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *EndCareRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EndCareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *CareRelationship      `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndCareResponse) Reset() {
+	*x = EndCareResponse{}
+	mi := &file_proto_care_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCareResponse) ProtoMessage() {}
+
+func (x *EndCareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCareResponse.ProtoReflect.Descriptor instead.
+func (*EndCareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EndCareResponse) GetRelationship() *CareRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type ListCareRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCareRelationshipsRequest) Reset() {
+	*x = ListCareRelationshipsRequest{}
+	mi := &file_proto_care_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCareRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCareRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListCareRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCareRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListCareRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ListCareRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*CareRelationship    `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCareRelationshipsResponse) Reset() {
+	*x = ListCareRelationshipsResponse{}
+	mi := &file_proto_care_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCareRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCareRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListCareRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCareRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListCareRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCareRelationshipsResponse) GetRelationships() []*CareRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`                                  // user yang ingin mengakses
+	ResourceOwner string                 `protobuf:"bytes,2,opt,name=resource_owner,json=resourceOwner,proto3" json:"resource_owner,omitempty"` // pemilik data
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                                    // "profile:read", "account:manage", "clinical:read", "clinical:write"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_proto_care_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckAccessRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetResourceOwner() string {
+	if x != nil {
+		return x.ResourceOwner
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type CheckAccessResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// Dasar izin: "self", "care_relationship", "guardianship"; kosong jika ditolak
+	Grant          string `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
+	RelationshipId string `protobuf:"bytes,3,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	// Lama hasil boleh di-cache pemanggil
+	CacheTtlSeconds int64 `protobuf:"varint,4,opt,name=cache_ttl_seconds,json=cacheTtlSeconds,proto3" json:"cache_ttl_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	mi := &file_proto_care_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessResponse) ProtoMessage() {}
+
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_care_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_care_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckAccessResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckAccessResponse) GetGrant() string {
+	if x != nil {
+		return x.Grant
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *CheckAccessResponse) GetCacheTtlSeconds() int64 {
+	if x != nil {
+		return x.CacheTtlSeconds
+	}
+	return 0
+}
+
+var File_proto_care_service_proto protoreflect.FileDescriptor
+
+const file_proto_care_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/care_service.proto\x12\aauth.v1\"\x9c\x03\n" +
+	"\x10CareRelationship\x12'\n" +
+	"\x0frelationship_id\x18\x01 \x01(\tR\x0erelationshipId\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12'\n" +
+	"\x0fpsychologist_id\x18\x03 \x01(\tR\x0epsychologistId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12.\n" +
+	"\x13client_consented_at\x18\a \x01(\x03R\x11clientConsentedAt\x12:\n" +
+	"\x19psychologist_consented_at\x18\b \x01(\x03R\x17psychologistConsentedAt\x12\x19\n" +
+	"\bended_at\x18\t \x01(\x03R\aendedAt\x12\x19\n" +
+	"\bended_by\x18\n" +
+	" \x01(\tR\aendedBy\x12\x1d\n" +
+	"\n" +
+	"end_reason\x18\v \x01(\tR\tendReason\"=\n" +
+	"\x12RequestCareRequest\x12'\n" +
+	"\x0fcounterparty_id\x18\x01 \x01(\tR\x0ecounterpartyId\"T\n" +
+	"\x13RequestCareResponse\x12=\n" +
+	"\frelationship\x18\x01 \x01(\v2\x19.auth.v1.CareRelationshipR\frelationship\"<\n" +
+	"\x11AcceptCareRequest\x12'\n" +
+	"\x0frelationship_id\x18\x01 \x01(\tR\x0erelationshipId\"S\n" +
+	"\x12AcceptCareResponse\x12=\n" +
+	"\frelationship\x18\x01 \x01(\v2\x19.auth.v1.CareRelationshipR\frelationship\"Q\n" +
+	"\x0eEndCareRequest\x12'\n" +
+	"\x0frelationship_id\x18\x01 \x01(\tR\x0erelationshipId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"P\n" +
+	"\x0fEndCareResponse\x12=\n" +
+	"\frelationship\x18\x01 \x01(\v2\x19.auth.v1.CareRelationshipR\frelationship\"\x1e\n" +
+	"\x1cListCareRelationshipsRequest\"`\n" +
+	"\x1dListCareRelationshipsResponse\x12?\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x19.auth.v1.CareRelationshipR\rrelationships\"m\n" +
+	"\x12CheckAccessRequest\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12%\n" +
+	"\x0eresource_owner\x18\x02 \x01(\tR\rresourceOwner\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"\x9a\x01\n" +
+	"\x13CheckAccessResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x14\n" +
+	"\x05grant\x18\x02 \x01(\tR\x05grant\x12'\n" +
+	"\x0frelationship_id\x18\x03 \x01(\tR\x0erelationshipId\x12*\n" +
+	"\x11cache_ttl_seconds\x18\x04 \x01(\x03R\x0fcacheTtlSeconds2\x8e\x03\n" +
+	"\vCareService\x12H\n" +
+	"\vRequestCare\x12\x1b.auth.v1.RequestCareRequest\x1a\x1c.auth.v1.RequestCareResponse\x12E\n" +
+	"\n" +
+	"AcceptCare\x12\x1a.auth.v1.AcceptCareRequest\x1a\x1b.auth.v1.AcceptCareResponse\x12<\n" +
+	"\aEndCare\x12\x17.auth.v1.EndCareRequest\x1a\x18.auth.v1.EndCareResponse\x12f\n" +
+	"\x15ListCareRelationships\x12%.auth.v1.ListCareRelationshipsRequest\x1a&.auth.v1.ListCareRelationshipsResponse\x12H\n" +
+	"\vCheckAccess\x12\x1b.auth.v1.CheckAccessRequest\x1a\x1c.auth.v1.CheckAccessResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_care_service_proto_rawDescOnce sync.Once
+	file_proto_care_service_proto_rawDescData []byte
+)
+
+func file_proto_care_service_proto_rawDescGZIP() []byte {
+	file_proto_care_service_proto_rawDescOnce.Do(func() {
+		file_proto_care_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_care_service_proto_rawDesc), len(file_proto_care_service_proto_rawDesc)))
+	})
+	return file_proto_care_service_proto_rawDescData
+}
+
+var file_proto_care_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_care_service_proto_goTypes = []any{
+	(*CareRelationship)(nil),              // 0: auth.v1.CareRelationship
+	(*RequestCareRequest)(nil),            // 1: auth.v1.RequestCareRequest
+	(*RequestCareResponse)(nil),           // 2: auth.v1.RequestCareResponse
+	(*AcceptCareRequest)(nil),             // 3: auth.v1.AcceptCareRequest
+	(*AcceptCareResponse)(nil),            // 4: auth.v1.AcceptCareResponse
+	(*EndCareRequest)(nil),                // 5: auth.v1.EndCareRequest
+	(*EndCareResponse)(nil),               // 6: auth.v1.EndCareResponse
+	(*ListCareRelationshipsRequest)(nil),  // 7: auth.v1.ListCareRelationshipsRequest
+	(*ListCareRelationshipsResponse)(nil), // 8: auth.v1.ListCareRelationshipsResponse
+	(*CheckAccessRequest)(nil),            // 9: auth.v1.CheckAccessRequest
+	(*CheckAccessResponse)(nil),           // 10: auth.v1.CheckAccessResponse
+}
+var file_proto_care_service_proto_depIdxs = []int32{
+	0,  // 0: auth.v1.RequestCareResponse.relationship:type_name -> auth.v1.CareRelationship
+	0,  // 1: auth.v1.AcceptCareResponse.relationship:type_name -> auth.v1.CareRelationship
+	0,  // 2: auth.v1.EndCareResponse.relationship:type_name -> auth.v1.CareRelationship
+	0,  // 3: auth.v1.ListCareRelationshipsResponse.relationships:type_name -> auth.v1.CareRelationship
+	1,  // 4: auth.v1.CareService.RequestCare:input_type -> auth.v1.RequestCareRequest
+	3,  // 5: auth.v1.CareService.AcceptCare:input_type -> auth.v1.AcceptCareRequest
+	5,  // 6: auth.v1.CareService.EndCare:input_type -> auth.v1.EndCareRequest
+	7,  // 7: auth.v1.CareService.ListCareRelationships:input_type -> auth.v1.ListCareRelationshipsRequest
+	9,  // 8: auth.v1.CareService.CheckAccess:input_type -> auth.v1.CheckAccessRequest
+	2,  // 9: auth.v1.CareService.RequestCare:output_type -> auth.v1.RequestCareResponse
+	4,  // 10: auth.v1.CareService.AcceptCare:output_type -> auth.v1.AcceptCareResponse
+	6,  // 11: auth.v1.CareService.EndCare:output_type -> auth.v1.EndCareResponse
+	8,  // 12: auth.v1.CareService.ListCareRelationships:output_type -> auth.v1.ListCareRelationshipsResponse
+	10, // 13: auth.v1.CareService.CheckAccess:output_type -> auth.v1.CheckAccessResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_care_service_proto_init() }
+func file_proto_care_service_proto_init() {
+	if File_proto_care_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_care_service_proto_rawDesc), len(file_proto_care_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_care_service_proto_goTypes,
+		DependencyIndexes: file_proto_care_service_proto_depIdxs,
+		MessageInfos:      file_proto_care_service_proto_msgTypes,
+	}.Build()
+	File_proto_care_service_proto = out.File
+	file_proto_care_service_proto_goTypes = nil
+	file_proto_care_service_proto_depIdxs = nil
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/care_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CareService_RequestCare_FullMethodName           = "/auth.v1.CareService/RequestCare"
+	CareService_AcceptCare_FullMethodName            = "/auth.v1.CareService/AcceptCare"
+	CareService_EndCare_FullMethodName               = "/auth.v1.CareService/EndCare"
+	CareService_ListCareRelationships_FullMethodName = "/auth.v1.CareService/ListCareRelationships"
+	CareService_CheckAccess_FullMethodName           = "/auth.v1.CareService/CheckAccess"
+)
+
+// CareServiceClient is the client API for CareService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Hubungan klien - psikolog. Request/Accept/End/List membutuhkan access token salah satu pihak;
+// CheckAccess dipanggil service lain dengan service token ber-scope "access:check".
+type CareServiceClient interface {
+	// Klien meminta ke psikolog terverifikasi, atau psikolog terverifikasi ke klien
+	RequestCare(ctx context.Context, in *RequestCareRequest, opts ...grpc.CallOption) (*RequestCareResponse, error)
+	// Hanya pihak yang diminta
+	AcceptCare(ctx context.Context, in *AcceptCareRequest, opts ...grpc.CallOption) (*AcceptCareResponse, error)
+	// Salah satu pihak; juga untuk menolak atau membatalkan permintaan
+	EndCare(ctx context.Context, in *EndCareRequest, opts ...grpc.CallOption) (*EndCareResponse, error)
+	ListCareRelationships(ctx context.Context, in *ListCareRelationshipsRequest, opts ...grpc.CallOption) (*ListCareRelationshipsResponse, error)
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+}
+
+type careServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCareServiceClient(cc grpc.ClientConnInterface) CareServiceClient {
+	return &careServiceClient{cc}
+}
+
+func (c *careServiceClient) RequestCare(ctx context.Context, in *RequestCareRequest, opts ...grpc.CallOption) (*RequestCareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestCareResponse)
+	err := c.cc.Invoke(ctx, CareService_RequestCare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *careServiceClient) AcceptCare(ctx context.Context, in *AcceptCareRequest, opts ...grpc.CallOption) (*AcceptCareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCareResponse)
+	err := c.cc.Invoke(ctx, CareService_AcceptCare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *careServiceClient) EndCare(ctx context.Context, in *EndCareRequest, opts ...grpc.CallOption) (*EndCareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndCareResponse)
+	err := c.cc.Invoke(ctx, CareService_EndCare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *careServiceClient) ListCareRelationships(ctx context.Context, in *ListCareRelationshipsRequest, opts ...grpc.CallOption) (*ListCareRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCareRelationshipsResponse)
+	err := c.cc.Invoke(ctx, CareService_ListCareRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *careServiceClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, CareService_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CareServiceServer is the server API for CareService service.
+// All implementations must embed UnimplementedCareServiceServer
+// for forward compatibility.
+//
+// Hubungan klien - psikolog. Request/Accept/End/List membutuhkan access token salah satu pihak;
+// CheckAccess dipanggil service lain dengan service token ber-scope "access:check".
+type CareServiceServer interface {
+	// Klien meminta ke psikolog terverifikasi, atau psikolog terverifikasi ke klien
+	RequestCare(context.Context, *RequestCareRequest) (*RequestCareResponse, error)
+	// Hanya pihak yang diminta
+	AcceptCare(context.Context, *AcceptCareRequest) (*AcceptCareResponse, error)
+	// Salah satu pihak; juga untuk menolak atau membatalkan permintaan
+	EndCare(context.Context, *EndCareRequest) (*EndCareResponse, error)
+	ListCareRelationships(context.Context, *ListCareRelationshipsRequest) (*ListCareRelationshipsResponse, error)
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+	mustEmbedUnimplementedCareServiceServer()
+}
+
+// UnimplementedCareServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCareServiceServer struct{}
+
+func (UnimplementedCareServiceServer) RequestCare(context.Context, *RequestCareRequest) (*RequestCareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCare not implemented")
+}
+func (UnimplementedCareServiceServer) AcceptCare(context.Context, *AcceptCareRequest) (*AcceptCareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCare not implemented")
+}
+func (UnimplementedCareServiceServer) EndCare(context.Context, *EndCareRequest) (*EndCareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndCare not implemented")
+}
+func (UnimplementedCareServiceServer) ListCareRelationships(context.Context, *ListCareRelationshipsRequest) (*ListCareRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCareRelationships not implemented")
+}
+func (UnimplementedCareServiceServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedCareServiceServer) mustEmbedUnimplementedCareServiceServer() {}
+func (UnimplementedCareServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCareServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CareServiceServer will
+// result in compilation errors.
+type UnsafeCareServiceServer interface {
+	mustEmbedUnimplementedCareServiceServer()
+}
+
+func RegisterCareServiceServer(s grpc.ServiceRegistrar, srv CareServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCareServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CareService_ServiceDesc, srv)
+}
+
+func _CareService_RequestCare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CareServiceServer).RequestCare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CareService_RequestCare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CareServiceServer).RequestCare(ctx, req.(*RequestCareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CareService_AcceptCare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CareServiceServer).AcceptCare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CareService_AcceptCare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CareServiceServer).AcceptCare(ctx, req.(*AcceptCareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CareService_EndCare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndCareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CareServiceServer).EndCare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CareService_EndCare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CareServiceServer).EndCare(ctx, req.(*EndCareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CareService_ListCareRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCareRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CareServiceServer).ListCareRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CareService_ListCareRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CareServiceServer).ListCareRelationships(ctx, req.(*ListCareRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CareService_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CareServiceServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CareService_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CareServiceServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CareService_ServiceDesc is the grpc.ServiceDesc for CareService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CareService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.CareService",
+	HandlerType: (*CareServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestCare",
+			Handler:    _CareService_RequestCare_Handler,
+		},
+		{
+			MethodName: "AcceptCare",
+			Handler:    _CareService_AcceptCare_Handler,
+		},
+		{
+			MethodName: "EndCare",
+			Handler:    _CareService_EndCare_Handler,
+		},
+		{
+			MethodName: "ListCareRelationships",
+			Handler:    _CareService_ListCareRelationships_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _CareService_CheckAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/care_service.proto",
+}
@@ -0,0 +1,487 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/client_invitation_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClientInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PreLinkCare   bool                   `protobuf:"varint,3,opt,name=pre_link_care,json=preLinkCare,proto3" json:"pre_link_care,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // unix seconds
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // unix seconds
+	AcceptedAt    int64                  `protobuf:"varint,6,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"` // unix seconds, 0 jika belum dipakai
+	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RevokedAt     int64                  `protobuf:"varint,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"` // unix seconds, 0 jika tidak dicabut
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientInvitation) Reset() {
+	*x = ClientInvitation{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientInvitation) ProtoMessage() {}
+
+func (x *ClientInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientInvitation.ProtoReflect.Descriptor instead.
+func (*ClientInvitation) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientInvitation) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *ClientInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ClientInvitation) GetPreLinkCare() bool {
+	if x != nil {
+		return x.PreLinkCare
+	}
+	return false
+}
+
+func (x *ClientInvitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ClientInvitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ClientInvitation) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *ClientInvitation) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ClientInvitation) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+type CreateClientInvitationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Email     string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ExpiresAt int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unix seconds, opsional (default 14 hari, maksimal 30 hari)
+	// Setelah mendaftar, klien menerima permintaan care relationship dari psikolog
+	PreLinkCare   bool `protobuf:"varint,3,opt,name=pre_link_care,json=preLinkCare,proto3" json:"pre_link_care,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientInvitationRequest) Reset() {
+	*x = CreateClientInvitationRequest{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientInvitationRequest) ProtoMessage() {}
+
+func (x *CreateClientInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateClientInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateClientInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateClientInvitationRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateClientInvitationRequest) GetPreLinkCare() bool {
+	if x != nil {
+		return x.PreLinkCare
+	}
+	return false
+}
+
+type CreateClientInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *ClientInvitation      `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientInvitationResponse) Reset() {
+	*x = CreateClientInvitationResponse{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientInvitationResponse) ProtoMessage() {}
+
+func (x *CreateClientInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateClientInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateClientInvitationResponse) GetInvitation() *ClientInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type ListClientInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientInvitationsRequest) Reset() {
+	*x = ListClientInvitationsRequest{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientInvitationsRequest) ProtoMessage() {}
+
+func (x *ListClientInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListClientInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ListClientInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*ClientInvitation    `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientInvitationsResponse) Reset() {
+	*x = ListClientInvitationsResponse{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientInvitationsResponse) ProtoMessage() {}
+
+func (x *ListClientInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListClientInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListClientInvitationsResponse) GetInvitations() []*ClientInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RevokeClientInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeClientInvitationRequest) Reset() {
+	*x = RevokeClientInvitationRequest{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeClientInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeClientInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeClientInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeClientInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeClientInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeClientInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeClientInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeClientInvitationResponse) Reset() {
+	*x = RevokeClientInvitationResponse{}
+	mi := &file_proto_client_invitation_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeClientInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeClientInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeClientInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_client_invitation_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeClientInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeClientInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_client_invitation_service_proto_rawDescGZIP(), []int{6}
+}
+
+var File_proto_client_invitation_service_proto protoreflect.FileDescriptor
+
+const file_proto_client_invitation_service_proto_rawDesc = "" +
+	"\n" +
+	"%proto/client_invitation_service.proto\x12\aauth.v1\"\x8c\x02\n" +
+	"\x10ClientInvitation\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\"\n" +
+	"\rpre_link_care\x18\x03 \x01(\bR\vpreLinkCare\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vaccepted_at\x18\x06 \x01(\x03R\n" +
+	"acceptedAt\x12\x1b\n" +
+	"\tclient_id\x18\a \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\x03R\trevokedAt\"x\n" +
+	"\x1dCreateClientInvitationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x12\"\n" +
+	"\rpre_link_care\x18\x03 \x01(\bR\vpreLinkCare\"a\n" +
+	"\x1eCreateClientInvitationResponse\x129\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x19.auth.v1.ClientInvitationR\n" +
+	"invitationJ\x04\b\x02\x10\x03\"\x1e\n" +
+	"\x1cListClientInvitationsRequest\"\\\n" +
+	"\x1dListClientInvitationsResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.auth.v1.ClientInvitationR\vinvitations\"D\n" +
+	"\x1dRevokeClientInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\" \n" +
+	"\x1eRevokeClientInvitationResponse2\xd7\x02\n" +
+	"\x17ClientInvitationService\x12i\n" +
+	"\x16CreateClientInvitation\x12&.auth.v1.CreateClientInvitationRequest\x1a'.auth.v1.CreateClientInvitationResponse\x12f\n" +
+	"\x15ListClientInvitations\x12%.auth.v1.ListClientInvitationsRequest\x1a&.auth.v1.ListClientInvitationsResponse\x12i\n" +
+	"\x16RevokeClientInvitation\x12&.auth.v1.RevokeClientInvitationRequest\x1a'.auth.v1.RevokeClientInvitationResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_client_invitation_service_proto_rawDescOnce sync.Once
+	file_proto_client_invitation_service_proto_rawDescData []byte
+)
+
+func file_proto_client_invitation_service_proto_rawDescGZIP() []byte {
+	file_proto_client_invitation_service_proto_rawDescOnce.Do(func() {
+		file_proto_client_invitation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_client_invitation_service_proto_rawDesc), len(file_proto_client_invitation_service_proto_rawDesc)))
+	})
+	return file_proto_client_invitation_service_proto_rawDescData
+}
+
+var file_proto_client_invitation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_client_invitation_service_proto_goTypes = []any{
+	(*ClientInvitation)(nil),               // 0: auth.v1.ClientInvitation
+	(*CreateClientInvitationRequest)(nil),  // 1: auth.v1.CreateClientInvitationRequest
+	(*CreateClientInvitationResponse)(nil), // 2: auth.v1.CreateClientInvitationResponse
+	(*ListClientInvitationsRequest)(nil),   // 3: auth.v1.ListClientInvitationsRequest
+	(*ListClientInvitationsResponse)(nil),  // 4: auth.v1.ListClientInvitationsResponse
+	(*RevokeClientInvitationRequest)(nil),  // 5: auth.v1.RevokeClientInvitationRequest
+	(*RevokeClientInvitationResponse)(nil), // 6: auth.v1.RevokeClientInvitationResponse
+}
+var file_proto_client_invitation_service_proto_depIdxs = []int32{
+	0, // 0: auth.v1.CreateClientInvitationResponse.invitation:type_name -> auth.v1.ClientInvitation
+	0, // 1: auth.v1.ListClientInvitationsResponse.invitations:type_name -> auth.v1.ClientInvitation
+	1, // 2: auth.v1.ClientInvitationService.CreateClientInvitation:input_type -> auth.v1.CreateClientInvitationRequest
+	3, // 3: auth.v1.ClientInvitationService.ListClientInvitations:input_type -> auth.v1.ListClientInvitationsRequest
+	5, // 4: auth.v1.ClientInvitationService.RevokeClientInvitation:input_type -> auth.v1.RevokeClientInvitationRequest
+	2, // 5: auth.v1.ClientInvitationService.CreateClientInvitation:output_type -> auth.v1.CreateClientInvitationResponse
+	4, // 6: auth.v1.ClientInvitationService.ListClientInvitations:output_type -> auth.v1.ListClientInvitationsResponse
+	6, // 7: auth.v1.ClientInvitationService.RevokeClientInvitation:output_type -> auth.v1.RevokeClientInvitationResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_client_invitation_service_proto_init() }
+func file_proto_client_invitation_service_proto_init() {
+	if File_proto_client_invitation_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_client_invitation_service_proto_rawDesc), len(file_proto_client_invitation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_client_invitation_service_proto_goTypes,
+		DependencyIndexes: file_proto_client_invitation_service_proto_depIdxs,
+		MessageInfos:      file_proto_client_invitation_service_proto_msgTypes,
+	}.Build()
+	File_proto_client_invitation_service_proto = out.File
+	file_proto_client_invitation_service_proto_goTypes = nil
+	file_proto_client_invitation_service_proto_depIdxs = nil
+}
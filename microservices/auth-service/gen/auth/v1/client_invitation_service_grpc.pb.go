@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/client_invitation_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClientInvitationService_CreateClientInvitation_FullMethodName = "/auth.v1.ClientInvitationService/CreateClientInvitation"
+	ClientInvitationService_ListClientInvitations_FullMethodName  = "/auth.v1.ClientInvitationService/ListClientInvitations"
+	ClientInvitationService_RevokeClientInvitation_FullMethodName = "/auth.v1.ClientInvitationService/RevokeClientInvitation"
+)
+
+// ClientInvitationServiceClient is the client API for ClientInvitationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Undangan klien oleh psikolog terverifikasi (membutuhkan access token psikolog).
+// Klien mendaftar lewat AuthService.Register dengan invitation_token dari email undangan.
+type ClientInvitationServiceClient interface {
+	CreateClientInvitation(ctx context.Context, in *CreateClientInvitationRequest, opts ...grpc.CallOption) (*CreateClientInvitationResponse, error)
+	ListClientInvitations(ctx context.Context, in *ListClientInvitationsRequest, opts ...grpc.CallOption) (*ListClientInvitationsResponse, error)
+	// Hanya undangan yang belum dipakai
+	RevokeClientInvitation(ctx context.Context, in *RevokeClientInvitationRequest, opts ...grpc.CallOption) (*RevokeClientInvitationResponse, error)
+}
+
+type clientInvitationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClientInvitationServiceClient(cc grpc.ClientConnInterface) ClientInvitationServiceClient {
+	return &clientInvitationServiceClient{cc}
+}
+
+func (c *clientInvitationServiceClient) CreateClientInvitation(ctx context.Context, in *CreateClientInvitationRequest, opts ...grpc.CallOption) (*CreateClientInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClientInvitationResponse)
+	err := c.cc.Invoke(ctx, ClientInvitationService_CreateClientInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientInvitationServiceClient) ListClientInvitations(ctx context.Context, in *ListClientInvitationsRequest, opts ...grpc.CallOption) (*ListClientInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientInvitationsResponse)
+	err := c.cc.Invoke(ctx, ClientInvitationService_ListClientInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientInvitationServiceClient) RevokeClientInvitation(ctx context.Context, in *RevokeClientInvitationRequest, opts ...grpc.CallOption) (*RevokeClientInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeClientInvitationResponse)
+	err := c.cc.Invoke(ctx, ClientInvitationService_RevokeClientInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClientInvitationServiceServer is the server API for ClientInvitationService service.
+// All implementations must embed UnimplementedClientInvitationServiceServer
+// for forward compatibility.
+//
+// Undangan klien oleh psikolog terverifikasi (membutuhkan access token psikolog).
+// Klien mendaftar lewat AuthService.Register dengan invitation_token dari email undangan.
+type ClientInvitationServiceServer interface {
+	CreateClientInvitation(context.Context, *CreateClientInvitationRequest) (*CreateClientInvitationResponse, error)
+	ListClientInvitations(context.Context, *ListClientInvitationsRequest) (*ListClientInvitationsResponse, error)
+	// Hanya undangan yang belum dipakai
+	RevokeClientInvitation(context.Context, *RevokeClientInvitationRequest) (*RevokeClientInvitationResponse, error)
+	mustEmbedUnimplementedClientInvitationServiceServer()
+}
+
+// UnimplementedClientInvitationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClientInvitationServiceServer struct{}
+
+func (UnimplementedClientInvitationServiceServer) CreateClientInvitation(context.Context, *CreateClientInvitationRequest) (*CreateClientInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientInvitation not implemented")
+}
+func (UnimplementedClientInvitationServiceServer) ListClientInvitations(context.Context, *ListClientInvitationsRequest) (*ListClientInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientInvitations not implemented")
+}
+func (UnimplementedClientInvitationServiceServer) RevokeClientInvitation(context.Context, *RevokeClientInvitationRequest) (*RevokeClientInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeClientInvitation not implemented")
+}
+func (UnimplementedClientInvitationServiceServer) mustEmbedUnimplementedClientInvitationServiceServer() {
+}
+func (UnimplementedClientInvitationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeClientInvitationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClientInvitationServiceServer will
+// result in compilation errors.
+type UnsafeClientInvitationServiceServer interface {
+	mustEmbedUnimplementedClientInvitationServiceServer()
+}
+
+func RegisterClientInvitationServiceServer(s grpc.ServiceRegistrar, srv ClientInvitationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedClientInvitationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClientInvitationService_ServiceDesc, srv)
+}
+
+func _ClientInvitationService_CreateClientInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientInvitationServiceServer).CreateClientInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientInvitationService_CreateClientInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientInvitationServiceServer).CreateClientInvitation(ctx, req.(*CreateClientInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientInvitationService_ListClientInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientInvitationServiceServer).ListClientInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientInvitationService_ListClientInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientInvitationServiceServer).ListClientInvitations(ctx, req.(*ListClientInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientInvitationService_RevokeClientInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeClientInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientInvitationServiceServer).RevokeClientInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientInvitationService_RevokeClientInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientInvitationServiceServer).RevokeClientInvitation(ctx, req.(*RevokeClientInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClientInvitationService_ServiceDesc is the grpc.ServiceDesc for ClientInvitationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClientInvitationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.ClientInvitationService",
+	HandlerType: (*ClientInvitationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClientInvitation",
+			Handler:    _ClientInvitationService_CreateClientInvitation_Handler,
+		},
+		{
+			MethodName: "ListClientInvitations",
+			Handler:    _ClientInvitationService_ListClientInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeClientInvitation",
+			Handler:    _ClientInvitationService_RevokeClientInvitation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/client_invitation_service.proto",
+}
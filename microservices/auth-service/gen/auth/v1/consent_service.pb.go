@@ -0,0 +1,762 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/consent_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConsentDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "terms", "privacy_policy", "clinical_data_processing"
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Roles         []string               `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`                                 // kosong berarti berlaku untuk semua role
+	PublishedAt   int64                  `protobuf:"varint,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsentDocument) Reset() {
+	*x = ConsentDocument{}
+	mi := &file_proto_consent_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsentDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentDocument) ProtoMessage() {}
+
+func (x *ConsentDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentDocument.ProtoReflect.Descriptor instead.
+func (*ConsentDocument) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsentDocument) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ConsentDocument) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ConsentDocument) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ConsentDocument) GetPublishedAt() int64 {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return 0
+}
+
+type ConsentRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsentId     string                 `protobuf:"bytes,1,opt,name=consent_id,json=consentId,proto3" json:"consent_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	AcceptedAt    int64                  `protobuf:"varint,4,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"` // unix seconds
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	WithdrawnAt   int64                  `protobuf:"varint,7,opt,name=withdrawn_at,json=withdrawnAt,proto3" json:"withdrawn_at,omitempty"` // unix seconds, 0 jika masih berlaku
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsentRecord) Reset() {
+	*x = ConsentRecord{}
+	mi := &file_proto_consent_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsentRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentRecord) ProtoMessage() {}
+
+func (x *ConsentRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentRecord.ProtoReflect.Descriptor instead.
+func (*ConsentRecord) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConsentRecord) GetConsentId() string {
+	if x != nil {
+		return x.ConsentId
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *ConsentRecord) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetWithdrawnAt() int64 {
+	if x != nil {
+		return x.WithdrawnAt
+	}
+	return 0
+}
+
+type ListConsentDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsentDocumentsRequest) Reset() {
+	*x = ListConsentDocumentsRequest{}
+	mi := &file_proto_consent_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsentDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsentDocumentsRequest) ProtoMessage() {}
+
+func (x *ListConsentDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsentDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListConsentDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ListConsentDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*ConsentDocument     `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsentDocumentsResponse) Reset() {
+	*x = ListConsentDocumentsResponse{}
+	mi := &file_proto_consent_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsentDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsentDocumentsResponse) ProtoMessage() {}
+
+func (x *ListConsentDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsentDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsentDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListConsentDocumentsResponse) GetDocuments() []*ConsentDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type RecordConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                               // harus versi terbaru
+	ConsentToken  string                 `protobuf:"bytes,3,opt,name=consent_token,json=consentToken,proto3" json:"consent_token,omitempty"` // hanya jika belum punya access token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordConsentRequest) Reset() {
+	*x = RecordConsentRequest{}
+	mi := &file_proto_consent_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordConsentRequest) ProtoMessage() {}
+
+func (x *RecordConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConsentRequest.ProtoReflect.Descriptor instead.
+func (*RecordConsentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordConsentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RecordConsentRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RecordConsentRequest) GetConsentToken() string {
+	if x != nil {
+		return x.ConsentToken
+	}
+	return ""
+}
+
+type RecordConsentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consent       *ConsentRecord         `protobuf:"bytes,1,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordConsentResponse) Reset() {
+	*x = RecordConsentResponse{}
+	mi := &file_proto_consent_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordConsentResponse) ProtoMessage() {}
+
+func (x *RecordConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConsentResponse.ProtoReflect.Descriptor instead.
+func (*RecordConsentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecordConsentResponse) GetConsent() *ConsentRecord {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+type ListConsentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsentsRequest) Reset() {
+	*x = ListConsentsRequest{}
+	mi := &file_proto_consent_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsentsRequest) ProtoMessage() {}
+
+func (x *ListConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsentsRequest.ProtoReflect.Descriptor instead.
+func (*ListConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ListConsentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consents      []*ConsentRecord       `protobuf:"bytes,1,rep,name=consents,proto3" json:"consents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConsentsResponse) Reset() {
+	*x = ListConsentsResponse{}
+	mi := &file_proto_consent_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConsentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConsentsResponse) ProtoMessage() {}
+
+func (x *ListConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConsentsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListConsentsResponse) GetConsents() []*ConsentRecord {
+	if x != nil {
+		return x.Consents
+	}
+	return nil
+}
+
+type WithdrawConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawConsentRequest) Reset() {
+	*x = WithdrawConsentRequest{}
+	mi := &file_proto_consent_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawConsentRequest) ProtoMessage() {}
+
+func (x *WithdrawConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawConsentRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawConsentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WithdrawConsentRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type WithdrawConsentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawConsentResponse) Reset() {
+	*x = WithdrawConsentResponse{}
+	mi := &file_proto_consent_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawConsentResponse) ProtoMessage() {}
+
+func (x *WithdrawConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawConsentResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawConsentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{9}
+}
+
+type PublishConsentDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ConsentDocument       `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"` // published_at diabaikan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishConsentDocumentRequest) Reset() {
+	*x = PublishConsentDocumentRequest{}
+	mi := &file_proto_consent_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConsentDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConsentDocumentRequest) ProtoMessage() {}
+
+func (x *PublishConsentDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConsentDocumentRequest.ProtoReflect.Descriptor instead.
+func (*PublishConsentDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PublishConsentDocumentRequest) GetDocument() *ConsentDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type PublishConsentDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ConsentDocument       `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishConsentDocumentResponse) Reset() {
+	*x = PublishConsentDocumentResponse{}
+	mi := &file_proto_consent_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConsentDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConsentDocumentResponse) ProtoMessage() {}
+
+func (x *PublishConsentDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_consent_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConsentDocumentResponse.ProtoReflect.Descriptor instead.
+func (*PublishConsentDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_consent_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PublishConsentDocumentResponse) GetDocument() *ConsentDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+var File_proto_consent_service_proto protoreflect.FileDescriptor
+
+const file_proto_consent_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/consent_service.proto\x12\aauth.v1\"\xbc\x01\n" +
+	"\x0fConsentDocument\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x14\n" +
+	"\x05roles\x18\x06 \x03(\tR\x05roles\x12!\n" +
+	"\fpublished_at\x18\a \x01(\x03R\vpublishedAt\"\xcf\x01\n" +
+	"\rConsentRecord\x12\x1d\n" +
+	"\n" +
+	"consent_id\x18\x01 \x01(\tR\tconsentId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1f\n" +
+	"\vaccepted_at\x18\x04 \x01(\x03R\n" +
+	"acceptedAt\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\x12!\n" +
+	"\fwithdrawn_at\x18\a \x01(\x03R\vwithdrawnAt\"\x1d\n" +
+	"\x1bListConsentDocumentsRequest\"V\n" +
+	"\x1cListConsentDocumentsResponse\x126\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x18.auth.v1.ConsentDocumentR\tdocuments\"i\n" +
+	"\x14RecordConsentRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12#\n" +
+	"\rconsent_token\x18\x03 \x01(\tR\fconsentToken\"I\n" +
+	"\x15RecordConsentResponse\x120\n" +
+	"\aconsent\x18\x01 \x01(\v2\x16.auth.v1.ConsentRecordR\aconsent\"\x15\n" +
+	"\x13ListConsentsRequest\"J\n" +
+	"\x14ListConsentsResponse\x122\n" +
+	"\bconsents\x18\x01 \x03(\v2\x16.auth.v1.ConsentRecordR\bconsents\",\n" +
+	"\x16WithdrawConsentRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\"\x19\n" +
+	"\x17WithdrawConsentResponse\"U\n" +
+	"\x1dPublishConsentDocumentRequest\x124\n" +
+	"\bdocument\x18\x01 \x01(\v2\x18.auth.v1.ConsentDocumentR\bdocument\"V\n" +
+	"\x1ePublishConsentDocumentResponse\x124\n" +
+	"\bdocument\x18\x01 \x01(\v2\x18.auth.v1.ConsentDocumentR\bdocument2\xd3\x03\n" +
+	"\x0eConsentService\x12c\n" +
+	"\x14ListConsentDocuments\x12$.auth.v1.ListConsentDocumentsRequest\x1a%.auth.v1.ListConsentDocumentsResponse\x12N\n" +
+	"\rRecordConsent\x12\x1d.auth.v1.RecordConsentRequest\x1a\x1e.auth.v1.RecordConsentResponse\x12K\n" +
+	"\fListConsents\x12\x1c.auth.v1.ListConsentsRequest\x1a\x1d.auth.v1.ListConsentsResponse\x12T\n" +
+	"\x0fWithdrawConsent\x12\x1f.auth.v1.WithdrawConsentRequest\x1a .auth.v1.WithdrawConsentResponse\x12i\n" +
+	"\x16PublishConsentDocument\x12&.auth.v1.PublishConsentDocumentRequest\x1a'.auth.v1.PublishConsentDocumentResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_consent_service_proto_rawDescOnce sync.Once
+	file_proto_consent_service_proto_rawDescData []byte
+)
+
+func file_proto_consent_service_proto_rawDescGZIP() []byte {
+	file_proto_consent_service_proto_rawDescOnce.Do(func() {
+		file_proto_consent_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_consent_service_proto_rawDesc), len(file_proto_consent_service_proto_rawDesc)))
+	})
+	return file_proto_consent_service_proto_rawDescData
+}
+
+var file_proto_consent_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_consent_service_proto_goTypes = []any{
+	(*ConsentDocument)(nil),                // 0: auth.v1.ConsentDocument
+	(*ConsentRecord)(nil),                  // 1: auth.v1.ConsentRecord
+	(*ListConsentDocumentsRequest)(nil),    // 2: auth.v1.ListConsentDocumentsRequest
+	(*ListConsentDocumentsResponse)(nil),   // 3: auth.v1.ListConsentDocumentsResponse
+	(*RecordConsentRequest)(nil),           // 4: auth.v1.RecordConsentRequest
+	(*RecordConsentResponse)(nil),          // 5: auth.v1.RecordConsentResponse
+	(*ListConsentsRequest)(nil),            // 6: auth.v1.ListConsentsRequest
+	(*ListConsentsResponse)(nil),           // 7: auth.v1.ListConsentsResponse
+	(*WithdrawConsentRequest)(nil),         // 8: auth.v1.WithdrawConsentRequest
+	(*WithdrawConsentResponse)(nil),        // 9: auth.v1.WithdrawConsentResponse
+	(*PublishConsentDocumentRequest)(nil),  // 10: auth.v1.PublishConsentDocumentRequest
+	(*PublishConsentDocumentResponse)(nil), // 11: auth.v1.PublishConsentDocumentResponse
+}
+var file_proto_consent_service_proto_depIdxs = []int32{
+	0,  // 0: auth.v1.ListConsentDocumentsResponse.documents:type_name -> auth.v1.ConsentDocument
+	1,  // 1: auth.v1.RecordConsentResponse.consent:type_name -> auth.v1.ConsentRecord
+	1,  // 2: auth.v1.ListConsentsResponse.consents:type_name -> auth.v1.ConsentRecord
+	0,  // 3: auth.v1.PublishConsentDocumentRequest.document:type_name -> auth.v1.ConsentDocument
+	0,  // 4: auth.v1.PublishConsentDocumentResponse.document:type_name -> auth.v1.ConsentDocument
+	2,  // 5: auth.v1.ConsentService.ListConsentDocuments:input_type -> auth.v1.ListConsentDocumentsRequest
+	4,  // 6: auth.v1.ConsentService.RecordConsent:input_type -> auth.v1.RecordConsentRequest
+	6,  // 7: auth.v1.ConsentService.ListConsents:input_type -> auth.v1.ListConsentsRequest
+	8,  // 8: auth.v1.ConsentService.WithdrawConsent:input_type -> auth.v1.WithdrawConsentRequest
+	10, // 9: auth.v1.ConsentService.PublishConsentDocument:input_type -> auth.v1.PublishConsentDocumentRequest
+	3,  // 10: auth.v1.ConsentService.ListConsentDocuments:output_type -> auth.v1.ListConsentDocumentsResponse
+	5,  // 11: auth.v1.ConsentService.RecordConsent:output_type -> auth.v1.RecordConsentResponse
+	7,  // 12: auth.v1.ConsentService.ListConsents:output_type -> auth.v1.ListConsentsResponse
+	9,  // 13: auth.v1.ConsentService.WithdrawConsent:output_type -> auth.v1.WithdrawConsentResponse
+	11, // 14: auth.v1.ConsentService.PublishConsentDocument:output_type -> auth.v1.PublishConsentDocumentResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_consent_service_proto_init() }
+func file_proto_consent_service_proto_init() {
+	if File_proto_consent_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_consent_service_proto_rawDesc), len(file_proto_consent_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_consent_service_proto_goTypes,
+		DependencyIndexes: file_proto_consent_service_proto_depIdxs,
+		MessageInfos:      file_proto_consent_service_proto_msgTypes,
+	}.Build()
+	File_proto_consent_service_proto = out.File
+	file_proto_consent_service_proto_goTypes = nil
+	file_proto_consent_service_proto_depIdxs = nil
+}
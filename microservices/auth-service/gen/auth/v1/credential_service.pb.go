@@ -0,0 +1,774 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/credential_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PsychologistCredential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LicenseNumber string                 `protobuf:"bytes,3,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Jurisdiction  string                 `protobuf:"bytes,4,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	DocumentRefs  []string               `protobuf:"bytes,5,rep,name=document_refs,json=documentRefs,proto3" json:"document_refs,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // pending, verified, rejected, expired
+	ReviewedBy    string                 `protobuf:"bytes,7,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewNote    string                 `protobuf:"bytes,8,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PsychologistCredential) Reset() {
+	*x = PsychologistCredential{}
+	mi := &file_proto_credential_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PsychologistCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsychologistCredential) ProtoMessage() {}
+
+func (x *PsychologistCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsychologistCredential.ProtoReflect.Descriptor instead.
+func (*PsychologistCredential) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PsychologistCredential) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetDocumentRefs() []string {
+	if x != nil {
+		return x.DocumentRefs
+	}
+	return nil
+}
+
+func (x *PsychologistCredential) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *PsychologistCredential) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *PsychologistCredential) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *PsychologistCredential) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SubmitCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LicenseNumber string                 `protobuf:"bytes,1,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Jurisdiction  string                 `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	DocumentRefs  []string               `protobuf:"bytes,3,rep,name=document_refs,json=documentRefs,proto3" json:"document_refs,omitempty"` // referensi file yang sudah diunggah ke storage
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitCredentialRequest) Reset() {
+	*x = SubmitCredentialRequest{}
+	mi := &file_proto_credential_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCredentialRequest) ProtoMessage() {}
+
+func (x *SubmitCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCredentialRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitCredentialRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *SubmitCredentialRequest) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *SubmitCredentialRequest) GetDocumentRefs() []string {
+	if x != nil {
+		return x.DocumentRefs
+	}
+	return nil
+}
+
+type SubmitCredentialResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Credential    *PsychologistCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitCredentialResponse) Reset() {
+	*x = SubmitCredentialResponse{}
+	mi := &file_proto_credential_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCredentialResponse) ProtoMessage() {}
+
+func (x *SubmitCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCredentialResponse.ProtoReflect.Descriptor instead.
+func (*SubmitCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitCredentialResponse) GetCredential() *PsychologistCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+type ListMyCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCredentialsRequest) Reset() {
+	*x = ListMyCredentialsRequest{}
+	mi := &file_proto_credential_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCredentialsRequest) ProtoMessage() {}
+
+func (x *ListMyCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ListMyCredentialsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Credentials   []*PsychologistCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCredentialsResponse) Reset() {
+	*x = ListMyCredentialsResponse{}
+	mi := &file_proto_credential_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCredentialsResponse) ProtoMessage() {}
+
+func (x *ListMyCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMyCredentialsResponse) GetCredentials() []*PsychologistCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type ListCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // default pending
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCredentialsRequest) Reset() {
+	*x = ListCredentialsRequest{}
+	mi := &file_proto_credential_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCredentialsRequest) ProtoMessage() {}
+
+func (x *ListCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCredentialsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListCredentialsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCredentialsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCredentialsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Credentials   []*PsychologistCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCredentialsResponse) Reset() {
+	*x = ListCredentialsResponse{}
+	mi := &file_proto_credential_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCredentialsResponse) ProtoMessage() {}
+
+func (x *ListCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCredentialsResponse) GetCredentials() []*PsychologistCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type ApproveCredentialRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CredentialId     string                 `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	LicenseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=license_expires_at,json=licenseExpiresAt,proto3" json:"license_expires_at,omitempty"` // tanggal habis lisensi
+	Note             string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApproveCredentialRequest) Reset() {
+	*x = ApproveCredentialRequest{}
+	mi := &file_proto_credential_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCredentialRequest) ProtoMessage() {}
+
+func (x *ApproveCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCredentialRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApproveCredentialRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *ApproveCredentialRequest) GetLicenseExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LicenseExpiresAt
+	}
+	return nil
+}
+
+func (x *ApproveCredentialRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ApproveCredentialResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Credential    *PsychologistCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCredentialResponse) Reset() {
+	*x = ApproveCredentialResponse{}
+	mi := &file_proto_credential_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCredentialResponse) ProtoMessage() {}
+
+func (x *ApproveCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCredentialResponse.ProtoReflect.Descriptor instead.
+func (*ApproveCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApproveCredentialResponse) GetCredential() *PsychologistCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+type RejectCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CredentialId  string                 `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCredentialRequest) Reset() {
+	*x = RejectCredentialRequest{}
+	mi := &file_proto_credential_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCredentialRequest) ProtoMessage() {}
+
+func (x *RejectCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCredentialRequest.ProtoReflect.Descriptor instead.
+func (*RejectCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectCredentialRequest) GetCredentialId() string {
+	if x != nil {
+		return x.CredentialId
+	}
+	return ""
+}
+
+func (x *RejectCredentialRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RejectCredentialResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Credential    *PsychologistCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectCredentialResponse) Reset() {
+	*x = RejectCredentialResponse{}
+	mi := &file_proto_credential_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCredentialResponse) ProtoMessage() {}
+
+func (x *RejectCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_credential_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCredentialResponse.ProtoReflect.Descriptor instead.
+func (*RejectCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_credential_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RejectCredentialResponse) GetCredential() *PsychologistCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+var File_proto_credential_service_proto protoreflect.FileDescriptor
+
+const file_proto_credential_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1eproto/credential_service.proto\x12\aauth.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x03\n" +
+	"\x16PsychologistCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\x0elicense_number\x18\x03 \x01(\tR\rlicenseNumber\x12\"\n" +
+	"\fjurisdiction\x18\x04 \x01(\tR\fjurisdiction\x12#\n" +
+	"\rdocument_refs\x18\x05 \x03(\tR\fdocumentRefs\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\a \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreview_note\x18\b \x01(\tR\n" +
+	"reviewNote\x12=\n" +
+	"\fsubmitted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12;\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x89\x01\n" +
+	"\x17SubmitCredentialRequest\x12%\n" +
+	"\x0elicense_number\x18\x01 \x01(\tR\rlicenseNumber\x12\"\n" +
+	"\fjurisdiction\x18\x02 \x01(\tR\fjurisdiction\x12#\n" +
+	"\rdocument_refs\x18\x03 \x03(\tR\fdocumentRefs\"[\n" +
+	"\x18SubmitCredentialResponse\x12?\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1f.auth.v1.PsychologistCredentialR\n" +
+	"credential\"\x1a\n" +
+	"\x18ListMyCredentialsRequest\"^\n" +
+	"\x19ListMyCredentialsResponse\x12A\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x1f.auth.v1.PsychologistCredentialR\vcredentials\"e\n" +
+	"\x16ListCredentialsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\\\n" +
+	"\x17ListCredentialsResponse\x12A\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x1f.auth.v1.PsychologistCredentialR\vcredentials\"\x9d\x01\n" +
+	"\x18ApproveCredentialRequest\x12#\n" +
+	"\rcredential_id\x18\x01 \x01(\tR\fcredentialId\x12H\n" +
+	"\x12license_expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10licenseExpiresAt\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\\\n" +
+	"\x19ApproveCredentialResponse\x12?\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1f.auth.v1.PsychologistCredentialR\n" +
+	"credential\"R\n" +
+	"\x17RejectCredentialRequest\x12#\n" +
+	"\rcredential_id\x18\x01 \x01(\tR\fcredentialId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"[\n" +
+	"\x18RejectCredentialResponse\x12?\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1f.auth.v1.PsychologistCredentialR\n" +
+	"credential2\xd3\x03\n" +
+	"\x11CredentialService\x12W\n" +
+	"\x10SubmitCredential\x12 .auth.v1.SubmitCredentialRequest\x1a!.auth.v1.SubmitCredentialResponse\x12Z\n" +
+	"\x11ListMyCredentials\x12!.auth.v1.ListMyCredentialsRequest\x1a\".auth.v1.ListMyCredentialsResponse\x12T\n" +
+	"\x0fListCredentials\x12\x1f.auth.v1.ListCredentialsRequest\x1a .auth.v1.ListCredentialsResponse\x12Z\n" +
+	"\x11ApproveCredential\x12!.auth.v1.ApproveCredentialRequest\x1a\".auth.v1.ApproveCredentialResponse\x12W\n" +
+	"\x10RejectCredential\x12 .auth.v1.RejectCredentialRequest\x1a!.auth.v1.RejectCredentialResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_credential_service_proto_rawDescOnce sync.Once
+	file_proto_credential_service_proto_rawDescData []byte
+)
+
+func file_proto_credential_service_proto_rawDescGZIP() []byte {
+	file_proto_credential_service_proto_rawDescOnce.Do(func() {
+		file_proto_credential_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_credential_service_proto_rawDesc), len(file_proto_credential_service_proto_rawDesc)))
+	})
+	return file_proto_credential_service_proto_rawDescData
+}
+
+var file_proto_credential_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_credential_service_proto_goTypes = []any{
+	(*PsychologistCredential)(nil),    // 0: auth.v1.PsychologistCredential
+	(*SubmitCredentialRequest)(nil),   // 1: auth.v1.SubmitCredentialRequest
+	(*SubmitCredentialResponse)(nil),  // 2: auth.v1.SubmitCredentialResponse
+	(*ListMyCredentialsRequest)(nil),  // 3: auth.v1.ListMyCredentialsRequest
+	(*ListMyCredentialsResponse)(nil), // 4: auth.v1.ListMyCredentialsResponse
+	(*ListCredentialsRequest)(nil),    // 5: auth.v1.ListCredentialsRequest
+	(*ListCredentialsResponse)(nil),   // 6: auth.v1.ListCredentialsResponse
+	(*ApproveCredentialRequest)(nil),  // 7: auth.v1.ApproveCredentialRequest
+	(*ApproveCredentialResponse)(nil), // 8: auth.v1.ApproveCredentialResponse
+	(*RejectCredentialRequest)(nil),   // 9: auth.v1.RejectCredentialRequest
+	(*RejectCredentialResponse)(nil),  // 10: auth.v1.RejectCredentialResponse
+	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+}
+var file_proto_credential_service_proto_depIdxs = []int32{
+	11, // 0: auth.v1.PsychologistCredential.submitted_at:type_name -> google.protobuf.Timestamp
+	11, // 1: auth.v1.PsychologistCredential.reviewed_at:type_name -> google.protobuf.Timestamp
+	11, // 2: auth.v1.PsychologistCredential.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: auth.v1.SubmitCredentialResponse.credential:type_name -> auth.v1.PsychologistCredential
+	0,  // 4: auth.v1.ListMyCredentialsResponse.credentials:type_name -> auth.v1.PsychologistCredential
+	0,  // 5: auth.v1.ListCredentialsResponse.credentials:type_name -> auth.v1.PsychologistCredential
+	11, // 6: auth.v1.ApproveCredentialRequest.license_expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: auth.v1.ApproveCredentialResponse.credential:type_name -> auth.v1.PsychologistCredential
+	0,  // 8: auth.v1.RejectCredentialResponse.credential:type_name -> auth.v1.PsychologistCredential
+	1,  // 9: auth.v1.CredentialService.SubmitCredential:input_type -> auth.v1.SubmitCredentialRequest
+	3,  // 10: auth.v1.CredentialService.ListMyCredentials:input_type -> auth.v1.ListMyCredentialsRequest
+	5,  // 11: auth.v1.CredentialService.ListCredentials:input_type -> auth.v1.ListCredentialsRequest
+	7,  // 12: auth.v1.CredentialService.ApproveCredential:input_type -> auth.v1.ApproveCredentialRequest
+	9,  // 13: auth.v1.CredentialService.RejectCredential:input_type -> auth.v1.RejectCredentialRequest
+	2,  // 14: auth.v1.CredentialService.SubmitCredential:output_type -> auth.v1.SubmitCredentialResponse
+	4,  // 15: auth.v1.CredentialService.ListMyCredentials:output_type -> auth.v1.ListMyCredentialsResponse
+	6,  // 16: auth.v1.CredentialService.ListCredentials:output_type -> auth.v1.ListCredentialsResponse
+	8,  // 17: auth.v1.CredentialService.ApproveCredential:output_type -> auth.v1.ApproveCredentialResponse
+	10, // 18: auth.v1.CredentialService.RejectCredential:output_type -> auth.v1.RejectCredentialResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_credential_service_proto_init() }
+func file_proto_credential_service_proto_init() {
+	if File_proto_credential_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_credential_service_proto_rawDesc), len(file_proto_credential_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_credential_service_proto_goTypes,
+		DependencyIndexes: file_proto_credential_service_proto_depIdxs,
+		MessageInfos:      file_proto_credential_service_proto_msgTypes,
+	}.Build()
+	File_proto_credential_service_proto = out.File
+	file_proto_credential_service_proto_goTypes = nil
+	file_proto_credential_service_proto_depIdxs = nil
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/credential_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CredentialService_SubmitCredential_FullMethodName  = "/auth.v1.CredentialService/SubmitCredential"
+	CredentialService_ListMyCredentials_FullMethodName = "/auth.v1.CredentialService/ListMyCredentials"
+	CredentialService_ListCredentials_FullMethodName   = "/auth.v1.CredentialService/ListCredentials"
+	CredentialService_ApproveCredential_FullMethodName = "/auth.v1.CredentialService/ApproveCredential"
+	CredentialService_RejectCredential_FullMethodName  = "/auth.v1.CredentialService/RejectCredential"
+)
+
+// CredentialServiceClient is the client API for CredentialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Verifikasi lisensi psikolog. Psikolog baru mendapat role claim "psychologist"
+// setelah credential-nya disetujui dan selama lisensinya masih berlaku.
+type CredentialServiceClient interface {
+	// Membutuhkan access token milik psikolog itu sendiri
+	SubmitCredential(ctx context.Context, in *SubmitCredentialRequest, opts ...grpc.CallOption) (*SubmitCredentialResponse, error)
+	ListMyCredentials(ctx context.Context, in *ListMyCredentialsRequest, opts ...grpc.CallOption) (*ListMyCredentialsResponse, error)
+	// credentials:review
+	ListCredentials(ctx context.Context, in *ListCredentialsRequest, opts ...grpc.CallOption) (*ListCredentialsResponse, error)
+	ApproveCredential(ctx context.Context, in *ApproveCredentialRequest, opts ...grpc.CallOption) (*ApproveCredentialResponse, error)
+	RejectCredential(ctx context.Context, in *RejectCredentialRequest, opts ...grpc.CallOption) (*RejectCredentialResponse, error)
+}
+
+type credentialServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCredentialServiceClient(cc grpc.ClientConnInterface) CredentialServiceClient {
+	return &credentialServiceClient{cc}
+}
+
+func (c *credentialServiceClient) SubmitCredential(ctx context.Context, in *SubmitCredentialRequest, opts ...grpc.CallOption) (*SubmitCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_SubmitCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) ListMyCredentials(ctx context.Context, in *ListMyCredentialsRequest, opts ...grpc.CallOption) (*ListMyCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyCredentialsResponse)
+	err := c.cc.Invoke(ctx, CredentialService_ListMyCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) ListCredentials(ctx context.Context, in *ListCredentialsRequest, opts ...grpc.CallOption) (*ListCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCredentialsResponse)
+	err := c.cc.Invoke(ctx, CredentialService_ListCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) ApproveCredential(ctx context.Context, in *ApproveCredentialRequest, opts ...grpc.CallOption) (*ApproveCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_ApproveCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) RejectCredential(ctx context.Context, in *RejectCredentialRequest, opts ...grpc.CallOption) (*RejectCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_RejectCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CredentialServiceServer is the server API for CredentialService service.
+// All implementations must embed UnimplementedCredentialServiceServer
+// for forward compatibility.
+//
+// Verifikasi lisensi psikolog. Psikolog baru mendapat role claim "psychologist"
+// setelah credential-nya disetujui dan selama lisensinya masih berlaku.
+type CredentialServiceServer interface {
+	// Membutuhkan access token milik psikolog itu sendiri
+	SubmitCredential(context.Context, *SubmitCredentialRequest) (*SubmitCredentialResponse, error)
+	ListMyCredentials(context.Context, *ListMyCredentialsRequest) (*ListMyCredentialsResponse, error)
+	// credentials:review
+	ListCredentials(context.Context, *ListCredentialsRequest) (*ListCredentialsResponse, error)
+	ApproveCredential(context.Context, *ApproveCredentialRequest) (*ApproveCredentialResponse, error)
+	RejectCredential(context.Context, *RejectCredentialRequest) (*RejectCredentialResponse, error)
+	mustEmbedUnimplementedCredentialServiceServer()
+}
+
+// UnimplementedCredentialServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCredentialServiceServer struct{}
+
+func (UnimplementedCredentialServiceServer) SubmitCredential(context.Context, *SubmitCredentialRequest) (*SubmitCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) ListMyCredentials(context.Context, *ListMyCredentialsRequest) (*ListMyCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCredentials not implemented")
+}
+func (UnimplementedCredentialServiceServer) ListCredentials(context.Context, *ListCredentialsRequest) (*ListCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+}
+func (UnimplementedCredentialServiceServer) ApproveCredential(context.Context, *ApproveCredentialRequest) (*ApproveCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) RejectCredential(context.Context, *RejectCredentialRequest) (*RejectCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) mustEmbedUnimplementedCredentialServiceServer() {}
+func (UnimplementedCredentialServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCredentialServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CredentialServiceServer will
+// result in compilation errors.
+type UnsafeCredentialServiceServer interface {
+	mustEmbedUnimplementedCredentialServiceServer()
+}
+
+func RegisterCredentialServiceServer(s grpc.ServiceRegistrar, srv CredentialServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCredentialServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CredentialService_ServiceDesc, srv)
+}
+
+func _CredentialService_SubmitCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).SubmitCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_SubmitCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).SubmitCredential(ctx, req.(*SubmitCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_ListMyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListMyCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_ListMyCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListMyCredentials(ctx, req.(*ListMyCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_ListCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_ListCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListCredentials(ctx, req.(*ListCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_ApproveCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ApproveCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_ApproveCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ApproveCredential(ctx, req.(*ApproveCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_RejectCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).RejectCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_RejectCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).RejectCredential(ctx, req.(*RejectCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CredentialService_ServiceDesc is the grpc.ServiceDesc for CredentialService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CredentialService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.CredentialService",
+	HandlerType: (*CredentialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitCredential",
+			Handler:    _CredentialService_SubmitCredential_Handler,
+		},
+		{
+			MethodName: "ListMyCredentials",
+			Handler:    _CredentialService_ListMyCredentials_Handler,
+		},
+		{
+			MethodName: "ListCredentials",
+			Handler:    _CredentialService_ListCredentials_Handler,
+		},
+		{
+			MethodName: "ApproveCredential",
+			Handler:    _CredentialService_ApproveCredential_Handler,
+		},
+		{
+			MethodName: "RejectCredential",
+			Handler:    _CredentialService_RejectCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/credential_service.proto",
+}
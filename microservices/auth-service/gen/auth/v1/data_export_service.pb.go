@@ -0,0 +1,638 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/data_export_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DataExportJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                               // "pending", "processing", "completed", "failed", "expired"
+	RequestedAt   int64                  `protobuf:"varint,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // unix seconds
+	CompletedAt   int64                  `protobuf:"varint,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // unix seconds, 0 jika belum selesai
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`       // unix seconds, arsip dihapus setelah waktu ini
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`                               // hex sha256 arsip ZIP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExportJob) Reset() {
+	*x = DataExportJob{}
+	mi := &file_proto_data_export_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportJob) ProtoMessage() {}
+
+func (x *DataExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportJob.ProtoReflect.Descriptor instead.
+func (*DataExportJob) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DataExportJob) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *DataExportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExportJob) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *DataExportJob) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *DataExportJob) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *DataExportJob) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_proto_data_export_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DataExportJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_proto_data_export_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportMyDataResponse) GetJob() *DataExportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_proto_data_export_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *DataExportJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_proto_data_export_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDataExportResponse) GetJob() *DataExportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type DownloadDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	mi := &file_proto_data_export_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportRequest) ProtoMessage() {}
+
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DownloadDataExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type DownloadDataExportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ZIP berisi data.json dan data.json.sig: JSON {"algorithm", "key_id", "signature"} dengan
+	// signature base64 atas byte data.json, diverifikasi dengan key dari ListExportSigningKeys
+	Archive       []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Sha256        string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDataExportResponse) Reset() {
+	*x = DownloadDataExportResponse{}
+	mi := &file_proto_data_export_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportResponse) ProtoMessage() {}
+
+func (x *DownloadDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DownloadDataExportResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *DownloadDataExportResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DownloadDataExportResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ListExportSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportSigningKeysRequest) Reset() {
+	*x = ListExportSigningKeysRequest{}
+	mi := &file_proto_data_export_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportSigningKeysRequest) ProtoMessage() {}
+
+func (x *ListExportSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListExportSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ExportSigningKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                   // "Ed25519"
+	PublicKey     []byte                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`  // raw 32 byte
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSigningKey) Reset() {
+	*x = ExportSigningKey{}
+	mi := &file_proto_data_export_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSigningKey) ProtoMessage() {}
+
+func (x *ExportSigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSigningKey.ProtoReflect.Descriptor instead.
+func (*ExportSigningKey) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportSigningKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ExportSigningKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *ExportSigningKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ExportSigningKey) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListExportSigningKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ExportSigningKey    `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // terbaru lebih dulu
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExportSigningKeysResponse) Reset() {
+	*x = ListExportSigningKeysResponse{}
+	mi := &file_proto_data_export_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExportSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportSigningKeysResponse) ProtoMessage() {}
+
+func (x *ListExportSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_data_export_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListExportSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_data_export_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListExportSigningKeysResponse) GetKeys() []*ExportSigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+var File_proto_data_export_service_proto protoreflect.FileDescriptor
+
+const file_proto_data_export_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproto/data_export_service.proto\x12\aauth.v1\"\xc1\x01\n" +
+	"\rDataExportJob\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12!\n" +
+	"\frequested_at\x18\x03 \x01(\x03R\vrequestedAt\x12!\n" +
+	"\fcompleted_at\x18\x04 \x01(\x03R\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\"\x15\n" +
+	"\x13ExportMyDataRequest\"@\n" +
+	"\x14ExportMyDataResponse\x12(\n" +
+	"\x03job\x18\x01 \x01(\v2\x16.auth.v1.DataExportJobR\x03job\"3\n" +
+	"\x14GetDataExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\"A\n" +
+	"\x15GetDataExportResponse\x12(\n" +
+	"\x03job\x18\x01 \x01(\v2\x16.auth.v1.DataExportJobR\x03job\"8\n" +
+	"\x19DownloadDataExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\"j\n" +
+	"\x1aDownloadDataExportResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\"\x1e\n" +
+	"\x1cListExportSigningKeysRequest\"\x85\x01\n" +
+	"\x10ExportSigningKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"N\n" +
+	"\x1dListExportSigningKeysResponse\x12-\n" +
+	"\x04keys\x18\x01 \x03(\v2\x19.auth.v1.ExportSigningKeyR\x04keys2\xf7\x02\n" +
+	"\x11DataExportService\x12K\n" +
+	"\fExportMyData\x12\x1c.auth.v1.ExportMyDataRequest\x1a\x1d.auth.v1.ExportMyDataResponse\x12N\n" +
+	"\rGetDataExport\x12\x1d.auth.v1.GetDataExportRequest\x1a\x1e.auth.v1.GetDataExportResponse\x12]\n" +
+	"\x12DownloadDataExport\x12\".auth.v1.DownloadDataExportRequest\x1a#.auth.v1.DownloadDataExportResponse\x12f\n" +
+	"\x15ListExportSigningKeys\x12%.auth.v1.ListExportSigningKeysRequest\x1a&.auth.v1.ListExportSigningKeysResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_data_export_service_proto_rawDescOnce sync.Once
+	file_proto_data_export_service_proto_rawDescData []byte
+)
+
+func file_proto_data_export_service_proto_rawDescGZIP() []byte {
+	file_proto_data_export_service_proto_rawDescOnce.Do(func() {
+		file_proto_data_export_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_data_export_service_proto_rawDesc), len(file_proto_data_export_service_proto_rawDesc)))
+	})
+	return file_proto_data_export_service_proto_rawDescData
+}
+
+var file_proto_data_export_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_data_export_service_proto_goTypes = []any{
+	(*DataExportJob)(nil),                 // 0: auth.v1.DataExportJob
+	(*ExportMyDataRequest)(nil),           // 1: auth.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),          // 2: auth.v1.ExportMyDataResponse
+	(*GetDataExportRequest)(nil),          // 3: auth.v1.GetDataExportRequest
+	(*GetDataExportResponse)(nil),         // 4: auth.v1.GetDataExportResponse
+	(*DownloadDataExportRequest)(nil),     // 5: auth.v1.DownloadDataExportRequest
+	(*DownloadDataExportResponse)(nil),    // 6: auth.v1.DownloadDataExportResponse
+	(*ListExportSigningKeysRequest)(nil),  // 7: auth.v1.ListExportSigningKeysRequest
+	(*ExportSigningKey)(nil),              // 8: auth.v1.ExportSigningKey
+	(*ListExportSigningKeysResponse)(nil), // 9: auth.v1.ListExportSigningKeysResponse
+}
+var file_proto_data_export_service_proto_depIdxs = []int32{
+	0, // 0: auth.v1.ExportMyDataResponse.job:type_name -> auth.v1.DataExportJob
+	0, // 1: auth.v1.GetDataExportResponse.job:type_name -> auth.v1.DataExportJob
+	8, // 2: auth.v1.ListExportSigningKeysResponse.keys:type_name -> auth.v1.ExportSigningKey
+	1, // 3: auth.v1.DataExportService.ExportMyData:input_type -> auth.v1.ExportMyDataRequest
+	3, // 4: auth.v1.DataExportService.GetDataExport:input_type -> auth.v1.GetDataExportRequest
+	5, // 5: auth.v1.DataExportService.DownloadDataExport:input_type -> auth.v1.DownloadDataExportRequest
+	7, // 6: auth.v1.DataExportService.ListExportSigningKeys:input_type -> auth.v1.ListExportSigningKeysRequest
+	2, // 7: auth.v1.DataExportService.ExportMyData:output_type -> auth.v1.ExportMyDataResponse
+	4, // 8: auth.v1.DataExportService.GetDataExport:output_type -> auth.v1.GetDataExportResponse
+	6, // 9: auth.v1.DataExportService.DownloadDataExport:output_type -> auth.v1.DownloadDataExportResponse
+	9, // 10: auth.v1.DataExportService.ListExportSigningKeys:output_type -> auth.v1.ListExportSigningKeysResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_data_export_service_proto_init() }
+func file_proto_data_export_service_proto_init() {
+	if File_proto_data_export_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_data_export_service_proto_rawDesc), len(file_proto_data_export_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_data_export_service_proto_goTypes,
+		DependencyIndexes: file_proto_data_export_service_proto_depIdxs,
+		MessageInfos:      file_proto_data_export_service_proto_msgTypes,
+	}.Build()
+	File_proto_data_export_service_proto = out.File
+	file_proto_data_export_service_proto_goTypes = nil
+	file_proto_data_export_service_proto_depIdxs = nil
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/data_export_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DataExportService_ExportMyData_FullMethodName          = "/auth.v1.DataExportService/ExportMyData"
+	DataExportService_GetDataExport_FullMethodName         = "/auth.v1.DataExportService/GetDataExport"
+	DataExportService_DownloadDataExport_FullMethodName    = "/auth.v1.DataExportService/DownloadDataExport"
+	DataExportService_ListExportSigningKeys_FullMethodName = "/auth.v1.DataExportService/ListExportSigningKeys"
+)
+
+// DataExportServiceClient is the client API for DataExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Export data milik user (akun, profil, sesi, persetujuan, audit log tentang user dan metadata
+// MFA tanpa secret). Arsip disusun di background; client memantau status dengan GetDataExport
+// lalu mengunduh dengan DownloadDataExport. Semua method membutuhkan access token kecuali
+// ListExportSigningKeys.
+type DataExportServiceClient interface {
+	// Mengembalikan job yang sedang berjalan jika sudah ada
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+	// Hanya untuk job berstatus "completed"
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (*DownloadDataExportResponse, error)
+	// Publik: public key untuk memverifikasi data.json.sig, termasuk key sebelum rotasi
+	ListExportSigningKeys(ctx context.Context, in *ListExportSigningKeysRequest, opts ...grpc.CallOption) (*ListExportSigningKeysResponse, error)
+}
+
+type dataExportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataExportServiceClient(cc grpc.ClientConnInterface) DataExportServiceClient {
+	return &dataExportServiceClient{cc}
+}
+
+func (c *dataExportServiceClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, DataExportService_ExportMyData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataExportServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, DataExportService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataExportServiceClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (*DownloadDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadDataExportResponse)
+	err := c.cc.Invoke(ctx, DataExportService_DownloadDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataExportServiceClient) ListExportSigningKeys(ctx context.Context, in *ListExportSigningKeysRequest, opts ...grpc.CallOption) (*ListExportSigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExportSigningKeysResponse)
+	err := c.cc.Invoke(ctx, DataExportService_ListExportSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataExportServiceServer is the server API for DataExportService service.
+// All implementations must embed UnimplementedDataExportServiceServer
+// for forward compatibility.
+//
+// Export data milik user (akun, profil, sesi, persetujuan, audit log tentang user dan metadata
+// MFA tanpa secret). Arsip disusun di background; client memantau status dengan GetDataExport
+// lalu mengunduh dengan DownloadDataExport. Semua method membutuhkan access token kecuali
+// ListExportSigningKeys.
+type DataExportServiceServer interface {
+	// Mengembalikan job yang sedang berjalan jika sudah ada
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
+	// Hanya untuk job berstatus "completed"
+	DownloadDataExport(context.Context, *DownloadDataExportRequest) (*DownloadDataExportResponse, error)
+	// Publik: public key untuk memverifikasi data.json.sig, termasuk key sebelum rotasi
+	ListExportSigningKeys(context.Context, *ListExportSigningKeysRequest) (*ListExportSigningKeysResponse, error)
+	mustEmbedUnimplementedDataExportServiceServer()
+}
+
+// UnimplementedDataExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDataExportServiceServer struct{}
+
+func (UnimplementedDataExportServiceServer) ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedDataExportServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedDataExportServiceServer) DownloadDataExport(context.Context, *DownloadDataExportRequest) (*DownloadDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedDataExportServiceServer) ListExportSigningKeys(context.Context, *ListExportSigningKeysRequest) (*ListExportSigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportSigningKeys not implemented")
+}
+func (UnimplementedDataExportServiceServer) mustEmbedUnimplementedDataExportServiceServer() {}
+func (UnimplementedDataExportServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDataExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataExportServiceServer will
+// result in compilation errors.
+type UnsafeDataExportServiceServer interface {
+	mustEmbedUnimplementedDataExportServiceServer()
+}
+
+func RegisterDataExportServiceServer(s grpc.ServiceRegistrar, srv DataExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDataExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DataExportService_ServiceDesc, srv)
+}
+
+func _DataExportService_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataExportServiceServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataExportService_ExportMyData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataExportServiceServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataExportService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataExportServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataExportService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataExportServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataExportService_DownloadDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataExportServiceServer).DownloadDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataExportService_DownloadDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataExportServiceServer).DownloadDataExport(ctx, req.(*DownloadDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataExportService_ListExportSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataExportServiceServer).ListExportSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataExportService_ListExportSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataExportServiceServer).ListExportSigningKeys(ctx, req.(*ListExportSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataExportService_ServiceDesc is the grpc.ServiceDesc for DataExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.DataExportService",
+	HandlerType: (*DataExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportMyData",
+			Handler:    _DataExportService_ExportMyData_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _DataExportService_GetDataExport_Handler,
+		},
+		{
+			MethodName: "DownloadDataExport",
+			Handler:    _DataExportService_DownloadDataExport_Handler,
+		},
+		{
+			MethodName: "ListExportSigningKeys",
+			Handler:    _DataExportService_ListExportSigningKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/data_export_service.proto",
+}
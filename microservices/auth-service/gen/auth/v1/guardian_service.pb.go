@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/guardian_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	DateOfBirth   string                 `protobuf:"bytes,4,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"` // YYYY-MM-DD
+	Relationship  string                 `protobuf:"bytes,5,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	LinkedAt      int64                  `protobuf:"varint,6,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ward) Reset() {
+	*x = Ward{}
+	mi := &file_proto_guardian_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ward) ProtoMessage() {}
+
+func (x *Ward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ward.ProtoReflect.Descriptor instead.
+func (*Ward) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ward) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Ward) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Ward) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Ward) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Ward) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *Ward) GetLinkedAt() int64 {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return 0
+}
+
+type AcceptGuardianshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGuardianshipRequest) Reset() {
+	*x = AcceptGuardianshipRequest{}
+	mi := &file_proto_guardian_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGuardianshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuardianshipRequest) ProtoMessage() {}
+
+func (x *AcceptGuardianshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuardianshipRequest.ProtoReflect.Descriptor instead.
+func (*AcceptGuardianshipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptGuardianshipRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptGuardianshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ward          *Ward                  `protobuf:"bytes,1,opt,name=ward,proto3" json:"ward,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptGuardianshipResponse) Reset() {
+	*x = AcceptGuardianshipResponse{}
+	mi := &file_proto_guardian_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptGuardianshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptGuardianshipResponse) ProtoMessage() {}
+
+func (x *AcceptGuardianshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptGuardianshipResponse.ProtoReflect.Descriptor instead.
+func (*AcceptGuardianshipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AcceptGuardianshipResponse) GetWard() *Ward {
+	if x != nil {
+		return x.Ward
+	}
+	return nil
+}
+
+type ListWardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWardsRequest) Reset() {
+	*x = ListWardsRequest{}
+	mi := &file_proto_guardian_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWardsRequest) ProtoMessage() {}
+
+func (x *ListWardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWardsRequest.ProtoReflect.Descriptor instead.
+func (*ListWardsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ListWardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wards         []*Ward                `protobuf:"bytes,1,rep,name=wards,proto3" json:"wards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWardsResponse) Reset() {
+	*x = ListWardsResponse{}
+	mi := &file_proto_guardian_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWardsResponse) ProtoMessage() {}
+
+func (x *ListWardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWardsResponse.ProtoReflect.Descriptor instead.
+func (*ListWardsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWardsResponse) GetWards() []*Ward {
+	if x != nil {
+		return x.Wards
+	}
+	return nil
+}
+
+type GetWardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWardRequest) Reset() {
+	*x = GetWardRequest{}
+	mi := &file_proto_guardian_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWardRequest) ProtoMessage() {}
+
+func (x *GetWardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWardRequest.ProtoReflect.Descriptor instead.
+func (*GetWardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ward          *Ward                  `protobuf:"bytes,1,opt,name=ward,proto3" json:"ward,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWardResponse) Reset() {
+	*x = GetWardResponse{}
+	mi := &file_proto_guardian_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWardResponse) ProtoMessage() {}
+
+func (x *GetWardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWardResponse.ProtoReflect.Descriptor instead.
+func (*GetWardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWardResponse) GetWard() *Ward {
+	if x != nil {
+		return x.Ward
+	}
+	return nil
+}
+
+type SetWardStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWardStatusRequest) Reset() {
+	*x = SetWardStatusRequest{}
+	mi := &file_proto_guardian_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWardStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWardStatusRequest) ProtoMessage() {}
+
+func (x *SetWardStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWardStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetWardStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetWardStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetWardStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SetWardStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetWardStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWardStatusResponse) Reset() {
+	*x = SetWardStatusResponse{}
+	mi := &file_proto_guardian_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWardStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWardStatusResponse) ProtoMessage() {}
+
+func (x *SetWardStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWardStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetWardStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{8}
+}
+
+type LogoutWardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutWardRequest) Reset() {
+	*x = LogoutWardRequest{}
+	mi := &file_proto_guardian_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutWardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutWardRequest) ProtoMessage() {}
+
+func (x *LogoutWardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutWardRequest.ProtoReflect.Descriptor instead.
+func (*LogoutWardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LogoutWardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LogoutWardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutWardResponse) Reset() {
+	*x = LogoutWardResponse{}
+	mi := &file_proto_guardian_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutWardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutWardResponse) ProtoMessage() {}
+
+func (x *LogoutWardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_guardian_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutWardResponse.ProtoReflect.Descriptor instead.
+func (*LogoutWardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_guardian_service_proto_rawDescGZIP(), []int{10}
+}
+
+var File_proto_guardian_service_proto protoreflect.FileDescriptor
+
+const file_proto_guardian_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/guardian_service.proto\x12\aauth.v1\"\xb2\x01\n" +
+	"\x04Ward\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\"\n" +
+	"\rdate_of_birth\x18\x04 \x01(\tR\vdateOfBirth\x12\"\n" +
+	"\frelationship\x18\x05 \x01(\tR\frelationship\x12\x1b\n" +
+	"\tlinked_at\x18\x06 \x01(\x03R\blinkedAt\"1\n" +
+	"\x19AcceptGuardianshipRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"?\n" +
+	"\x1aAcceptGuardianshipResponse\x12!\n" +
+	"\x04ward\x18\x01 \x01(\v2\r.auth.v1.WardR\x04ward\"\x12\n" +
+	"\x10ListWardsRequest\"8\n" +
+	"\x11ListWardsResponse\x12#\n" +
+	"\x05wards\x18\x01 \x03(\v2\r.auth.v1.WardR\x05wards\")\n" +
+	"\x0eGetWardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x0fGetWardResponse\x12!\n" +
+	"\x04ward\x18\x01 \x01(\v2\r.auth.v1.WardR\x04ward\"_\n" +
+	"\x14SetWardStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x17\n" +
+	"\x15SetWardStatusResponse\",\n" +
+	"\x11LogoutWardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x14\n" +
+	"\x12LogoutWardResponse2\x89\x03\n" +
+	"\x0fGuardianService\x12]\n" +
+	"\x12AcceptGuardianship\x12\".auth.v1.AcceptGuardianshipRequest\x1a#.auth.v1.AcceptGuardianshipResponse\x12B\n" +
+	"\tListWards\x12\x19.auth.v1.ListWardsRequest\x1a\x1a.auth.v1.ListWardsResponse\x12<\n" +
+	"\aGetWard\x12\x17.auth.v1.GetWardRequest\x1a\x18.auth.v1.GetWardResponse\x12N\n" +
+	"\rSetWardStatus\x12\x1d.auth.v1.SetWardStatusRequest\x1a\x1e.auth.v1.SetWardStatusResponse\x12E\n" +
+	"\n" +
+	"LogoutWard\x12\x1a.auth.v1.LogoutWardRequest\x1a\x1b.auth.v1.LogoutWardResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_guardian_service_proto_rawDescOnce sync.Once
+	file_proto_guardian_service_proto_rawDescData []byte
+)
+
+func file_proto_guardian_service_proto_rawDescGZIP() []byte {
+	file_proto_guardian_service_proto_rawDescOnce.Do(func() {
+		file_proto_guardian_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_guardian_service_proto_rawDesc), len(file_proto_guardian_service_proto_rawDesc)))
+	})
+	return file_proto_guardian_service_proto_rawDescData
+}
+
+var file_proto_guardian_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_guardian_service_proto_goTypes = []any{
+	(*Ward)(nil),                       // 0: auth.v1.Ward
+	(*AcceptGuardianshipRequest)(nil),  // 1: auth.v1.AcceptGuardianshipRequest
+	(*AcceptGuardianshipResponse)(nil), // 2: auth.v1.AcceptGuardianshipResponse
+	(*ListWardsRequest)(nil),           // 3: auth.v1.ListWardsRequest
+	(*ListWardsResponse)(nil),          // 4: auth.v1.ListWardsResponse
+	(*GetWardRequest)(nil),             // 5: auth.v1.GetWardRequest
+	(*GetWardResponse)(nil),            // 6: auth.v1.GetWardResponse
+	(*SetWardStatusRequest)(nil),       // 7: auth.v1.SetWardStatusRequest
+	(*SetWardStatusResponse)(nil),      // 8: auth.v1.SetWardStatusResponse
+	(*LogoutWardRequest)(nil),          // 9: auth.v1.LogoutWardRequest
+	(*LogoutWardResponse)(nil),         // 10: auth.v1.LogoutWardResponse
+}
+var file_proto_guardian_service_proto_depIdxs = []int32{
+	0,  // 0: auth.v1.AcceptGuardianshipResponse.ward:type_name -> auth.v1.Ward
+	0,  // 1: auth.v1.ListWardsResponse.wards:type_name -> auth.v1.Ward
+	0,  // 2: auth.v1.GetWardResponse.ward:type_name -> auth.v1.Ward
+	1,  // 3: auth.v1.GuardianService.AcceptGuardianship:input_type -> auth.v1.AcceptGuardianshipRequest
+	3,  // 4: auth.v1.GuardianService.ListWards:input_type -> auth.v1.ListWardsRequest
+	5,  // 5: auth.v1.GuardianService.GetWard:input_type -> auth.v1.GetWardRequest
+	7,  // 6: auth.v1.GuardianService.SetWardStatus:input_type -> auth.v1.SetWardStatusRequest
+	9,  // 7: auth.v1.GuardianService.LogoutWard:input_type -> auth.v1.LogoutWardRequest
+	2,  // 8: auth.v1.GuardianService.AcceptGuardianship:output_type -> auth.v1.AcceptGuardianshipResponse
+	4,  // 9: auth.v1.GuardianService.ListWards:output_type -> auth.v1.ListWardsResponse
+	6,  // 10: auth.v1.GuardianService.GetWard:output_type -> auth.v1.GetWardResponse
+	8,  // 11: auth.v1.GuardianService.SetWardStatus:output_type -> auth.v1.SetWardStatusResponse
+	10, // 12: auth.v1.GuardianService.LogoutWard:output_type -> auth.v1.LogoutWardResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_guardian_service_proto_init() }
+func file_proto_guardian_service_proto_init() {
+	if File_proto_guardian_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_guardian_service_proto_rawDesc), len(file_proto_guardian_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_guardian_service_proto_goTypes,
+		DependencyIndexes: file_proto_guardian_service_proto_depIdxs,
+		MessageInfos:      file_proto_guardian_service_proto_msgTypes,
+	}.Build()
+	File_proto_guardian_service_proto = out.File
+	file_proto_guardian_service_proto_goTypes = nil
+	file_proto_guardian_service_proto_depIdxs = nil
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/guardian_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GuardianService_AcceptGuardianship_FullMethodName = "/auth.v1.GuardianService/AcceptGuardianship"
+	GuardianService_ListWards_FullMethodName          = "/auth.v1.GuardianService/ListWards"
+	GuardianService_GetWard_FullMethodName            = "/auth.v1.GuardianService/GetWard"
+	GuardianService_SetWardStatus_FullMethodName      = "/auth.v1.GuardianService/SetWardStatus"
+	GuardianService_LogoutWard_FullMethodName         = "/auth.v1.GuardianService/LogoutWard"
+)
+
+// GuardianServiceClient is the client API for GuardianService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Pengelolaan akun klien di bawah umur oleh walinya (membutuhkan access token wali).
+// Wali bisa melihat profil dan mengelola akun, tetapi tidak membaca konten klinis.
+type GuardianServiceClient interface {
+	// Token dari email undangan; akun wali harus memakai email yang diundang
+	AcceptGuardianship(ctx context.Context, in *AcceptGuardianshipRequest, opts ...grpc.CallOption) (*AcceptGuardianshipResponse, error)
+	ListWards(ctx context.Context, in *ListWardsRequest, opts ...grpc.CallOption) (*ListWardsResponse, error)
+	GetWard(ctx context.Context, in *GetWardRequest, opts ...grpc.CallOption) (*GetWardResponse, error)
+	// Hanya "active" atau "suspended"; suspend mencabut semua sesi anak
+	SetWardStatus(ctx context.Context, in *SetWardStatusRequest, opts ...grpc.CallOption) (*SetWardStatusResponse, error)
+	LogoutWard(ctx context.Context, in *LogoutWardRequest, opts ...grpc.CallOption) (*LogoutWardResponse, error)
+}
+
+type guardianServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuardianServiceClient(cc grpc.ClientConnInterface) GuardianServiceClient {
+	return &guardianServiceClient{cc}
+}
+
+func (c *guardianServiceClient) AcceptGuardianship(ctx context.Context, in *AcceptGuardianshipRequest, opts ...grpc.CallOption) (*AcceptGuardianshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptGuardianshipResponse)
+	err := c.cc.Invoke(ctx, GuardianService_AcceptGuardianship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) ListWards(ctx context.Context, in *ListWardsRequest, opts ...grpc.CallOption) (*ListWardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWardsResponse)
+	err := c.cc.Invoke(ctx, GuardianService_ListWards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) GetWard(ctx context.Context, in *GetWardRequest, opts ...grpc.CallOption) (*GetWardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWardResponse)
+	err := c.cc.Invoke(ctx, GuardianService_GetWard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) SetWardStatus(ctx context.Context, in *SetWardStatusRequest, opts ...grpc.CallOption) (*SetWardStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetWardStatusResponse)
+	err := c.cc.Invoke(ctx, GuardianService_SetWardStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardianServiceClient) LogoutWard(ctx context.Context, in *LogoutWardRequest, opts ...grpc.CallOption) (*LogoutWardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutWardResponse)
+	err := c.cc.Invoke(ctx, GuardianService_LogoutWard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuardianServiceServer is the server API for GuardianService service.
+// All implementations must embed UnimplementedGuardianServiceServer
+// for forward compatibility.
+//
+// Pengelolaan akun klien di bawah umur oleh walinya (membutuhkan access token wali).
+// Wali bisa melihat profil dan mengelola akun, tetapi tidak membaca konten klinis.
+type GuardianServiceServer interface {
+	// Token dari email undangan; akun wali harus memakai email yang diundang
+	AcceptGuardianship(context.Context, *AcceptGuardianshipRequest) (*AcceptGuardianshipResponse, error)
+	ListWards(context.Context, *ListWardsRequest) (*ListWardsResponse, error)
+	GetWard(context.Context, *GetWardRequest) (*GetWardResponse, error)
+	// Hanya "active" atau "suspended"; suspend mencabut semua sesi anak
+	SetWardStatus(context.Context, *SetWardStatusRequest) (*SetWardStatusResponse, error)
+	LogoutWard(context.Context, *LogoutWardRequest) (*LogoutWardResponse, error)
+	mustEmbedUnimplementedGuardianServiceServer()
+}
+
+// UnimplementedGuardianServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuardianServiceServer struct{}
+
+func (UnimplementedGuardianServiceServer) AcceptGuardianship(context.Context, *AcceptGuardianshipRequest) (*AcceptGuardianshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptGuardianship not implemented")
+}
+func (UnimplementedGuardianServiceServer) ListWards(context.Context, *ListWardsRequest) (*ListWardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWards not implemented")
+}
+func (UnimplementedGuardianServiceServer) GetWard(context.Context, *GetWardRequest) (*GetWardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWard not implemented")
+}
+func (UnimplementedGuardianServiceServer) SetWardStatus(context.Context, *SetWardStatusRequest) (*SetWardStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWardStatus not implemented")
+}
+func (UnimplementedGuardianServiceServer) LogoutWard(context.Context, *LogoutWardRequest) (*LogoutWardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutWard not implemented")
+}
+func (UnimplementedGuardianServiceServer) mustEmbedUnimplementedGuardianServiceServer() {}
+func (UnimplementedGuardianServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeGuardianServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuardianServiceServer will
+// result in compilation errors.
+type UnsafeGuardianServiceServer interface {
+	mustEmbedUnimplementedGuardianServiceServer()
+}
+
+func RegisterGuardianServiceServer(s grpc.ServiceRegistrar, srv GuardianServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGuardianServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuardianService_ServiceDesc, srv)
+}
+
+func _GuardianService_AcceptGuardianship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptGuardianshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).AcceptGuardianship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_AcceptGuardianship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).AcceptGuardianship(ctx, req.(*AcceptGuardianshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_ListWards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).ListWards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_ListWards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).ListWards(ctx, req.(*ListWardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_GetWard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).GetWard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_GetWard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).GetWard(ctx, req.(*GetWardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_SetWardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWardStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).SetWardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_SetWardStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).SetWardStatus(ctx, req.(*SetWardStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardianService_LogoutWard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutWardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardianServiceServer).LogoutWard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardianService_LogoutWard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardianServiceServer).LogoutWard(ctx, req.(*LogoutWardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuardianService_ServiceDesc is the grpc.ServiceDesc for GuardianService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuardianService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.GuardianService",
+	HandlerType: (*GuardianServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptGuardianship",
+			Handler:    _GuardianService_AcceptGuardianship_Handler,
+		},
+		{
+			MethodName: "ListWards",
+			Handler:    _GuardianService_ListWards_Handler,
+		},
+		{
+			MethodName: "GetWard",
+			Handler:    _GuardianService_GetWard_Handler,
+		},
+		{
+			MethodName: "SetWardStatus",
+			Handler:    _GuardianService_SetWardStatus_Handler,
+		},
+		{
+			MethodName: "LogoutWard",
+			Handler:    _GuardianService_LogoutWard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/guardian_service.proto",
+}
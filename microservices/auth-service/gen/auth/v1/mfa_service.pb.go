@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/mfa_service.proto
+
+package authv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StartPhoneVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPhoneVerificationRequest) Reset() {
+	*x = StartPhoneVerificationRequest{}
+	mi := &file_proto_mfa_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPhoneVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPhoneVerificationRequest) ProtoMessage() {}
+
+func (x *StartPhoneVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mfa_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPhoneVerificationRequest.ProtoReflect.Descriptor instead.
+func (*StartPhoneVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mfa_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartPhoneVerificationRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type StartPhoneVerificationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartPhoneVerificationResponse) Reset() {
+	*x = StartPhoneVerificationResponse{}
+	mi := &file_proto_mfa_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPhoneVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPhoneVerificationResponse) ProtoMessage() {}
+
+func (x *StartPhoneVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mfa_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPhoneVerificationResponse.ProtoReflect.Descriptor instead.
+func (*StartPhoneVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mfa_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartPhoneVerificationResponse) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+type ConfirmPhoneVerificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfirmPhoneVerificationRequest) Reset() {
+	*x = ConfirmPhoneVerificationRequest{}
+	mi := &file_proto_mfa_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhoneVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhoneVerificationRequest) ProtoMessage() {}
+
+func (x *ConfirmPhoneVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mfa_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhoneVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPhoneVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mfa_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfirmPhoneVerificationRequest) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *ConfirmPhoneVerificationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmPhoneVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPhoneVerificationResponse) Reset() {
+	*x = ConfirmPhoneVerificationResponse{}
+	mi := &file_proto_mfa_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhoneVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhoneVerificationResponse) ProtoMessage() {}
+
+func (x *ConfirmPhoneVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mfa_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhoneVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPhoneVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mfa_service_proto_rawDescGZIP(), []int{3}
+}
+
+type SetMFAChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // "sms", "email", atau kosong untuk menonaktifkan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMFAChannelRequest) Reset() {
+	*x = SetMFAChannelRequest{}
+	mi := &file_proto_mfa_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMFAChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMFAChannelRequest) ProtoMessage() {}
+
+func (x *SetMFAChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mfa_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMFAChannelRequest.ProtoReflect.Descriptor instead.
+func (*SetMFAChannelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mfa_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetMFAChannelRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type SetMFAChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMFAChannelResponse) Reset() {
+	*x = SetMFAChannelResponse{}
+	mi := &file_proto_mfa_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMFAChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMFAChannelResponse) ProtoMessage() {}
+
+func (x *SetMFAChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mfa_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMFAChannelResponse.ProtoReflect.Descriptor instead.
+func (*SetMFAChannelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mfa_service_proto_rawDescGZIP(), []int{5}
+}
+
+var File_proto_mfa_service_proto protoreflect.FileDescriptor
+
+const file_proto_mfa_service_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/mfa_service.proto\x12\aauth.v1\"5\n" +
+	"\x1dStartPhoneVerificationRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"I\n" +
+	"\x1eStartPhoneVerificationResponse\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\"^\n" +
+	"\x1fConfirmPhoneVerificationRequest\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\"\n" +
+	" ConfirmPhoneVerificationResponse\"0\n" +
+	"\x14SetMFAChannelRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\"\x17\n" +
+	"\x15SetMFAChannelResponse2\xb8\x02\n" +
+	"\n" +
+	"MFAService\x12i\n" +
+	"\x16StartPhoneVerification\x12&.auth.v1.StartPhoneVerificationRequest\x1a'.auth.v1.StartPhoneVerificationResponse\x12o\n" +
+	"\x18ConfirmPhoneVerification\x12(.auth.v1.ConfirmPhoneVerificationRequest\x1a).auth.v1.ConfirmPhoneVerificationResponse\x12N\n" +
+	"\rSetMFAChannel\x12\x1d.auth.v1.SetMFAChannelRequest\x1a\x1e.auth.v1.SetMFAChannelResponseB\x14Z\x12gen/auth/v1;authv1b\x06proto3"
+
+var (
+	file_proto_mfa_service_proto_rawDescOnce sync.Once
+	file_proto_mfa_service_proto_rawDescData []byte
+)
+
+func file_proto_mfa_service_proto_rawDescGZIP() []byte {
+	file_proto_mfa_service_proto_rawDescOnce.Do(func() {
+		file_proto_mfa_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_mfa_service_proto_rawDesc), len(file_proto_mfa_service_proto_rawDesc)))
+	})
+	return file_proto_mfa_service_proto_rawDescData
+}
+
+var file_proto_mfa_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_mfa_service_proto_goTypes = []any{
+	(*StartPhoneVerificationRequest)(nil),    // 0: auth.v1.StartPhoneVerificationRequest
+	(*StartPhoneVerificationResponse)(nil),   // 1: auth.v1.StartPhoneVerificationResponse
+	(*ConfirmPhoneVerificationRequest)(nil),  // 2: auth.v1.ConfirmPhoneVerificationRequest
+	(*ConfirmPhoneVerificationResponse)(nil), // 3: auth.v1.ConfirmPhoneVerificationResponse
+	(*SetMFAChannelRequest)(nil),             // 4: auth.v1.SetMFAChannelRequest
+	(*SetMFAChannelResponse)(nil),            // 5: auth.v1.SetMFAChannelResponse
+}
+var file_proto_mfa_service_proto_depIdxs = []int32{
+	0, // 0: auth.v1.MFAService.StartPhoneVerification:input_type -> auth.v1.StartPhoneVerificationRequest
+	2, // 1: auth.v1.MFAService.ConfirmPhoneVerification:input_type -> auth.v1.ConfirmPhoneVerificationRequest
+	4, // 2: auth.v1.MFAService.SetMFAChannel:input_type -> auth.v1.SetMFAChannelRequest
+	1, // 3: auth.v1.MFAService.StartPhoneVerification:output_type -> auth.v1.StartPhoneVerificationResponse
+	3, // 4: auth.v1.MFAService.ConfirmPhoneVerification:output_type -> auth.v1.ConfirmPhoneVerificationResponse
+	5, // 5: auth.v1.MFAService.SetMFAChannel:output_type -> auth.v1.SetMFAChannelResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_mfa_service_proto_init() }
+func file_proto_mfa_service_proto_init() {
+	if File_proto_mfa_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_mfa_service_proto_rawDesc), len(file_proto_mfa_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_mfa_service_proto_goTypes,
+		DependencyIndexes: file_proto_mfa_service_proto_depIdxs,
+		MessageInfos:      file_proto_mfa_service_proto_msgTypes,
+	}.Build()
+	File_proto_mfa_service_proto = out.File
+	file_proto_mfa_service_proto_goTypes = nil
+	file_proto_mfa_service_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// DataKeySize : AES-256
+const DataKeySize = 32
+
+var errCiphertextTooShort = errors.New("ciphertext too short")
+
+// GenerateDataKey membuat kunci acak untuk Seal/Open
+func GenerateDataKey() ([]byte, error) {
+	return generateRandomSalt(DataKeySize)
+}
+
+// Seal mengenkripsi dengan AES-GCM, nonce acak disimpan di depan ciphertext
+func Seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open membuka hasil Seal, gagal jika kunci salah atau ciphertext diubah
+func Open(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errCiphertextTooShort
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, sealed, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// BlindIndex : HMAC-SHA256 hex dari value yang dinormalisasi (trim, huruf kecil), untuk
+// pencarian exact-match tanpa membedakan huruf besar atas kolom terenkripsi tanpa menyimpan
+// nilai aslinya. Normalisasi dilakukan di sini agar penyimpanan dan pencarian selalu sama.
+func BlindIndex(key []byte, value string) string {
+	return LegacyBlindIndex(key, NormalizeIndexValue(value))
+}
+
+// LegacyBlindIndex : blind index tanpa normalisasi, hanya untuk mencocokkan baris yang
+// diindeks sebelum normalisasi dan belum diindeks ulang
+func LegacyBlindIndex(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NormalizeIndexValue : bentuk value yang di-hash oleh BlindIndex dan PrefixBlindIndex
+func NormalizeIndexValue(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// PrefixIndexMinLength : prefix terpendek (dalam karakter) yang bisa dicari lewat PrefixToken
+const PrefixIndexMinLength = 3
+
+// PrefixBlindIndex : token untuk setiap prefix value (dinormalisasi) sepanjang minimal
+// PrefixIndexMinLength karakter, disimpan bersama nilai terenkripsi. Pencarian prefix cukup
+// mencocokkan satu token dari PrefixToken.
+func PrefixBlindIndex(key []byte, value string) []string {
+	runes := []rune(NormalizeIndexValue(value))
+	tokens := make([]string, 0, len(runes))
+	for n := PrefixIndexMinLength; n <= len(runes); n++ {
+		tokens = append(tokens, prefixToken(key, string(runes[:n])))
+	}
+	return tokens
+}
+
+// PrefixToken : token pencarian untuk prefix, "" jika lebih pendek dari PrefixIndexMinLength
+func PrefixToken(key []byte, prefix string) string {
+	prefix = NormalizeIndexValue(prefix)
+	if len([]rune(prefix)) < PrefixIndexMinLength {
+		return ""
+	}
+	return prefixToken(key, prefix)
+}
+
+// prefixToken memakai label "prefix" agar token tidak sama dengan BlindIndex nilai yang sama,
+// dan dipotong 128 bit untuk menghemat ruang
+func prefixToken(key []byte, prefix string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("prefix\x00"))
+	mac.Write([]byte(prefix))
+	return hex.EncodeToString(mac.Sum(nil))[:32]
+}
@@ -1,16 +1,24 @@
 package auth
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+
+	"microservices/auth-service/domain/entities"
 )
 
 const (
 	accessTokenExpiry  = 15 * time.Minute
 	refreshTokenExpiry = 7 * 24 * time.Hour
+	serviceTokenExpiry = 5 * time.Minute
+	// Token hasil Reauthenticate sengaja berumur pendek
+	ElevatedTokenExpiry = 5 * time.Minute
 )
 
 type JWTAuth struct {
@@ -22,27 +30,143 @@ func NewJWTAuth(secret string) *JWTAuth {
 }
 
 type CustomClaims struct {
-	UserID string `json:"uid"`
-	Role   string `json:"role"`
+	UserID      string   `json:"uid"`
+	Role        string   `json:"role"`
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"perms,omitempty"`
+	Scopes      []string `json:"scopes,omitempty"`
+
+	// Waktu dan cara user terakhir kali autentikasi (OIDC Core), untuk step-up di service lain
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	ACR      string           `json:"acr,omitempty"`
+	AMR      []string         `json:"amr,omitempty"`
+
+	// Akun klien di bawah umur yang dikelola pemegang token. Hanya memberi hak mengelola akun
+	// (status, sesi), bukan membaca konten klinis milik anak.
+	GuardianOf []string `json:"guardian_of,omitempty"`
+	Minor      bool     `json:"minor,omitempty"`
+
+	// Organisasi (tenant) aktif dan role user di dalamnya, lihat SwitchOrganization
+	OrgID   string `json:"org_id,omitempty"`
+	OrgRole string `json:"org_role,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func (ja *JWTAuth) GenerateAccessToken(userID, role string) (string, error) {
+// IssuedAtTime mengembalikan waktu token diterbitkan, zero time untuk token lama tanpa iat
+func (c *CustomClaims) IssuedAtTime() time.Time {
+	if c.IssuedAt == nil {
+		return time.Time{}
+	}
+	return c.IssuedAt.Time
+}
+
+// AuthTimeValue mengembalikan zero time jika token tidak membawa auth_time
+func (c *CustomClaims) AuthTimeValue() time.Time {
+	if c.AuthTime == nil {
+		return time.Time{}
+	}
+	return c.AuthTime.Time
+}
+
+func (c *CustomClaims) HasPermission(permission string) bool {
+	for _, p := range c.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenOption menambahkan claim opsional ke access token
+type TokenOption func(*CustomClaims)
+
+func WithRoles(roles []string) TokenOption {
+	return func(c *CustomClaims) { c.Roles = roles }
+}
+
+func WithPermissions(permissions []string) TokenOption {
+	return func(c *CustomClaims) { c.Permissions = permissions }
+}
+
+// WithAuthContext mengisi claim auth_time, acr dan amr
+func WithAuthContext(authCtx entities.AuthContext) TokenOption {
+	return func(c *CustomClaims) {
+		if authCtx.AuthTime.IsZero() {
+			return
+		}
+		c.AuthTime = jwt.NewNumericDate(authCtx.AuthTime)
+		c.ACR = authCtx.ACR()
+		c.AMR = make([]string, len(authCtx.Methods))
+		for i, m := range authCtx.Methods {
+			c.AMR[i] = string(m)
+		}
+	}
+}
+
+// WithGuardianOf mengisi claim guardian_of dengan ID akun anak yang dikelola
+func WithGuardianOf(minorIDs []string) TokenOption {
+	return func(c *CustomClaims) { c.GuardianOf = minorIDs }
+}
+
+// WithMinor menandai token milik user di bawah umur
+func WithMinor() TokenOption {
+	return func(c *CustomClaims) { c.Minor = true }
+}
+
+// WithOrganization mengisi claim org_id dan org_role untuk token tenant-scoped
+func WithOrganization(orgID, orgRole string) TokenOption {
+	return func(c *CustomClaims) {
+		c.OrgID = orgID
+		c.OrgRole = orgRole
+	}
+}
+
+// WithLifetime mengganti masa berlaku default access token
+func WithLifetime(d time.Duration) TokenOption {
+	return func(c *CustomClaims) {
+		c.ExpiresAt = jwt.NewNumericDate(c.IssuedAt.Time.Add(d))
+	}
+}
+
+func (ja *JWTAuth) GenerateAccessToken(userID, role string, opts ...TokenOption) (string, error) {
 	accessClaims := CustomClaims{
 		UserID: userID,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessTokenExpiry)),
 			ID:        uuid.NewString(),
 		},
 	}
+	for _, opt := range opts {
+		opt(&accessClaims)
+	}
 
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
 	return accessToken.SignedString([]byte(ja.secret))
 }
 
-func (ja *JWTAuth) GenerateTokens(userID, role string) (string, string, error) {
-	accessToken, err := ja.GenerateAccessToken(userID, role)
+// GenerateServiceToken menerbitkan JWT berumur pendek untuk service account (client_credentials)
+func (ja *JWTAuth) GenerateServiceToken(serviceAccountID string, scopes []string) (string, time.Duration, error) {
+	claims := CustomClaims{
+		UserID: serviceAccountID,
+		Role:   string(entities.ServiceRole),
+		Scopes: scopes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   serviceAccountID,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(serviceTokenExpiry)),
+			ID:        uuid.NewString(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(ja.secret))
+	return signed, serviceTokenExpiry, err
+}
+
+func (ja *JWTAuth) GenerateTokens(userID, role string, opts ...TokenOption) (string, string, error) {
+	accessToken, err := ja.GenerateAccessToken(userID, role, opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -57,13 +181,20 @@ func (ja *JWTAuth) GenerateTokens(userID, role string) (string, string, error) {
 
 func (ja *JWTAuth) ValidateToken(tokenString string) (*CustomClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
 		return []byte(ja.secret), nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
+	// Refresh token tidak punya uid, jangan sampai dipakai sebagai access token
+	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid && claims.UserID != "" {
 		return claims, nil
 	}
-	return nil, err
+	return nil, fmt.Errorf("invalid token")
 }
 
 func (ja *JWTAuth) GenerateRefreshToken() (string, error) {
@@ -96,9 +227,9 @@ func (ja *JWTAuth) ValidateRefreshToken(tokenString string) (*jwt.RegisteredClai
 	return nil, fmt.Errorf("invalid token")
 }
 
-func (ja *JWTAuth) RotateTokens(userID, role string) (string, string, error) {
+func (ja *JWTAuth) RotateTokens(userID, role string, opts ...TokenOption) (string, string, error) {
 	// Generate new access token
-	accessToken, err := ja.GenerateAccessToken(userID, role)
+	accessToken, err := ja.GenerateAccessToken(userID, role, opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -111,3 +242,98 @@ func (ja *JWTAuth) RotateTokens(userID, role string) (string, string, error) {
 
 	return accessToken, refreshToken, nil
 }
+
+const clientInvitationAudience = "client_invitation"
+
+// InvitationClaims : token undangan klien. Subject berisi ID undangan.
+type InvitationClaims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// purposeKey diturunkan dari secret JWT per audience supaya token undangan, consent, dst.
+// tidak pernah lolos sebagai access/refresh token (dan sebaliknya)
+func (ja *JWTAuth) purposeKey(audience string) []byte {
+	mac := hmac.New(sha256.New, []byte(ja.secret))
+	mac.Write([]byte(audience))
+	return mac.Sum(nil)
+}
+
+// GenerateInvitationToken menandatangani undangan klien yang berlaku sampai expiresAt
+func (ja *JWTAuth) GenerateInvitationToken(invitationID, email string, expiresAt time.Time) (string, error) {
+	claims := InvitationClaims{
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   invitationID,
+			Audience:  jwt.ClaimStrings{clientInvitationAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(ja.purposeKey(clientInvitationAudience))
+}
+
+func (ja *JWTAuth) ValidateInvitationToken(tokenString string) (*InvitationClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &InvitationClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return ja.purposeKey(clientInvitationAudience), nil
+	}, jwt.WithAudience(clientInvitationAudience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := token.Claims.(*InvitationClaims); ok && token.Valid && claims.Subject != "" {
+		return claims, nil
+	}
+	return nil, fmt.Errorf("invalid token")
+}
+
+const (
+	consentAudience    = "consent"
+	consentTokenExpiry = 10 * time.Minute
+)
+
+// GenerateConsentToken diberikan ke login yang tertahan karena persetujuan, hanya bisa
+// dipakai untuk RecordConsent atas nama userID
+func (ja *JWTAuth) GenerateConsentToken(userID string) (string, error) {
+	claims := jwt.RegisteredClaims{
+		Subject:   userID,
+		Audience:  jwt.ClaimStrings{consentAudience},
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(consentTokenExpiry)),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(ja.purposeKey(consentAudience))
+}
+
+// ValidateConsentToken mengembalikan user ID pemilik token
+func (ja *JWTAuth) ValidateConsentToken(tokenString string) (string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return ja.purposeKey(consentAudience), nil
+	}, jwt.WithAudience(consentAudience), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", err
+	}
+
+	if claims, ok := token.Claims.(*jwt.RegisteredClaims); ok && token.Valid && claims.Subject != "" {
+		return claims.Subject, nil
+	}
+	return "", fmt.Errorf("invalid token")
+}
+
+const pseudonymPurpose = "pseudonym"
+
+// Pseudonym menggantikan user ID di audit log setelah akun dihapus. Nilainya tetap untuk
+// user yang sama sehingga event-event miliknya masih bisa dikaitkan, tetapi tidak bisa
+// dibalik ke user ID tanpa secret.
+func (ja *JWTAuth) Pseudonym(userID string) string {
+	mac := hmac.New(sha256.New, ja.purposeKey(pseudonymPurpose))
+	mac.Write([]byte(userID))
+	return entities.PseudonymPrefix + hex.EncodeToString(mac.Sum(nil))[:32]
+}
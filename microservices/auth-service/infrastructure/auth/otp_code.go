@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"math/big"
+)
+
+// GenerateNumericCode membuat kode angka acak (OTP) dengan panjang digits, termasuk nol di depan
+func GenerateNumericCode(digits int) (string, error) {
+	code := make([]byte, digits)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		code[i] = byte('0' + n.Int64())
+	}
+	return string(code), nil
+}
+
+// HashOTPCode mengikat kode ke challenge-nya, sehingga hash tidak bisa dipakai ulang untuk challenge lain
+func HashOTPCode(challengeID, code string) string {
+	sum := sha256.Sum256([]byte(challengeID + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func VerifyOTPCode(challengeID, code, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashOTPCode(challengeID, code)), []byte(hash)) == 1
+}
@@ -0,0 +1,63 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"microservices/auth-service/domain/entities"
+)
+
+// cityRecord : subset field database GeoLite2-City / GeoIP2-City yang dipakai
+type cityRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
+
+// MaxMindLocator membaca file .mmdb lokal, tidak ada request ke layanan eksternal
+type MaxMindLocator struct {
+	reader *maxminddb.Reader
+}
+
+func NewMaxMindLocator(path string) (*MaxMindLocator, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open geoip database: %w", err)
+	}
+	return &MaxMindLocator{reader: reader}, nil
+}
+
+func (l *MaxMindLocator) Locate(ip string) (*entities.GeoLocation, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil, nil
+	}
+
+	var record cityRecord
+	if err := l.reader.Lookup(parsed, &record); err != nil {
+		return nil, err
+	}
+	// IP privat / tidak terdaftar tidak punya koordinat
+	if record.Location.Latitude == nil || record.Location.Longitude == nil {
+		return nil, nil
+	}
+	return &entities.GeoLocation{
+		Country:   record.Country.ISOCode,
+		City:      record.City.Names["en"],
+		Latitude:  *record.Location.Latitude,
+		Longitude: *record.Location.Longitude,
+	}, nil
+}
+
+func (l *MaxMindLocator) Close() error {
+	return l.reader.Close()
+}
@@ -0,0 +1,200 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"microservices/auth-service/infrastructure/auth"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+var ErrUnknownMasterKey = errors.New("unknown master key")
+
+// keyFile : format file master key. Key lama tetap disimpan agar data key yang belum
+// dibungkus ulang masih bisa dibuka; hapus hanya setelah re-wrap selesai.
+type keyFile struct {
+	Current string      `json:"current"`
+	Keys    []masterKey `json:"keys"`
+}
+
+type masterKey struct {
+	ID        string    `json:"id"`
+	Key       string    `json:"key"` // base64 std, 32 byte
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FileKeyManager adalah KMS pengganti untuk development dan deployment kecil: master key
+// disimpan di file lokal (0600). File dibaca ulang saat berubah, sehingga rotasi lewat
+// RotateKeyFile berlaku tanpa restart.
+type FileKeyManager struct {
+	keyStore
+}
+
+func NewFileKeyManager(path string) (*FileKeyManager, error) {
+	m := &FileKeyManager{keyStore{path: path}}
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *FileKeyManager) CurrentKeyID() string {
+	// Gagal membaca ulang: tetap memakai key yang sudah dimuat
+	_ = m.reload()
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.current
+}
+
+func (m *FileKeyManager) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	if err := m.reload(); err != nil {
+		return "", nil, err
+	}
+	m.mu.RLock()
+	keyID, key := m.current, m.keys[m.current]
+	m.mu.RUnlock()
+
+	wrapped, err := auth.Seal(key, dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return keyID, wrapped, nil
+}
+
+func (m *FileKeyManager) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	key, ok := m.keys[keyID]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMasterKey, keyID)
+	}
+	return auth.Open(key, wrapped)
+}
+
+// keyStore : isi file key (format keyFile) yang dibaca ulang saat file berubah
+type keyStore struct {
+	path string
+
+	mu        sync.RWMutex
+	modTime   time.Time
+	size      int64
+	current   string
+	keys      map[string][]byte
+	createdAt map[string]time.Time
+}
+
+// reload membaca file hanya jika waktu modifikasi atau ukurannya berubah
+func (m *keyStore) reload() error {
+	info, err := os.Stat(m.path)
+	if err != nil {
+		return err
+	}
+	m.mu.RLock()
+	unchanged := info.ModTime().Equal(m.modTime) && info.Size() == m.size
+	m.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	kf, err := readKeyFile(m.path)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string][]byte, len(kf.Keys))
+	createdAt := make(map[string]time.Time, len(kf.Keys))
+	for _, k := range kf.Keys {
+		raw, err := base64.StdEncoding.DecodeString(k.Key)
+		if err != nil || len(raw) != auth.DataKeySize {
+			return fmt.Errorf("key %s: invalid key", k.ID)
+		}
+		keys[k.ID] = raw
+		createdAt[k.ID] = k.CreatedAt
+	}
+	if _, ok := keys[kf.Current]; !ok {
+		return fmt.Errorf("%w: current key %q", ErrUnknownMasterKey, kf.Current)
+	}
+
+	m.mu.Lock()
+	m.modTime, m.size, m.current, m.keys, m.createdAt = info.ModTime(), info.Size(), kf.Current, keys, createdAt
+	m.mu.Unlock()
+	return nil
+}
+
+// EnsureKeyFile membuat file berisi satu master key baru jika path belum ada
+func EnsureKeyFile(path string) (created bool, err error) {
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	if _, err := RotateKeyFile(path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RotateKeyFile menambahkan master key baru dan menjadikannya current (membuat file jika
+// belum ada). Data key lama dibungkus ulang oleh job re-wrap di auth-service.
+func RotateKeyFile(path string) (string, error) {
+	return rotateKeyFile(path, "mk-")
+}
+
+// rotateKeyFile menambahkan key acak 32 byte dengan ID berawalan idPrefix sebagai current
+func rotateKeyFile(path, idPrefix string) (string, error) {
+	kf := &keyFile{}
+	if _, err := os.Stat(path); err == nil {
+		if kf, err = readKeyFile(path); err != nil {
+			return "", err
+		}
+	}
+
+	raw, err := auth.GenerateDataKey()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	id := idPrefix + now.Format("20060102T150405Z")
+	for _, k := range kf.Keys {
+		if k.ID == id {
+			id += "-" + auth.GenerateUUID()[:8]
+			break
+		}
+	}
+	kf.Keys = append(kf.Keys, masterKey{ID: id, Key: base64.StdEncoding.EncodeToString(raw), CreatedAt: now})
+	kf.Current = id
+
+	data, err := json.MarshalIndent(kf, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", err
+	}
+	// Tulis ke file sementara lalu rename agar pembaca tidak pernah melihat file setengah jadi
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func readKeyFile(path string) (*keyFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var kf keyFile
+	if err := json.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("parse master key file: %w", err)
+	}
+	return &kf, nil
+}
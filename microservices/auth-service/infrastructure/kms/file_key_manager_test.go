@@ -0,0 +1,67 @@
+package kms_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/infrastructure/kms"
+)
+
+func TestFileKeyManager_MissingFile(t *testing.T) {
+	_, err := kms.NewFileKeyManager(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestFileKeyManager_WrapRotateUnwrap(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "secrets", "master-keys.json")
+
+	created, err := kms.EnsureKeyFile(path)
+	require.NoError(t, err)
+	assert.True(t, created)
+	created, err = kms.EnsureKeyFile(path)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	m, err := kms.NewFileKeyManager(path)
+	require.NoError(t, err)
+	firstID := m.CurrentKeyID()
+
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+	keyID, wrapped, err := m.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+	assert.Equal(t, firstID, keyID)
+	assert.NotContains(t, string(wrapped), string(dataKey))
+
+	// Rotasi berlaku tanpa membuat ulang FileKeyManager, key lama tetap bisa membuka
+	rotatedID, err := kms.RotateKeyFile(path)
+	require.NoError(t, err)
+	assert.NotEqual(t, firstID, rotatedID)
+	assert.Equal(t, rotatedID, m.CurrentKeyID())
+
+	unwrapped, err := m.UnwrapKey(ctx, firstID, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	keyID, rewrapped, err := m.WrapKey(ctx, unwrapped)
+	require.NoError(t, err)
+	assert.Equal(t, rotatedID, keyID)
+	unwrapped, err = m.UnwrapKey(ctx, rotatedID, rewrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	// Dibuka dengan master key yang salah atau tidak dikenal
+	_, err = m.UnwrapKey(ctx, rotatedID, wrapped)
+	assert.Error(t, err)
+	_, err = m.UnwrapKey(ctx, "mk-unknown", wrapped)
+	assert.ErrorIs(t, err, kms.ErrUnknownMasterKey)
+}
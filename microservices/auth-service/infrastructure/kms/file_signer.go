@@ -0,0 +1,76 @@
+package kms
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"microservices/auth-service/domain/entities"
+	"os"
+	"sort"
+)
+
+// SigningAlgorithm : algoritma tanda tangan FileSigner
+const SigningAlgorithm = "Ed25519"
+
+// FileSigner menandatangani arsip export dengan key Ed25519 dari file lokal (0600, format
+// sama dengan file master key; key berisi seed 32 byte). Key lama tetap dipublikasikan
+// agar arsip yang ditandatangani sebelum rotasi masih bisa diverifikasi.
+type FileSigner struct {
+	keyStore
+}
+
+func NewFileSigner(path string) (*FileSigner, error) {
+	s := &FileSigner{keyStore{path: path}}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSigner) Algorithm() string { return SigningAlgorithm }
+
+func (s *FileSigner) Sign(ctx context.Context, data []byte) (string, []byte, error) {
+	if err := s.reload(); err != nil {
+		return "", nil, err
+	}
+	s.mu.RLock()
+	keyID, seed := s.current, s.keys[s.current]
+	s.mu.RUnlock()
+	return keyID, ed25519.Sign(ed25519.NewKeyFromSeed(seed), data), nil
+}
+
+// PublicKeys diurutkan dari yang terbaru
+func (s *FileSigner) PublicKeys(ctx context.Context) ([]*entities.SigningKey, error) {
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]*entities.SigningKey, 0, len(s.keys))
+	for id, seed := range s.keys {
+		keys = append(keys, &entities.SigningKey{
+			ID:        id,
+			Algorithm: SigningAlgorithm,
+			PublicKey: ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey),
+			CreatedAt: s.createdAt[id],
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+	return keys, nil
+}
+
+// EnsureSigningKeyFile membuat file berisi satu signing key baru jika path belum ada
+func EnsureSigningKeyFile(path string) (created bool, err error) {
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	if _, err := RotateSigningKeyFile(path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RotateSigningKeyFile menambahkan signing key baru dan menjadikannya current
+func RotateSigningKeyFile(path string) (string, error) {
+	return rotateKeyFile(path, "sk-")
+}
@@ -0,0 +1,35 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"microservices/auth-service/domain/entities"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Channel default, harus sama dengan authn.DefaultRevocationChannel di shared module
+const DefaultRevocationChannel = "auth:revocations"
+
+// RedisRevocationPublisher mengirim event revocation lewat Redis pub/sub.
+// Pub/sub bersifat best-effort: subscriber yang sedang terputus tidak menerima event,
+// sehingga umur access token yang pendek tetap menjadi batas atas.
+type RedisRevocationPublisher struct {
+	client  *redis.Client
+	channel string
+}
+
+func NewRedisRevocationPublisher(client *redis.Client, channel string) *RedisRevocationPublisher {
+	if channel == "" {
+		channel = DefaultRevocationChannel
+	}
+	return &RedisRevocationPublisher{client: client, channel: channel}
+}
+
+func (p *RedisRevocationPublisher) PublishRevocation(ctx context.Context, event *entities.RevocationEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return p.client.Publish(ctx, p.channel, payload).Err()
+}
@@ -0,0 +1,59 @@
+package messaging
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	DefaultEventStream = "auth:events"
+
+	defaultStreamMaxLen = 100000
+	// Jendela deduplikasi, harus lebih panjang dari backoff retry terlama relay
+	eventDedupTTL    = 24 * time.Hour
+	eventDedupPrefix = "event_published:"
+)
+
+// Penanda event ID dan XADD dijalankan atomik, event yang dikirim ulang relay tidak diduplikasi
+var publishOnce = redis.NewScript(`
+if redis.call('SET', KEYS[2], '1', 'NX', 'EX', ARGV[1]) then
+    return redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[2], '*',
+        'id', ARGV[3], 'type', ARGV[4], 'aggregate_id', ARGV[5], 'payload', ARGV[6], 'occurred_at', ARGV[7])
+end
+return false
+`)
+
+// RedisStreamBroker mengirim domain event ke satu Redis Stream, consumer memakai consumer group
+// dan memfilter berdasarkan field type
+type RedisStreamBroker struct {
+	client *redis.Client
+	stream string
+}
+
+func NewRedisStreamBroker(client *redis.Client, stream string) *RedisStreamBroker {
+	if stream == "" {
+		stream = DefaultEventStream
+	}
+	return &RedisStreamBroker{client: client, stream: stream}
+}
+
+func (b *RedisStreamBroker) Publish(ctx context.Context, event *entities.DomainEvent) error {
+	err := publishOnce.Run(ctx, b.client,
+		[]string{b.stream, eventDedupPrefix + event.ID},
+		int(eventDedupTTL.Seconds()),
+		defaultStreamMaxLen,
+		event.ID,
+		string(event.Type),
+		event.AggregateID,
+		string(event.Payload),
+		event.OccurredAt.UTC().Format(time.RFC3339Nano),
+	).Err()
+	if err == redis.Nil {
+		// Sudah pernah dikirim
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+
+	"go.uber.org/zap"
+)
+
+// LogTransport hanya menulis pesan ke log, untuk development tanpa transport email.
+// Isi pesan (yang bisa memuat token) ditulis di level debug, yang hanya masuk ke file log lokal.
+type LogTransport struct {
+	logger *zap.Logger
+}
+
+func NewLogTransport(logger *zap.Logger) *LogTransport {
+	return &LogTransport{logger: logger}
+}
+
+func (t *LogTransport) Send(ctx context.Context, message *entities.Message) error {
+	t.logger.Info("notification sent",
+		zap.String("recipient", message.To),
+		zap.String("subject", message.Subject),
+	)
+	t.logger.Debug("notification content",
+		zap.String("recipient", message.To),
+		zap.String("body", message.Body),
+	)
+	return nil
+}
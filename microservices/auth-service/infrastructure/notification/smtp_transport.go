@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"microservices/auth-service/domain/entities"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPTransport mengirim email lewat server SMTP. Untuk development diarahkan ke mail
+// catcher lokal (Mailpit/MailHog) tanpa autentikasi.
+type SMTPTransport struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPTransport : username kosong berarti tanpa autentikasi. net/smtp hanya mengirim
+// PLAIN auth lewat TLS atau ke localhost.
+func NewSMTPTransport(addr, from, username, password string) *SMTPTransport {
+	t := &SMTPTransport{addr: addr, from: from}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		t.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return t
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, message *entities.Message) error {
+	// Cegah header injection lewat alamat tujuan
+	if strings.ContainsAny(message.To, "\r\n") {
+		return fmt.Errorf("invalid recipient address")
+	}
+	return smtp.SendMail(t.addr, t.auth, t.from, []string{message.To}, t.build(message))
+}
+
+func (t *SMTPTransport) build(message *entities.Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", t.from)
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return b.Bytes()
+}
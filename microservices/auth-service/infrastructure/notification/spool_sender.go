@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"microservices/auth-service/domain/entities"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9@._+-]`)
+
+// SpoolSender adalah SMSSender dan MessageTransport palsu untuk development dan
+// test lokal: setiap pesan ditulis sebagai file di <dir>/sms atau <dir>/email, tidak ada yang
+// benar-benar dikirim.
+type SpoolSender struct {
+	dir string
+}
+
+func NewSpoolSender(dir string) (*SpoolSender, error) {
+	for _, sub := range []string{"sms", "email"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o700); err != nil {
+			return nil, err
+		}
+	}
+	return &SpoolSender{dir: dir}, nil
+}
+
+func (s *SpoolSender) SendSMS(ctx context.Context, to, message string) error {
+	return s.write("sms", to, fmt.Sprintf("To: %s\n\n%s\n", to, message))
+}
+
+// Send menulis email yang sudah dirender (MessageTransport untuk development)
+func (s *SpoolSender) Send(ctx context.Context, message *entities.Message) error {
+	return s.write("email", message.To, fmt.Sprintf("To: %s\nSubject: %s\n\n%s", message.To, message.Subject, message.Body))
+}
+
+// write memakai nama file <timestamp>-<tujuan>.txt agar urutan pesan mudah dibaca
+func (s *SpoolSender) write(kind, to, content string) error {
+	name := fmt.Sprintf("%s-%s.txt", time.Now().UTC().Format("20060102T150405.000000000"), unsafeFileChars.ReplaceAllString(to, "_"))
+	return os.WriteFile(filepath.Join(s.dir, kind, name), []byte(content), 0o600)
+}
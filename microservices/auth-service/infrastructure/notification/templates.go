@@ -0,0 +1,76 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"microservices/auth-service/domain/entities"
+	"path"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*/*.tmpl
+var templateFS embed.FS
+
+// TemplateRenderer merender notifikasi dari templates/<locale>/<kind>.tmpl.
+// Setiap template mendefinisikan blok "subject" dan "body".
+type TemplateRenderer struct {
+	templates     map[string]*template.Template // key: locale/kind
+	defaultLocale string
+}
+
+func NewTemplateRenderer(defaultLocale string) (*TemplateRenderer, error) {
+	r := &TemplateRenderer{templates: make(map[string]*template.Template), defaultLocale: defaultLocale}
+	files, err := fs.Glob(templateFS, "templates/*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		locale := path.Base(path.Dir(file))
+		kind := strings.TrimSuffix(path.Base(file), ".tmpl")
+		tmpl, err := template.New(kind).Option("missingkey=zero").ParseFS(templateFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("parse template %s: %w", file, err)
+		}
+		r.templates[locale+"/"+kind] = tmpl
+	}
+	if _, ok := r.lookupLocale(defaultLocale); !ok {
+		return nil, fmt.Errorf("no templates for default locale %q", defaultLocale)
+	}
+	return r, nil
+}
+
+func (r *TemplateRenderer) lookupLocale(locale string) (string, bool) {
+	for key := range r.templates {
+		if strings.HasPrefix(key, locale+"/") {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// Render memakai bahasa notifikasi jika templatenya ada, selain itu bahasa default
+func (r *TemplateRenderer) Render(n *entities.Notification) (*entities.Message, error) {
+	tmpl, ok := r.templates[n.Locale+"/"+string(n.Kind)]
+	if !ok {
+		tmpl, ok = r.templates[r.defaultLocale+"/"+string(n.Kind)]
+	}
+	if !ok {
+		return nil, fmt.Errorf("no template for notification kind %q", n.Kind)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", n.Data); err != nil {
+		return nil, err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", n.Data); err != nil {
+		return nil, err
+	}
+	return &entities.Message{
+		To:      n.Recipient,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    strings.TrimSpace(body.String()) + "\n",
+	}, nil
+}
@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/domain/entities"
+)
+
+var allKinds = []entities.NotificationKind{
+	entities.NotificationMagicLink,
+	entities.NotificationPasswordReset,
+	entities.NotificationLoginAlert,
+	entities.NotificationVerificationCode,
+	entities.NotificationGuardianConsent,
+	entities.NotificationOrgInvitation,
+	entities.NotificationClientInvitation,
+	entities.NotificationAccountDeletion,
+}
+
+func TestTemplateRenderer_AllKindsInAllLocales(t *testing.T) {
+	renderer, err := NewTemplateRenderer(entities.DefaultLocale)
+	require.NoError(t, err)
+
+	for _, locale := range []string{"id", "en"} {
+		for _, kind := range allKinds {
+			_, ok := renderer.templates[locale+"/"+string(kind)]
+			assert.True(t, ok, "missing template %s/%s", locale, kind)
+		}
+	}
+}
+
+func TestTemplateRenderer_Render(t *testing.T) {
+	renderer, err := NewTemplateRenderer(entities.DefaultLocale)
+	require.NoError(t, err)
+
+	n := &entities.Notification{
+		Kind:      entities.NotificationVerificationCode,
+		Recipient: "user@example.com",
+		Locale:    "en",
+		Data:      map[string]string{"code": "123456", "expires_in_minutes": "5"},
+	}
+	msg, err := renderer.Render(n)
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", msg.To)
+	assert.Equal(t, "Your verification code", msg.Subject)
+	assert.Contains(t, msg.Body, "123456")
+
+	// Bahasa tanpa template jatuh ke bahasa default
+	n.Locale = "fr"
+	msg, err = renderer.Render(n)
+	require.NoError(t, err)
+	assert.Equal(t, "Kode verifikasi Anda", msg.Subject)
+}
+
+func TestTemplateRenderer_LoginAlertOptionalFields(t *testing.T) {
+	renderer, err := NewTemplateRenderer(entities.DefaultLocale)
+	require.NoError(t, err)
+
+	msg, err := renderer.Render(&entities.Notification{
+		Kind:   entities.NotificationLoginAlert,
+		Locale: "en",
+		Data:   map[string]string{"ip": "1.1.1.1", "blocked": "true"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "A sign-in to your account needs verification", msg.Subject)
+	assert.Contains(t, msg.Body, "Location  : unknown")
+	assert.NotContains(t, msg.Body, "<no value>")
+}
+
+func TestTemplateRenderer_UnknownKind(t *testing.T) {
+	renderer, err := NewTemplateRenderer(entities.DefaultLocale)
+	require.NoError(t, err)
+
+	_, err = renderer.Render(&entities.Notification{Kind: "unknown", Locale: "id"})
+	assert.Error(t, err)
+}
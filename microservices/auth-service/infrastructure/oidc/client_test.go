@@ -0,0 +1,89 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"microservices/auth-service/infrastructure/oidc"
+	"microservices/auth-service/infrastructure/oidc/oidctest"
+)
+
+func newTestClient(p *oidctest.Provider) *oidc.Client {
+	return oidc.NewClient(oidc.Config{
+		Name:         "google",
+		Issuer:       p.Issuer(),
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "https://app.example.com/callback",
+	})
+}
+
+func TestClient_AuthCodeURL(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+
+	rawURL, err := newTestClient(p).AuthCodeURL(context.Background(), "state-1", "nonce-1")
+	assert.NoError(t, err)
+	authURL, err := url.Parse(rawURL)
+	assert.NoError(t, err)
+
+	q := authURL.Query()
+	assert.Equal(t, "/authorize", authURL.Path)
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, oidctest.ClientID, q.Get("client_id"))
+	assert.Equal(t, "state-1", q.Get("state"))
+	assert.Equal(t, "nonce-1", q.Get("nonce"))
+}
+
+func TestClient_AuthCodeURL_DiscoveryUnavailable(t *testing.T) {
+	p := oidctest.NewProvider()
+	client := newTestClient(p)
+	p.Close()
+
+	authURL, err := client.AuthCodeURL(context.Background(), "state-1", "nonce-1")
+	assert.Error(t, err)
+	assert.Empty(t, authURL)
+}
+
+func TestClient_Exchange_Success(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+
+	p.RegisterCode("code-1", oidctest.Identity{
+		Subject:       "google-sub-1",
+		Email:         "User@Example.com",
+		EmailVerified: true,
+		Nonce:         "nonce-1",
+	})
+
+	identity, err := newTestClient(p).Exchange(context.Background(), "code-1", "nonce-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "google", identity.Provider)
+	assert.Equal(t, "google-sub-1", identity.Subject)
+	assert.Equal(t, "user@example.com", identity.Email)
+	assert.True(t, identity.EmailVerified)
+}
+
+func TestClient_Exchange_NonceMismatch(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+
+	p.RegisterCode("code-1", oidctest.Identity{Subject: "google-sub-1", Nonce: "nonce-1"})
+
+	_, err := newTestClient(p).Exchange(context.Background(), "code-1", "other-nonce")
+
+	assert.Error(t, err)
+}
+
+func TestClient_Exchange_InvalidCode(t *testing.T) {
+	p := oidctest.NewProvider()
+	defer p.Close()
+
+	_, err := newTestClient(p).Exchange(context.Background(), "unknown-code", "nonce-1")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/domain/services"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// PIICipher mengenkripsi kolom PII users (email, phone, date_of_birth) dengan data key milik
+// user (envelope encryption): data key di user_keys dibungkus master key dari KMS, dan email
+// juga disimpan sebagai blind index agar tetap bisa dicari tanpa didekripsi.
+type PIICipher struct {
+	kms      services.KeyManager
+	indexKey []byte
+}
+
+func NewPIICipher(kms services.KeyManager, indexKey []byte) *PIICipher {
+	return &PIICipher{kms: kms, indexKey: indexKey}
+}
+
+// EmailIndex dicocokkan persis tanpa membedakan huruf besar; juga menjadi unique constraint email
+func (c *PIICipher) EmailIndex(email string) string {
+	return auth.BlindIndex(c.indexKey, email)
+}
+
+// legacyEmailIndex : email_hash baris yang belum diindeks ulang (email_prefix_index NULL)
+func (c *PIICipher) legacyEmailIndex(email string) string {
+	return auth.LegacyBlindIndex(c.indexKey, email)
+}
+
+// EmailPrefixIndex : token prefix email untuk pencarian admin (users.email_prefix_index)
+func (c *PIICipher) EmailPrefixIndex(email string) []string {
+	return auth.PrefixBlindIndex(c.indexKey, email)
+}
+
+// EmailPrefixToken : "" jika prefix lebih pendek dari auth.PrefixIndexMinLength
+func (c *PIICipher) EmailPrefixToken(prefix string) string {
+	return auth.PrefixToken(c.indexKey, prefix)
+}
+
+// unwrapKey : wrapped nil berarti user tidak punya kunci, keyID kosong berarti data key
+// lama yang belum dibungkus master key
+func (c *PIICipher) unwrapKey(ctx context.Context, key wrappedKey) ([]byte, error) {
+	if key.key == nil {
+		return nil, nil
+	}
+	if key.masterKeyID.String == "" {
+		return key.key, nil
+	}
+	return c.kms.UnwrapKey(ctx, key.masterKeyID.String, key.key)
+}
+
+// wrappedKey : data key seperti tersimpan di user_keys
+type wrappedKey struct {
+	key         []byte
+	masterKeyID sql.NullString
+}
+
+// userKeyColumns : data key user terbungkus beserta master key-nya (NULL jika tidak ada)
+func userKeyColumns(usersAlias string) string {
+	return fmt.Sprintf(`(SELECT k.data_key FROM user_keys k WHERE k.user_id = %[1]s.id),
+              (SELECT k.master_key_id FROM user_keys k WHERE k.user_id = %[1]s.id)`, usersAlias)
+}
+
+// sealedPII : kolom PII users dalam bentuk tersimpan, nilai kosong disimpan sebagai NULL
+type sealedPII struct {
+	emailHash   sql.NullString
+	emailPrefix []string
+	email       []byte
+	phone       []byte
+	dateOfBirth []byte
+}
+
+func (c *PIICipher) sealPII(key []byte, email, phone string, dateOfBirth *time.Time) (*sealedPII, error) {
+	var s sealedPII
+	var err error
+	if email != "" {
+		s.emailHash = sql.NullString{String: c.EmailIndex(email), Valid: true}
+		s.emailPrefix = c.EmailPrefixIndex(email)
+	}
+	if s.email, err = sealField(key, email); err != nil {
+		return nil, err
+	}
+	if s.phone, err = sealField(key, phone); err != nil {
+		return nil, err
+	}
+	if dateOfBirth != nil {
+		if s.dateOfBirth, err = sealField(key, dateOfBirth.Format(dateLayout)); err != nil {
+			return nil, err
+		}
+	}
+	return &s, nil
+}
+
+func sealField(key []byte, value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return auth.Seal(key, []byte(value))
+}
+
+// openField mengembalikan plain jika kolom belum dienkripsi
+func openField(key, sealed []byte, plain string) (string, error) {
+	if sealed == nil {
+		return plain, nil
+	}
+	if key == nil {
+		return "", entities.ErrUserKeyDestroyed
+	}
+	value, err := auth.Open(key, sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
@@ -0,0 +1,259 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"microservices/auth-service/domain/entities"
+	"strings"
+	"time"
+)
+
+// Kunci advisory lock untuk menyerialkan Append agar hash chain tidak bercabang
+const auditChainLockID = 36_000_001
+
+// errAuditRedactionDisabled : Pseudonymize dipanggil tanpa koneksi role audit_redactor
+var errAuditRedactionDisabled = errors.New("audit redaction requires a connection as an audit_redactor member")
+
+type PostgresAuditRepository struct {
+	db *sql.DB
+	// redactionDB login sebagai anggota role audit_redactor (migrasi 000022), satu-satunya
+	// koneksi yang diizinkan trigger untuk mengubah audit_events. Nil menonaktifkan Pseudonymize.
+	redactionDB *sql.DB
+}
+
+func NewPostgresAuditRepository(db, redactionDB *sql.DB) *PostgresAuditRepository {
+	return &PostgresAuditRepository{db: db, redactionDB: redactionDB}
+}
+
+const auditColumns = `seq, action, actor_id, subject_id, ip, user_agent, app_version, device_id, request_id, metadata, occurred_at, prev_hash, hash, redacted, commitments, nonces`
+
+func (r *PostgresAuditRepository) Append(ctx context.Context, event *entities.AuditEvent) error {
+	metadata := []byte("{}")
+	if len(event.Metadata) > 0 {
+		var err error
+		if metadata, err = json.Marshal(event.Metadata); err != nil {
+			return err
+		}
+	}
+	// Postgres membulatkan ke mikrodetik, hash harus dihitung dari nilai yang tersimpan
+	event.OccurredAt = event.OccurredAt.UTC().Truncate(time.Microsecond)
+	if err := event.Commit(); err != nil {
+		return err
+	}
+	commitments, err := json.Marshal(event.Commitments)
+	if err != nil {
+		return err
+	}
+	nonces, err := json.Marshal(event.Nonces)
+	if err != nil {
+		return err
+	}
+
+	return NewPostgresTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, auditChainLockID); err != nil {
+			return err
+		}
+
+		var prevHash string
+		err := tx.QueryRowContext(ctx, `SELECT hash FROM audit_events ORDER BY seq DESC LIMIT 1`).Scan(&prevHash)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		event.PrevHash = prevHash
+		event.Hash = event.ComputeHash()
+		query := `INSERT INTO audit_events (action, actor_id, subject_id, ip, user_agent, app_version, device_id, request_id, metadata, occurred_at, prev_hash, hash, commitments, nonces)
+                  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+                  RETURNING seq`
+		return tx.QueryRowContext(ctx, query,
+			string(event.Action),
+			event.ActorID,
+			event.SubjectID,
+			event.IP,
+			event.UserAgent,
+			event.AppVersion,
+			event.DeviceID,
+			event.RequestID,
+			string(metadata),
+			event.OccurredAt,
+			event.PrevHash,
+			event.Hash,
+			string(commitments),
+			string(nonces),
+		).Scan(&event.Seq)
+	})
+}
+
+func (r *PostgresAuditRepository) Query(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, int, error) {
+	where, args := auditWhere(filter)
+
+	var total int
+	if err := executor(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_events`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(`SELECT `+auditColumns+`
+              FROM audit_events%s
+              ORDER BY seq DESC
+              LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+	events, err := r.list(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (r *PostgresAuditRepository) ListAfter(ctx context.Context, filter entities.AuditFilter) ([]*entities.AuditEvent, error) {
+	where, args := auditWhere(filter)
+	query := fmt.Sprintf(`SELECT `+auditColumns+`
+              FROM audit_events%s
+              ORDER BY seq
+              LIMIT $%d`, where, len(args)+1)
+	return r.list(ctx, query, append(args, filter.Limit)...)
+}
+
+// Pseudonymize berjalan lewat redactionDB di transaksinya sendiri, karena trigger
+// audit_events_append_only (migrasi 000022) hanya mengizinkan UPDATE dari session anggota
+// role audit_redactor. IP, user agent, device dan request hanya dikosongkan jika user adalah
+// pelakunya; untuk aksi admin terhadap user, data tersebut milik admin. Nilai metadata yang
+// sama dengan userID atau email user (e.g. login gagal, undangan) ikut diganti pseudonym.
+// Nonce setiap field yang diubah dihapus sehingga commitment-nya tidak bisa dicocokkan lagi.
+// Event sebelum migrasi 000022 tidak punya commitment dan tidak bisa dipseudonimkan tanpa
+// merusak rantai, sehingga dilewati.
+func (r *PostgresAuditRepository) Pseudonymize(ctx context.Context, userID, email, pseudonym string) (int, error) {
+	if r.redactionDB == nil {
+		return 0, errAuditRedactionDisabled
+	}
+	query := `WITH matches(value) AS (
+                  SELECT $1::text UNION SELECT lower($3::text) WHERE $3::text <> ''
+              )
+              UPDATE audit_events SET
+                  actor_id = CASE WHEN actor_id = $1 THEN $2 ELSE actor_id END,
+                  subject_id = CASE WHEN subject_id = $1 THEN $2 ELSE subject_id END,
+                  ip = CASE WHEN actor_id = $1 THEN '' ELSE ip END,
+                  user_agent = CASE WHEN actor_id = $1 THEN '' ELSE user_agent END,
+                  app_version = CASE WHEN actor_id = $1 THEN '' ELSE app_version END,
+                  device_id = CASE WHEN actor_id = $1 THEN '' ELSE device_id END,
+                  request_id = CASE WHEN actor_id = $1 THEN '' ELSE request_id END,
+                  metadata = COALESCE((
+                      SELECT jsonb_object_agg(m.key, CASE
+                          WHEN lower(m.value) IN (SELECT value FROM matches) THEN to_jsonb($2::text)
+                          ELSE metadata -> m.key END)
+                      FROM jsonb_each_text(metadata) m
+                  ), '{}'::jsonb),
+                  nonces = nonces
+                      - CASE WHEN actor_id = $1
+                          THEN ARRAY['actor_id', 'ip', 'user_agent', 'app_version', 'device_id', 'request_id']
+                          ELSE '{}'::text[] END
+                      - CASE WHEN subject_id = $1 THEN ARRAY['subject_id'] ELSE '{}'::text[] END
+                      - COALESCE((
+                          SELECT array_agg('metadata.' || m.key)
+                          FROM jsonb_each_text(metadata) m
+                          WHERE lower(m.value) IN (SELECT value FROM matches)
+                      ), '{}'::text[]),
+                  redacted = TRUE
+              WHERE commitments IS NOT NULL
+                AND (actor_id = $1 OR subject_id = $1
+                     OR EXISTS (SELECT 1 FROM jsonb_each_text(metadata) m WHERE lower(m.value) IN (SELECT value FROM matches)))`
+	res, err := r.redactionDB.ExecContext(ctx, query, userID, pseudonym, email)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (r *PostgresAuditRepository) list(ctx context.Context, query string, args ...interface{}) ([]*entities.AuditEvent, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*entities.AuditEvent
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func auditWhere(filter entities.AuditFilter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.ActorID != "" {
+		addCond("actor_id = $%d", filter.ActorID)
+	}
+	if filter.SubjectID != "" {
+		addCond("subject_id = $%d", filter.SubjectID)
+	}
+	if filter.Action != "" {
+		addCond("action = $%d", string(filter.Action))
+	}
+	if filter.From != nil {
+		addCond("occurred_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCond("occurred_at < $%d", *filter.To)
+	}
+	if filter.AfterSeq > 0 {
+		addCond("seq > $%d", filter.AfterSeq)
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+func scanAuditEvent(row rowScanner) (*entities.AuditEvent, error) {
+	var event entities.AuditEvent
+	var action string
+	var metadata, commitments, nonces []byte
+	if err := row.Scan(
+		&event.Seq,
+		&action,
+		&event.ActorID,
+		&event.SubjectID,
+		&event.IP,
+		&event.UserAgent,
+		&event.AppVersion,
+		&event.DeviceID,
+		&event.RequestID,
+		&metadata,
+		&event.OccurredAt,
+		&event.PrevHash,
+		&event.Hash,
+		&event.Redacted,
+		&commitments,
+		&nonces,
+	); err != nil {
+		return nil, err
+	}
+	event.Action = entities.AuditAction(action)
+	if err := json.Unmarshal(metadata, &event.Metadata); err != nil {
+		return nil, err
+	}
+	// NULL untuk event sebelum migrasi 000022
+	if commitments != nil {
+		if err := json.Unmarshal(commitments, &event.Commitments); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(nonces, &event.Nonces); err != nil {
+			return nil, err
+		}
+	}
+	return &event, nil
+}
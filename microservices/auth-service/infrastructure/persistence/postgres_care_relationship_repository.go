@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+)
+
+type PostgresCareRelationshipRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresCareRelationshipRepository(db *sql.DB) *PostgresCareRelationshipRepository {
+	return &PostgresCareRelationshipRepository{db: db}
+}
+
+const careRelationshipColumns = `id, client_id, psychologist_id, status, requested_by, created_at,
+              client_consented_at, psychologist_consented_at, ended_at, ended_by, end_reason`
+
+func (r *PostgresCareRelationshipRepository) CreateCareRelationship(ctx context.Context, rel *entities.CareRelationship) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO care_relationships (id, client_id, psychologist_id, status, requested_by, created_at,
+             client_consented_at, psychologist_consented_at)
+         VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, $7, $8)`,
+		rel.ID, rel.ClientID, rel.PsychologistID, string(rel.Status), rel.RequestedBy, rel.CreatedAt,
+		rel.ClientConsentedAt, rel.PsychologistConsentedAt,
+	)
+	if isUniqueViolation(err) {
+		return entities.ErrCareRelationshipExists
+	}
+	return err
+}
+
+func (r *PostgresCareRelationshipRepository) FindCareRelationship(ctx context.Context, id string) (*entities.CareRelationship, error) {
+	rel, err := scanCareRelationship(executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+careRelationshipColumns+` FROM care_relationships WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return rel, err
+}
+
+func (r *PostgresCareRelationshipRepository) FindOpen(ctx context.Context, clientID, psychologistID string) (*entities.CareRelationship, error) {
+	rel, err := scanCareRelationship(executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+careRelationshipColumns+` FROM care_relationships
+         WHERE client_id = $1 AND psychologist_id = $2 AND status IN ('requested', 'active')`,
+		clientID, psychologistID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return rel, err
+}
+
+func (r *PostgresCareRelationshipRepository) ListByUser(ctx context.Context, userID string) ([]*entities.CareRelationship, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT `+careRelationshipColumns+` FROM care_relationships
+         WHERE client_id = $1 OR psychologist_id = $1
+         ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.CareRelationship
+	for rows.Next() {
+		rel, err := scanCareRelationship(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rel)
+	}
+	return result, rows.Err()
+}
+
+func (r *PostgresCareRelationshipRepository) UpdateCareRelationship(ctx context.Context, rel *entities.CareRelationship, from entities.CareStatus) error {
+	res, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE care_relationships
+         SET status = $3, client_consented_at = $4, psychologist_consented_at = $5,
+             ended_at = $6, ended_by = NULLIF($7, '')::uuid, end_reason = $8
+         WHERE id = $1 AND status = $2`,
+		rel.ID, string(from), string(rel.Status), rel.ClientConsentedAt, rel.PsychologistConsentedAt,
+		rel.EndedAt, rel.EndedBy, rel.EndReason,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrCareRelationshipNotFound
+	}
+	return nil
+}
+
+func scanCareRelationship(row rowScanner) (*entities.CareRelationship, error) {
+	var rel entities.CareRelationship
+	var status string
+	var requestedBy, endedBy sql.NullString
+	if err := row.Scan(&rel.ID, &rel.ClientID, &rel.PsychologistID, &status, &requestedBy, &rel.CreatedAt,
+		&rel.ClientConsentedAt, &rel.PsychologistConsentedAt, &rel.EndedAt, &endedBy, &rel.EndReason); err != nil {
+		return nil, err
+	}
+	rel.Status = entities.CareStatus(status)
+	rel.RequestedBy = requestedBy.String
+	rel.EndedBy = endedBy.String
+	return &rel, nil
+}
@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+type PostgresClientInvitationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresClientInvitationRepository(db *sql.DB) *PostgresClientInvitationRepository {
+	return &PostgresClientInvitationRepository{db: db}
+}
+
+const clientInvitationColumns = `id, psychologist_id, email, pre_link_care, created_at, expires_at,
+              accepted_at, client_id, revoked_at`
+
+func (r *PostgresClientInvitationRepository) CreateInvitation(ctx context.Context, inv *entities.ClientInvitation) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO client_invitations (id, psychologist_id, email, pre_link_care, created_at, expires_at)
+         VALUES ($1, $2, $3, $4, $5, $6)`,
+		inv.ID, inv.PsychologistID, inv.Email, inv.PreLinkCare, inv.CreatedAt, inv.ExpiresAt,
+	)
+	return err
+}
+
+func (r *PostgresClientInvitationRepository) FindInvitation(ctx context.Context, id string) (*entities.ClientInvitation, error) {
+	inv, err := scanClientInvitation(executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+clientInvitationColumns+` FROM client_invitations WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return inv, err
+}
+
+func (r *PostgresClientInvitationRepository) ListByPsychologist(ctx context.Context, psychologistID string) ([]*entities.ClientInvitation, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT `+clientInvitationColumns+` FROM client_invitations
+         WHERE psychologist_id = $1 ORDER BY created_at DESC`, psychologistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.ClientInvitation
+	for rows.Next() {
+		inv, err := scanClientInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, inv)
+	}
+	return result, rows.Err()
+}
+
+func (r *PostgresClientInvitationRepository) AcceptInvitation(ctx context.Context, id, clientID string, acceptedAt time.Time) error {
+	res, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE client_invitations SET accepted_at = $3, client_id = $2
+         WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > $3`,
+		id, clientID, acceptedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrInvalidToken
+	}
+	return nil
+}
+
+func (r *PostgresClientInvitationRepository) RevokeInvitation(ctx context.Context, id, psychologistID string, revokedAt time.Time) error {
+	res, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE client_invitations SET revoked_at = $3
+         WHERE id = $1 AND psychologist_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL`,
+		id, psychologistID, revokedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrInvitationNotFound
+	}
+	return nil
+}
+
+func scanClientInvitation(row rowScanner) (*entities.ClientInvitation, error) {
+	var inv entities.ClientInvitation
+	var clientID sql.NullString
+	if err := row.Scan(&inv.ID, &inv.PsychologistID, &inv.Email, &inv.PreLinkCare, &inv.CreatedAt, &inv.ExpiresAt,
+		&inv.AcceptedAt, &clientID, &inv.RevokedAt); err != nil {
+		return nil, err
+	}
+	inv.ClientID = clientID.String
+	return &inv, nil
+}
@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type PostgresConsentRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresConsentRepository(db *sql.DB) *PostgresConsentRepository {
+	return &PostgresConsentRepository{db: db}
+}
+
+func (r *PostgresConsentRepository) PublishDocument(ctx context.Context, doc *entities.ConsentDocument) error {
+	roles := make([]string, len(doc.Roles))
+	for i, role := range doc.Roles {
+		roles[i] = string(role)
+	}
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO consent_documents (kind, version, title, url, required, roles, published_at)
+         VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		string(doc.Kind), doc.Version, doc.Title, doc.URL, doc.Required, pq.Array(roles), doc.PublishedAt,
+	)
+	if isUniqueViolation(err) {
+		return entities.ErrConsentVersionExists
+	}
+	return err
+}
+
+func (r *PostgresConsentRepository) ListCurrentDocuments(ctx context.Context) ([]*entities.ConsentDocument, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT DISTINCT ON (kind) kind, version, title, url, required, roles, published_at
+         FROM consent_documents
+         ORDER BY kind, published_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.ConsentDocument
+	for rows.Next() {
+		var doc entities.ConsentDocument
+		var kind string
+		var roles []string
+		if err := rows.Scan(&kind, &doc.Version, &doc.Title, &doc.URL, &doc.Required, pq.Array(&roles), &doc.PublishedAt); err != nil {
+			return nil, err
+		}
+		doc.Kind = entities.ConsentKind(kind)
+		for _, role := range roles {
+			doc.Roles = append(doc.Roles, entities.Role(role))
+		}
+		result = append(result, &doc)
+	}
+	return result, rows.Err()
+}
+
+func (r *PostgresConsentRepository) CreateRecord(ctx context.Context, record *entities.ConsentRecord) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO consent_records (id, user_id, kind, version, accepted_at, ip, user_agent)
+         VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		record.ID, record.UserID, string(record.Kind), record.Version, record.AcceptedAt, record.IP, record.UserAgent,
+	)
+	return err
+}
+
+func (r *PostgresConsentRepository) ListRecords(ctx context.Context, userID string) ([]*entities.ConsentRecord, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT id, user_id, kind, version, accepted_at, ip, user_agent, withdrawn_at
+         FROM consent_records WHERE user_id = $1
+         ORDER BY accepted_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.ConsentRecord
+	for rows.Next() {
+		var rec entities.ConsentRecord
+		var kind string
+		if err := rows.Scan(&rec.ID, &rec.UserID, &kind, &rec.Version, &rec.AcceptedAt, &rec.IP, &rec.UserAgent, &rec.WithdrawnAt); err != nil {
+			return nil, err
+		}
+		rec.Kind = entities.ConsentKind(kind)
+		result = append(result, &rec)
+	}
+	return result, rows.Err()
+}
+
+func (r *PostgresConsentRepository) WithdrawRecords(ctx context.Context, userID string, kind entities.ConsentKind, withdrawnAt time.Time) (int, error) {
+	res, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE consent_records SET withdrawn_at = $3
+         WHERE user_id = $1 AND kind = $2 AND withdrawn_at IS NULL`,
+		userID, string(kind), withdrawnAt)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
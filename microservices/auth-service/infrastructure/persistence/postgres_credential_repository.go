@@ -0,0 +1,152 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type PostgresCredentialRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresCredentialRepository(db *sql.DB) *PostgresCredentialRepository {
+	return &PostgresCredentialRepository{db: db}
+}
+
+const credentialColumns = `id, user_id, license_number, jurisdiction, document_refs, status,
+              COALESCE(reviewed_by::text, ''), review_note, submitted_at, reviewed_at, expires_at`
+
+// CreateCredential lebih dulu menandai expired pengajuan pending yang melewati
+// CredentialPendingTTL, agar indeks satu-pending-per-user tidak menahan pengajuan ulang
+// sebelum worker ExpireCredentials berjalan
+func (r *PostgresCredentialRepository) CreateCredential(ctx context.Context, cred *entities.PsychologistCredential) error {
+	err := NewPostgresTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE psychologist_credentials SET status = 'expired'
+             WHERE user_id = $1 AND status = 'pending' AND submitted_at <= $2`,
+			cred.UserID, cred.SubmittedAt.Add(-entities.CredentialPendingTTL),
+		); err != nil {
+			return err
+		}
+		query := `INSERT INTO psychologist_credentials (id, user_id, license_number, jurisdiction, document_refs, status, submitted_at)
+                  VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		_, err := tx.ExecContext(ctx, query,
+			cred.ID,
+			cred.UserID,
+			cred.LicenseNumber,
+			cred.Jurisdiction,
+			pq.Array(cred.DocumentRefs),
+			string(cred.Status),
+			cred.SubmittedAt,
+		)
+		return err
+	})
+	if isUniqueViolation(err) {
+		return entities.ErrCredentialPending
+	}
+	return err
+}
+
+func (r *PostgresCredentialRepository) FindByID(ctx context.Context, id string) (*entities.PsychologistCredential, error) {
+	query := `SELECT ` + credentialColumns + ` FROM psychologist_credentials WHERE id = $1`
+	return scanCredential(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *PostgresCredentialRepository) ListByUserID(ctx context.Context, userID string) ([]*entities.PsychologistCredential, error) {
+	query := `SELECT ` + credentialColumns + `
+              FROM psychologist_credentials WHERE user_id = $1
+              ORDER BY submitted_at DESC`
+	return r.list(ctx, query, userID)
+}
+
+func (r *PostgresCredentialRepository) ListByStatus(ctx context.Context, status entities.CredentialStatus, limit, offset int) ([]*entities.PsychologistCredential, error) {
+	query := `SELECT ` + credentialColumns + `
+              FROM psychologist_credentials WHERE status = $1
+              ORDER BY submitted_at
+              LIMIT $2 OFFSET $3`
+	return r.list(ctx, query, string(status), limit, offset)
+}
+
+func (r *PostgresCredentialRepository) UpdateReview(ctx context.Context, cred *entities.PsychologistCredential) error {
+	query := `UPDATE psychologist_credentials
+              SET status = $2, reviewed_by = NULLIF($3, '')::uuid, review_note = $4, reviewed_at = $5, expires_at = $6
+              WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query,
+		cred.ID,
+		string(cred.Status),
+		cred.ReviewedBy,
+		cred.ReviewNote,
+		cred.ReviewedAt,
+		cred.ExpiresAt,
+	)
+	return err
+}
+
+func (r *PostgresCredentialRepository) ExpireCredentials(ctx context.Context, now time.Time) (int, error) {
+	query := `UPDATE psychologist_credentials SET status = 'expired'
+              WHERE (status = 'verified' AND expires_at IS NOT NULL AND expires_at <= $1)
+                 OR (status = 'pending' AND submitted_at <= $2)`
+	res, err := r.db.ExecContext(ctx, query, now, now.Add(-entities.CredentialPendingTTL))
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (r *PostgresCredentialRepository) list(ctx context.Context, query string, args ...interface{}) ([]*entities.PsychologistCredential, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var creds []*entities.PsychologistCredential
+	for rows.Next() {
+		cred, err := scanCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, cred)
+	}
+	return creds, rows.Err()
+}
+
+func scanCredential(row rowScanner) (*entities.PsychologistCredential, error) {
+	var cred entities.PsychologistCredential
+	var status string
+	var reviewedAt, expiresAt sql.NullTime
+	err := row.Scan(
+		&cred.ID,
+		&cred.UserID,
+		&cred.LicenseNumber,
+		&cred.Jurisdiction,
+		pq.Array(&cred.DocumentRefs),
+		&status,
+		&cred.ReviewedBy,
+		&cred.ReviewNote,
+		&cred.SubmittedAt,
+		&reviewedAt,
+		&expiresAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	cred.Status = entities.CredentialStatus(status)
+	cred.ReviewedAt = nullTimePtr(reviewedAt)
+	cred.ExpiresAt = nullTimePtr(expiresAt)
+	return &cred, nil
+}
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+type PostgresDataExportRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresDataExportRepository(db *sql.DB) *PostgresDataExportRepository {
+	return &PostgresDataExportRepository{db: db}
+}
+
+const dataExportColumns = `id, user_id, status, requested_at, started_at, completed_at, expires_at, attempts, error, sha256`
+
+func (r *PostgresDataExportRepository) CreateExport(ctx context.Context, export *entities.DataExport) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO data_exports (id, user_id, status, requested_at) VALUES ($1, $2, $3, $4)`,
+		export.ID, export.UserID, string(export.Status), export.RequestedAt,
+	)
+	if isUniqueViolation(err) {
+		return entities.ErrDataExportInProgress
+	}
+	return err
+}
+
+func (r *PostgresDataExportRepository) FindExport(ctx context.Context, id string, withArchive bool) (*entities.DataExport, error) {
+	query := `SELECT ` + dataExportColumns + `, NULL::BYTEA FROM data_exports WHERE id = $1`
+	if withArchive {
+		query = `SELECT ` + dataExportColumns + `, archive FROM data_exports WHERE id = $1`
+	}
+	export, err := scanDataExport(executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return export, err
+}
+
+func (r *PostgresDataExportRepository) FindActiveByUser(ctx context.Context, userID string) (*entities.DataExport, error) {
+	export, err := scanDataExport(executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+dataExportColumns+`, NULL::BYTEA FROM data_exports
+         WHERE user_id = $1 AND status IN ('pending', 'processing')`, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return export, err
+}
+
+// ClaimPending memakai SKIP LOCKED sehingga beberapa worker bisa berjalan bersamaan
+func (r *PostgresDataExportRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.DataExport, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`UPDATE data_exports
+         SET status = 'processing', started_at = NOW(), attempts = attempts + 1,
+             lease_until = NOW() + $2 * INTERVAL '1 millisecond'
+         WHERE id IN (
+             SELECT id FROM data_exports
+             WHERE status IN ('pending', 'processing') AND COALESCE(lease_until, requested_at) <= NOW()
+             ORDER BY requested_at
+             LIMIT $1
+             FOR UPDATE SKIP LOCKED
+         )
+         RETURNING `+dataExportColumns+`, NULL::BYTEA`, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exports []*entities.DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, export)
+	}
+	return exports, rows.Err()
+}
+
+func (r *PostgresDataExportRepository) CompleteExport(ctx context.Context, id string, archive []byte, sha256 string, completedAt, expiresAt time.Time) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE data_exports
+         SET status = 'completed', archive = $2, sha256 = $3, completed_at = $4, expires_at = $5,
+             lease_until = NULL, error = ''
+         WHERE id = $1`,
+		id, archive, sha256, completedAt, expiresAt)
+	return err
+}
+
+func (r *PostgresDataExportRepository) MarkFailed(ctx context.Context, id, lastError string, retryAt time.Time) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE data_exports SET status = 'pending', error = $2, lease_until = $3 WHERE id = $1`,
+		id, lastError, retryAt)
+	return err
+}
+
+func (r *PostgresDataExportRepository) MarkDead(ctx context.Context, id, lastError string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE data_exports SET status = 'failed', error = $2, lease_until = NULL WHERE id = $1`,
+		id, lastError)
+	return err
+}
+
+func (r *PostgresDataExportRepository) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	res, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE data_exports SET status = 'expired', archive = NULL
+         WHERE status = 'completed' AND expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func scanDataExport(row rowScanner) (*entities.DataExport, error) {
+	var export entities.DataExport
+	var status string
+	if err := row.Scan(
+		&export.ID,
+		&export.UserID,
+		&status,
+		&export.RequestedAt,
+		&export.StartedAt,
+		&export.CompletedAt,
+		&export.ExpiresAt,
+		&export.Attempts,
+		&export.Error,
+		&export.SHA256,
+		&export.Archive,
+	); err != nil {
+		return nil, err
+	}
+	export.Status = entities.DataExportStatus(status)
+	return &export, nil
+}
@@ -0,0 +1,174 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+	"time"
+)
+
+type PostgresErasureRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresErasureRepository(db *sql.DB) *PostgresErasureRepository {
+	return &PostgresErasureRepository{db: db}
+}
+
+func (r *PostgresErasureRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT id FROM users
+         WHERE deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= $1
+         ORDER BY deletion_scheduled_at
+         LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Data pribadi di tabel lain yang terhubung lewat ID user dihapus atau dikosongkan
+var erasureStatements = []string{
+	`DELETE FROM user_identities WHERE user_id = $1`,
+	`DELETE FROM user_devices WHERE user_id = $1`,
+	`DELETE FROM login_history WHERE user_id = $1`,
+	`DELETE FROM data_exports WHERE user_id = $1`,
+	`DELETE FROM notifications WHERE user_id = $1::text`,
+	`UPDATE consent_records SET ip = '', user_agent = '' WHERE user_id = $1`,
+	`UPDATE psychologist_credentials SET document_refs = '{}' WHERE user_id = $1`,
+	`UPDATE guardianships SET guardian_email = '' WHERE guardian_id = $1 OR minor_id = $1`,
+	`UPDATE client_invitations SET email = '' WHERE client_id = $1`,
+	// Event yang sudah terkirim tidak dibutuhkan lagi; yang belum terkirim tetap menunggu relay
+	`DELETE FROM outbox WHERE aggregate_id = $1::text AND published_at IS NOT NULL`,
+}
+
+// Undangan hanya bisa dicocokkan lewat email user; diberikan pemanggil karena email di users terenkripsi
+var emailErasureStatements = []string{
+	`UPDATE client_invitations SET email = '' WHERE lower(email) = lower($1)`,
+	`UPDATE organization_invitations SET email = '' WHERE lower(email) = lower($1)`,
+}
+
+// ID, role dan created_at dipertahankan untuk statistik dan relasi yang masih menunjuk user
+const anonymizeUserQuery = `UPDATE users SET
+         email = NULL,
+         email_hash = NULL,
+         email_prefix_index = NULL,
+         email_enc = NULL,
+         phone_enc = NULL,
+         date_of_birth_enc = NULL,
+         password_hash = '',
+         phone = '',
+         phone_verified_at = NULL,
+         mfa_channel = '',
+         date_of_birth = NULL,
+         email_verified_at = NULL,
+         disabled_reason = '',
+         status = 'deleted',
+         status_reason = 'erased',
+         status_changed_at = $2,
+         deletion_scheduled_at = NULL,
+         updated_at = NOW()
+     WHERE id = $1`
+
+func (r *PostgresErasureRepository) EraseUser(ctx context.Context, userID, email string, erasedAt time.Time) error {
+	return NewPostgresTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+		for _, stmt := range erasureStatements {
+			if _, err := tx.ExecContext(ctx, stmt, userID); err != nil {
+				return err
+			}
+		}
+		if email != "" {
+			for _, stmt := range emailErasureStatements {
+				if _, err := tx.ExecContext(ctx, stmt, email); err != nil {
+					return err
+				}
+			}
+		}
+		res, err := tx.ExecContext(ctx, anonymizeUserQuery, userID, erasedAt)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return entities.ErrUserNotFound
+		}
+		return nil
+	})
+}
+
+// PostgresUserKeyRepository menyimpan data key user dalam bentuk terbungkus master key KMS
+type PostgresUserKeyRepository struct {
+	db     *sql.DB
+	cipher *PIICipher
+}
+
+func NewPostgresUserKeyRepository(db *sql.DB, cipher *PIICipher) *PostgresUserKeyRepository {
+	return &PostgresUserKeyRepository{db: db, cipher: cipher}
+}
+
+func (r *PostgresUserKeyRepository) FindKey(ctx context.Context, userID string) ([]byte, error) {
+	var key wrappedKey
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT data_key, master_key_id FROM user_keys WHERE user_id = $1`, userID,
+	).Scan(&key.key, &key.masterKeyID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.cipher.unwrapKey(ctx, key)
+}
+
+// createKey hanya untuk user yang masih ada dan belum dihapus: kunci user yang sudah
+// di-shred tidak boleh muncul kembali
+func (r *PostgresUserKeyRepository) createKey(ctx context.Context, userID string, key []byte) error {
+	keyID, wrapped, err := r.cipher.kms.WrapKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	_, err = executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO user_keys (user_id, data_key, master_key_id)
+         SELECT id, $2, $3 FROM users WHERE id = $1 AND status <> $4
+         ON CONFLICT (user_id) DO NOTHING`, userID, wrapped, keyID, string(entities.StatusDeleted))
+	return err
+}
+
+func (r *PostgresUserKeyRepository) DestroyKey(ctx context.Context, userID string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM user_keys WHERE user_id = $1`, userID)
+	return err
+}
+
+// EnsureKey membuat kunci user (termasuk user lama yang dibuat sebelum enkripsi PII) jika belum
+// ada, lalu membaca ulang kunci yang tersimpan karena pemanggilan bersamaan bisa lebih dulu
+// menyimpan kunci lain. ErrUserKeyDestroyed untuk user yang sudah dihapus atau tidak ada.
+func (r *PostgresUserKeyRepository) EnsureKey(ctx context.Context, userID string) ([]byte, error) {
+	key, err := r.FindKey(ctx, userID)
+	if err != nil || key != nil {
+		return key, err
+	}
+	if key, err = auth.GenerateDataKey(); err != nil {
+		return nil, err
+	}
+	if err := r.createKey(ctx, userID, key); err != nil {
+		return nil, err
+	}
+	if key, err = r.FindKey(ctx, userID); err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, entities.ErrUserKeyDestroyed
+	}
+	return key, nil
+}
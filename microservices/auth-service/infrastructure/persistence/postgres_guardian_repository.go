@@ -0,0 +1,115 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+type PostgresGuardianRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresGuardianRepository(db *sql.DB) *PostgresGuardianRepository {
+	return &PostgresGuardianRepository{db: db}
+}
+
+const guardianshipColumns = `id, minor_id, COALESCE(guardian_id::text, ''), guardian_email, relationship, status,
+              COALESCE(consent_token_hash, ''), consent_expires_at, created_at, consented_at`
+
+func (r *PostgresGuardianRepository) CreateGuardianship(ctx context.Context, g *entities.Guardianship) error {
+	query := `INSERT INTO guardianships (id, minor_id, guardian_id, guardian_email, relationship, status,
+                  consent_token_hash, consent_expires_at, created_at)
+              VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, NULLIF($7, ''), $8, $9)`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		g.ID,
+		g.MinorID,
+		g.GuardianID,
+		g.GuardianEmail,
+		string(g.Relationship),
+		string(g.Status),
+		g.ConsentTokenHash,
+		g.ConsentExpiresAt,
+		g.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresGuardianRepository) FindByConsentToken(ctx context.Context, tokenHash string) (*entities.Guardianship, error) {
+	query := `SELECT ` + guardianshipColumns + ` FROM guardianships WHERE consent_token_hash = $1`
+	return r.findOne(ctx, query, tokenHash)
+}
+
+func (r *PostgresGuardianRepository) ActivateGuardianship(ctx context.Context, id, guardianID string, consentedAt time.Time) error {
+	query := `UPDATE guardianships
+              SET guardian_id = $2, status = 'active', consented_at = $3, consent_token_hash = NULL
+              WHERE id = $1 AND status = 'pending'`
+	res, err := executor(ctx, r.db).ExecContext(ctx, query, id, guardianID, consentedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrGuardianshipNotFound
+	}
+	return nil
+}
+
+func (r *PostgresGuardianRepository) FindActive(ctx context.Context, guardianID, minorID string) (*entities.Guardianship, error) {
+	query := `SELECT ` + guardianshipColumns + ` FROM guardianships
+              WHERE guardian_id = $1 AND minor_id = $2 AND status = 'active'`
+	return r.findOne(ctx, query, guardianID, minorID)
+}
+
+func (r *PostgresGuardianRepository) ListActiveByGuardian(ctx context.Context, guardianID string) ([]*entities.Guardianship, error) {
+	query := `SELECT ` + guardianshipColumns + ` FROM guardianships
+              WHERE guardian_id = $1 AND status = 'active'
+              ORDER BY consented_at`
+	rows, err := r.db.QueryContext(ctx, query, guardianID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.Guardianship
+	for rows.Next() {
+		g, err := scanGuardianship(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, g)
+	}
+	return result, rows.Err()
+}
+
+func (r *PostgresGuardianRepository) findOne(ctx context.Context, query string, args ...interface{}) (*entities.Guardianship, error) {
+	g, err := scanGuardianship(executor(ctx, r.db).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return g, err
+}
+
+func scanGuardianship(row rowScanner) (*entities.Guardianship, error) {
+	var g entities.Guardianship
+	var relationship, status string
+	var expiresAt sql.NullTime
+	if err := row.Scan(
+		&g.ID,
+		&g.MinorID,
+		&g.GuardianID,
+		&g.GuardianEmail,
+		&relationship,
+		&status,
+		&g.ConsentTokenHash,
+		&expiresAt,
+		&g.CreatedAt,
+		&g.ConsentedAt,
+	); err != nil {
+		return nil, err
+	}
+	g.Relationship = entities.GuardianRelationship(relationship)
+	g.Status = entities.GuardianshipStatus(status)
+	g.ConsentExpiresAt = expiresAt.Time
+	return &g, nil
+}
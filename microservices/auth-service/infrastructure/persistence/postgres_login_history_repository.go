@@ -0,0 +1,131 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+
+	"github.com/lib/pq"
+)
+
+type PostgresLoginHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresLoginHistoryRepository(db *sql.DB) *PostgresLoginHistoryRepository {
+	return &PostgresLoginHistoryRepository{db: db}
+}
+
+func (r *PostgresLoginHistoryRepository) FindDevice(ctx context.Context, userID, fingerprint string) (*entities.KnownDevice, error) {
+	query := `SELECT user_id, fingerprint, user_agent, app_version, last_ip, last_country, first_seen_at, last_seen_at
+              FROM user_devices WHERE user_id = $1 AND fingerprint = $2`
+	var d entities.KnownDevice
+	err := r.db.QueryRowContext(ctx, query, userID, fingerprint).Scan(
+		&d.UserID,
+		&d.Fingerprint,
+		&d.UserAgent,
+		&d.AppVersion,
+		&d.LastIP,
+		&d.LastCountry,
+		&d.FirstSeenAt,
+		&d.LastSeenAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r *PostgresLoginHistoryRepository) UpsertDevice(ctx context.Context, d *entities.KnownDevice) error {
+	query := `INSERT INTO user_devices (user_id, fingerprint, user_agent, app_version, last_ip, last_country, first_seen_at, last_seen_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+              ON CONFLICT (user_id, fingerprint) DO UPDATE
+              SET user_agent = EXCLUDED.user_agent, app_version = EXCLUDED.app_version,
+                  last_ip = EXCLUDED.last_ip, last_country = EXCLUDED.last_country, last_seen_at = EXCLUDED.last_seen_at`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		d.UserID,
+		d.Fingerprint,
+		d.UserAgent,
+		d.AppVersion,
+		d.LastIP,
+		d.LastCountry,
+		d.FirstSeenAt,
+		d.LastSeenAt,
+	)
+	return err
+}
+
+func (r *PostgresLoginHistoryRepository) LastLogin(ctx context.Context, userID string) (*entities.LoginRecord, error) {
+	query := `SELECT user_id, fingerprint, ip, country, city, latitude, longitude, risk_score, risk_reasons, occurred_at
+              FROM login_history WHERE user_id = $1
+              ORDER BY occurred_at DESC LIMIT 1`
+	var rec entities.LoginRecord
+	var country, city string
+	var lat, lon sql.NullFloat64
+	var reasons []string
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&rec.UserID,
+		&rec.Fingerprint,
+		&rec.IP,
+		&country,
+		&city,
+		&lat,
+		&lon,
+		&rec.RiskScore,
+		pq.Array(&reasons),
+		&rec.OccurredAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if lat.Valid && lon.Valid {
+		rec.Location = &entities.GeoLocation{Country: country, City: city, Latitude: lat.Float64, Longitude: lon.Float64}
+	}
+	for _, reason := range reasons {
+		rec.RiskReasons = append(rec.RiskReasons, entities.RiskReason(reason))
+	}
+	return &rec, nil
+}
+
+func (r *PostgresLoginHistoryRepository) HasLoginFromCountry(ctx context.Context, userID, country string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM login_history WHERE user_id = $1 AND country = $2)`
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, userID, country).Scan(&exists)
+	return exists, err
+}
+
+func (r *PostgresLoginHistoryRepository) RecordLogin(ctx context.Context, rec *entities.LoginRecord) error {
+	var country, city string
+	var lat, lon sql.NullFloat64
+	if rec.Location != nil {
+		country, city = rec.Location.Country, rec.Location.City
+		lat = sql.NullFloat64{Float64: rec.Location.Latitude, Valid: true}
+		lon = sql.NullFloat64{Float64: rec.Location.Longitude, Valid: true}
+	}
+	reasons := make([]string, len(rec.RiskReasons))
+	for i, reason := range rec.RiskReasons {
+		reasons[i] = string(reason)
+	}
+
+	query := `INSERT INTO login_history (user_id, fingerprint, ip, country, city, latitude, longitude, risk_score, risk_reasons, occurred_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query,
+		rec.UserID,
+		rec.Fingerprint,
+		rec.IP,
+		country,
+		city,
+		lat,
+		lon,
+		rec.RiskScore,
+		pq.Array(reasons),
+		rec.OccurredAt,
+	)
+	return err
+}
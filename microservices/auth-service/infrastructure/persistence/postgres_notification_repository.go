@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"microservices/auth-service/domain/entities"
+	"sort"
+	"time"
+)
+
+type PostgresNotificationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresNotificationRepository(db *sql.DB) *PostgresNotificationRepository {
+	return &PostgresNotificationRepository{db: db}
+}
+
+func (r *PostgresNotificationRepository) Enqueue(ctx context.Context, n *entities.Notification) error {
+	data, err := json.Marshal(n.Data)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO notifications (id, kind, user_id, recipient, locale, data, status, next_attempt_at, created_at)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
+		n.ID,
+		string(n.Kind),
+		n.UserID,
+		n.Recipient,
+		n.Locale,
+		string(data),
+		string(n.Status),
+		n.NextAttemptAt,
+		n.CreatedAt,
+	)
+	return err
+}
+
+// ClaimPending memakai SKIP LOCKED sehingga beberapa dispatcher bisa berjalan bersamaan
+func (r *PostgresNotificationRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.Notification, error) {
+	query := `UPDATE notifications SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+              WHERE id IN (
+                  SELECT id FROM notifications
+                  WHERE status = 'pending' AND next_attempt_at <= NOW()
+                  ORDER BY created_at
+                  LIMIT $1
+                  FOR UPDATE SKIP LOCKED
+              )
+              RETURNING id, kind, user_id, recipient, locale, data, status, attempts, last_error, next_attempt_at, created_at, sent_at`
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*entities.Notification
+	for rows.Next() {
+		var n entities.Notification
+		var kind, status string
+		var data []byte
+		if err := rows.Scan(
+			&n.ID,
+			&kind,
+			&n.UserID,
+			&n.Recipient,
+			&n.Locale,
+			&data,
+			&status,
+			&n.Attempts,
+			&n.LastError,
+			&n.NextAttemptAt,
+			&n.CreatedAt,
+			&n.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &n.Data); err != nil {
+			return nil, err
+		}
+		n.Kind = entities.NotificationKind(kind)
+		n.Status = entities.NotificationStatus(status)
+		notifications = append(notifications, &n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING tidak menjamin urutan
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.Before(notifications[j].CreatedAt)
+	})
+	return notifications, nil
+}
+
+func (r *PostgresNotificationRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	query := `UPDATE notifications SET status = 'sent', sent_at = $2, attempts = attempts + 1, last_error = '', data = '{}'
+              WHERE id = $1`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, id, sentAt)
+	return err
+}
+
+func (r *PostgresNotificationRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	query := `UPDATE notifications SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, id, lastError, nextAttemptAt)
+	return err
+}
+
+func (r *PostgresNotificationRepository) MarkDead(ctx context.Context, id string, lastError string) error {
+	query := `UPDATE notifications SET status = 'dead', attempts = attempts + 1, last_error = $2, data = '{}' WHERE id = $1`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, id, lastError)
+	return err
+}
@@ -0,0 +1,254 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"time"
+)
+
+type PostgresOrganizationRepository struct {
+	db     *sql.DB
+	cipher *PIICipher
+}
+
+func NewPostgresOrganizationRepository(db *sql.DB, cipher *PIICipher) *PostgresOrganizationRepository {
+	return &PostgresOrganizationRepository{db: db, cipher: cipher}
+}
+
+// tenant mengambil organisasi aktif dari ctx; semua query tenant-scoped wajib memakainya
+func tenant(ctx context.Context) (string, error) {
+	orgID, ok := entities.TenantFromContext(ctx)
+	if !ok {
+		return "", entities.ErrTenantRequired
+	}
+	return orgID, nil
+}
+
+func (r *PostgresOrganizationRepository) CreateOrganization(ctx context.Context, org *entities.Organization, owner *entities.Membership) error {
+	db := executor(ctx, r.db)
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO organizations (id, name, created_by, created_at) VALUES ($1, $2, $3, $4)`,
+		org.ID, org.Name, org.CreatedBy, org.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx,
+		`INSERT INTO organization_members (org_id, user_id, role, created_at) VALUES ($1, $2, $3, $4)`,
+		org.ID, owner.UserID, string(owner.Role), owner.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresOrganizationRepository) FindOrganization(ctx context.Context, id string) (*entities.Organization, error) {
+	var org entities.Organization
+	var createdBy sql.NullString
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, name, created_by, created_at FROM organizations WHERE id = $1`, id,
+	).Scan(&org.ID, &org.Name, &createdBy, &org.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	org.CreatedBy = createdBy.String
+	return &org, nil
+}
+
+func (r *PostgresOrganizationRepository) ListMembershipsByUser(ctx context.Context, userID string) ([]*entities.Membership, error) {
+	query := `SELECT ` + membershipColumns + `
+              FROM organization_members m
+              JOIN organizations o ON o.id = m.org_id
+              JOIN users u ON u.id = m.user_id
+              WHERE m.user_id = $1
+              ORDER BY o.name`
+	return r.listMemberships(ctx, query, userID)
+}
+
+func (r *PostgresOrganizationRepository) FindInvitationByToken(ctx context.Context, tokenHash string) (*entities.OrgInvitation, error) {
+	var inv entities.OrgInvitation
+	var role string
+	var invitedBy sql.NullString
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT id, org_id, email, role, token_hash, invited_by, created_at, expires_at, accepted_at
+         FROM organization_invitations WHERE token_hash = $1`, tokenHash,
+	).Scan(&inv.ID, &inv.OrgID, &inv.Email, &role, &inv.TokenHash, &invitedBy, &inv.CreatedAt, &inv.ExpiresAt, &inv.AcceptedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	inv.Role = entities.OrgRole(role)
+	inv.InvitedBy = invitedBy.String
+	return &inv, nil
+}
+
+func (r *PostgresOrganizationRepository) FindMember(ctx context.Context, userID string) (*entities.Membership, error) {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + membershipColumns + `
+              FROM organization_members m
+              JOIN organizations o ON o.id = m.org_id
+              JOIN users u ON u.id = m.user_id
+              WHERE m.org_id = $1 AND m.user_id = $2`
+	m, err := r.scanMembership(ctx, executor(ctx, r.db).QueryRowContext(ctx, query, orgID, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return m, err
+}
+
+func (r *PostgresOrganizationRepository) ListMembers(ctx context.Context) ([]*entities.Membership, error) {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + membershipColumns + `
+              FROM organization_members m
+              JOIN organizations o ON o.id = m.org_id
+              JOIN users u ON u.id = m.user_id
+              WHERE m.org_id = $1
+              ORDER BY m.created_at`
+	return r.listMemberships(ctx, query, orgID)
+}
+
+func (r *PostgresOrganizationRepository) AddMember(ctx context.Context, m *entities.Membership) error {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO organization_members (org_id, user_id, role, created_at) VALUES ($1, $2, $3, $4)`,
+		orgID, m.UserID, string(m.Role), m.CreatedAt,
+	)
+	if isUniqueViolation(err) {
+		return entities.ErrAlreadyOrgMember
+	}
+	if err == nil {
+		m.OrgID = orgID
+	}
+	return err
+}
+
+func (r *PostgresOrganizationRepository) SetMemberRole(ctx context.Context, userID string, role entities.OrgRole) error {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return err
+	}
+	return r.execMember(ctx, `UPDATE organization_members SET role = $3 WHERE org_id = $1 AND user_id = $2`,
+		orgID, userID, string(role))
+}
+
+func (r *PostgresOrganizationRepository) RemoveMember(ctx context.Context, userID string) error {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return err
+	}
+	return r.execMember(ctx, `DELETE FROM organization_members WHERE org_id = $1 AND user_id = $2`, orgID, userID)
+}
+
+func (r *PostgresOrganizationRepository) CountMembersWithRole(ctx context.Context, role entities.OrgRole) (int, error) {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	err = executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM organization_members WHERE org_id = $1 AND role = $2`, orgID, string(role),
+	).Scan(&n)
+	return n, err
+}
+
+func (r *PostgresOrganizationRepository) CreateInvitation(ctx context.Context, inv *entities.OrgInvitation) error {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = executor(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO organization_invitations (id, org_id, email, role, token_hash, invited_by, created_at, expires_at)
+         VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7, $8)`,
+		inv.ID, orgID, inv.Email, string(inv.Role), inv.TokenHash, inv.InvitedBy, inv.CreatedAt, inv.ExpiresAt,
+	)
+	if err == nil {
+		inv.OrgID = orgID
+	}
+	return err
+}
+
+func (r *PostgresOrganizationRepository) MarkInvitationAccepted(ctx context.Context, id string, acceptedAt time.Time) error {
+	orgID, err := tenant(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE organization_invitations SET accepted_at = $3
+         WHERE id = $1 AND org_id = $2 AND accepted_at IS NULL`, id, orgID, acceptedAt)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrInvalidToken
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationRepository) execMember(ctx context.Context, query string, args ...interface{}) error {
+	res, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotOrgMember
+	}
+	return nil
+}
+
+func (r *PostgresOrganizationRepository) listMemberships(ctx context.Context, query string, args ...interface{}) ([]*entities.Membership, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.Membership
+	for rows.Next() {
+		m, err := r.scanMembership(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, rows.Err()
+}
+
+// Email anggota diambil dari users (terenkripsi) beserta data key user
+var membershipColumns = `m.org_id, o.name, m.user_id, u.email, u.email_enc, m.role, m.created_at,
+              ` + userKeyColumns("u")
+
+func (r *PostgresOrganizationRepository) scanMembership(ctx context.Context, row rowScanner) (*entities.Membership, error) {
+	var m entities.Membership
+	var role string
+	var email sql.NullString
+	var emailEnc []byte
+	var key wrappedKey
+	if err := row.Scan(&m.OrgID, &m.OrgName, &m.UserID, &email, &emailEnc, &role, &m.CreatedAt, &key.key, &key.masterKeyID); err != nil {
+		return nil, err
+	}
+	m.Role = entities.OrgRole(role)
+	m.Email = email.String
+	if emailEnc != nil {
+		dataKey, err := r.cipher.unwrapKey(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if m.Email, err = openField(dataKey, emailEnc, ""); err != nil {
+			return nil, err
+		}
+	}
+	return &m, nil
+}
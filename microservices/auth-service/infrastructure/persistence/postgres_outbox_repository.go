@@ -0,0 +1,113 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+	"sort"
+	"time"
+)
+
+type PostgresOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{db: db}
+}
+
+func (r *PostgresOutboxRepository) Enqueue(ctx context.Context, events ...*entities.DomainEvent) error {
+	query := `INSERT INTO outbox (id, event_type, aggregate_id, payload, occurred_at, next_attempt_at)
+              VALUES ($1, $2, $3, $4, $5, $5)`
+	for _, e := range events {
+		if _, err := executor(ctx, r.db).ExecContext(ctx, query,
+			e.ID,
+			string(e.Type),
+			e.AggregateID,
+			string(e.Payload), // []byte dikirim lib/pq sebagai bytea
+			e.OccurredAt,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimPending memakai SKIP LOCKED sehingga beberapa relay bisa berjalan bersamaan
+func (r *PostgresOutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entities.DomainEvent, error) {
+	query := `UPDATE outbox SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+              WHERE id IN (
+                  SELECT id FROM outbox
+                  WHERE published_at IS NULL AND next_attempt_at <= NOW()
+                  ORDER BY occurred_at
+                  LIMIT $1
+                  FOR UPDATE SKIP LOCKED
+              )
+              RETURNING id, event_type, aggregate_id, payload, occurred_at, attempts, last_error, next_attempt_at, published_at`
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*entities.DomainEvent
+	for rows.Next() {
+		var e entities.DomainEvent
+		var eventType string
+		if err := rows.Scan(
+			&e.ID,
+			&eventType,
+			&e.AggregateID,
+			&e.Payload,
+			&e.OccurredAt,
+			&e.Attempts,
+			&e.LastError,
+			&e.NextAttemptAt,
+			&e.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		e.Type = entities.DomainEventType(eventType)
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING tidak menjamin urutan
+	sortByOccurredAt(events)
+	return events, nil
+}
+
+func (r *PostgresOutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	query := `UPDATE outbox SET published_at = $2, attempts = attempts + 1, last_error = '' WHERE id = $1`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, id, publishedAt)
+	return err
+}
+
+func (r *PostgresOutboxRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`
+	_, err := executor(ctx, r.db).ExecContext(ctx, query, id, lastError, nextAttemptAt)
+	return err
+}
+
+// PurgePublished menghapus per batch agar tidak mengunci tabel outbox terlalu lama
+func (r *PostgresOutboxRepository) PurgePublished(ctx context.Context, publishedBefore time.Time, limit int) (int, error) {
+	query := `DELETE FROM outbox WHERE id IN (
+                  SELECT id FROM outbox
+                  WHERE published_at IS NOT NULL AND published_at < $1
+                  LIMIT $2
+              )`
+	res, err := executor(ctx, r.db).ExecContext(ctx, query, publishedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func sortByOccurredAt(events []*entities.DomainEvent) {
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].OccurredAt.Before(events[j].OccurredAt)
+	})
+}
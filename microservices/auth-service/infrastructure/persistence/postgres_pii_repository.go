@@ -0,0 +1,199 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type PostgresPIIRepository struct {
+	db     *sql.DB
+	cipher *PIICipher
+	keys   *PostgresUserKeyRepository
+}
+
+func NewPostgresPIIRepository(db *sql.DB, cipher *PIICipher) *PostgresPIIRepository {
+	return &PostgresPIIRepository{db: db, cipher: cipher, keys: NewPostgresUserKeyRepository(db, cipher)}
+}
+
+// pendingPII : baris yang masih plaintext (email terisi), atau sudah terenkripsi tetapi blind
+// index email-nya belum dihitung ulang dengan normalisasi (email_prefix_index NULL)
+type pendingPII struct {
+	id          string
+	email       sql.NullString
+	emailEnc    []byte
+	phone       string
+	dateOfBirth *time.Time
+}
+
+// piiEncryptMaxAttempts : baris yang gagal sebanyak ini dilewati sampai diperbaiki manual
+// (lihat users.pii_encryption_error)
+const piiEncryptMaxAttempts = 5
+
+// EncryptPlaintext mengosongkan kolom plaintext setelah nilainya dienkripsi, dan menghitung
+// ulang blind index email baris yang dienkripsi sebelum normalisasi. Baris dikunci
+// dengan SKIP LOCKED sehingga beberapa instance boleh berjalan bersamaan. Setiap baris
+// diproses dalam savepoint: baris yang gagal dicatat (attempts/error) lalu dilewati.
+func (r *PostgresPIIRepository) EncryptPlaintext(ctx context.Context, limit int) (encrypted, failed int, err error) {
+	err = NewPostgresTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		encrypted, failed = 0, 0
+		tx := executor(ctx, r.db)
+		batch, err := r.lockPending(ctx, limit)
+		if err != nil {
+			return err
+		}
+		for _, p := range batch {
+			if _, err := tx.ExecContext(ctx, `SAVEPOINT pii_row`); err != nil {
+				return err
+			}
+			rowErr := r.encryptRow(ctx, p)
+			if rowErr == nil {
+				if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT pii_row`); err != nil {
+					return err
+				}
+				encrypted++
+				continue
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT pii_row`); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE users SET pii_encryption_attempts = pii_encryption_attempts + 1, pii_encryption_error = $2
+                 WHERE id = $1`, p.id, rowErr.Error()); err != nil {
+				return err
+			}
+			failed++
+		}
+		return nil
+	})
+	return encrypted, failed, err
+}
+
+func (r *PostgresPIIRepository) encryptRow(ctx context.Context, p pendingPII) error {
+	key, err := r.keys.EnsureKey(ctx, p.id)
+	if err != nil {
+		return err
+	}
+	if !p.email.Valid {
+		return r.reindexEmail(ctx, key, p)
+	}
+	pii, err := r.cipher.sealPII(key, p.email.String, p.phone, p.dateOfBirth)
+	if err != nil {
+		return err
+	}
+	// phone/date_of_birth yang sudah terenkripsi (e.g. SetPhone setelah migrasi) dipertahankan
+	_, err = executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE users SET
+             email = NULL, email_hash = $2, email_enc = $3,
+             phone = '', phone_enc = COALESCE($4, phone_enc),
+             date_of_birth = NULL, date_of_birth_enc = COALESCE($5, date_of_birth_enc),
+             email_prefix_index = $6,
+             pii_encryption_error = '',
+             updated_at = NOW()
+         WHERE id = $1`,
+		p.id, pii.emailHash, pii.email, pii.phone, pii.dateOfBirth, pq.Array(pii.emailPrefix))
+	return err
+}
+
+// reindexEmail membuka email terenkripsi lalu menyimpan blind index dan token prefix-nya.
+// Gagal (unique violation) jika email yang hanya berbeda huruf besar sudah dipakai user lain.
+func (r *PostgresPIIRepository) reindexEmail(ctx context.Context, key []byte, p pendingPII) error {
+	email, err := openField(key, p.emailEnc, "")
+	if err != nil {
+		return err
+	}
+	_, err = executor(ctx, r.db).ExecContext(ctx,
+		`UPDATE users SET email_hash = $2, email_prefix_index = $3, pii_encryption_error = '', updated_at = NOW()
+         WHERE id = $1`,
+		p.id, r.cipher.EmailIndex(email), pq.Array(r.cipher.EmailPrefixIndex(email)))
+	return err
+}
+
+// RewrapKeys membuka data key dengan master key lamanya lalu membungkusnya dengan master key
+// terbaru. Data key sendiri tidak berubah sehingga kolom terenkripsi tidak perlu disentuh.
+func (r *PostgresPIIRepository) RewrapKeys(ctx context.Context, limit int) (int, error) {
+	current := r.cipher.kms.CurrentKeyID()
+	n := 0
+	err := NewPostgresTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+		batch, err := r.lockStaleKeys(ctx, current, limit)
+		if err != nil {
+			return err
+		}
+		for _, k := range batch {
+			dataKey, err := r.cipher.unwrapKey(ctx, k.key)
+			if err != nil {
+				return err
+			}
+			keyID, wrapped, err := r.cipher.kms.WrapKey(ctx, dataKey)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx,
+				`UPDATE user_keys SET data_key = $2, master_key_id = $3 WHERE user_id = $1`,
+				k.userID, wrapped, keyID); err != nil {
+				return err
+			}
+		}
+		n = len(batch)
+		return nil
+	})
+	return n, err
+}
+
+func (r *PostgresPIIRepository) lockPending(ctx context.Context, limit int) ([]pendingPII, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT id, email, email_enc, phone, date_of_birth FROM users
+         WHERE (email IS NOT NULL OR (email_enc IS NOT NULL AND email_prefix_index IS NULL))
+           AND pii_encryption_attempts < $2
+         ORDER BY pii_encryption_attempts, id
+         LIMIT $1
+         FOR UPDATE SKIP LOCKED`, limit, piiEncryptMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []pendingPII
+	for rows.Next() {
+		var p pendingPII
+		if err := rows.Scan(&p.id, &p.email, &p.emailEnc, &p.phone, &p.dateOfBirth); err != nil {
+			return nil, err
+		}
+		batch = append(batch, p)
+	}
+	return batch, rows.Err()
+}
+
+type storedKey struct {
+	userID string
+	key    wrappedKey
+}
+
+func (r *PostgresPIIRepository) lockStaleKeys(ctx context.Context, current string, limit int) ([]storedKey, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT user_id, data_key, master_key_id FROM user_keys
+         WHERE master_key_id <> $1
+         ORDER BY user_id
+         LIMIT $2
+         FOR UPDATE SKIP LOCKED`, current, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batch []storedKey
+	for rows.Next() {
+		var k storedKey
+		if err := rows.Scan(&k.userID, &k.key.key, &k.key.masterKeyID); err != nil {
+			return nil, err
+		}
+		batch = append(batch, k)
+	}
+	return batch, rows.Err()
+}
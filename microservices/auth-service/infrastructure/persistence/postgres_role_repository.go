@@ -0,0 +1,153 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"microservices/auth-service/domain/entities"
+
+	"github.com/lib/pq"
+)
+
+type PostgresRoleRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresRoleRepository(db *sql.DB) *PostgresRoleRepository {
+	return &PostgresRoleRepository{db: db}
+}
+
+func (r *PostgresRoleRepository) ListRoles(ctx context.Context) ([]*entities.RoleDefinition, error) {
+	query := `SELECT r.name, r.description,
+                     COALESCE(array_agg(rp.permission ORDER BY rp.permission) FILTER (WHERE rp.permission IS NOT NULL), '{}')
+              FROM roles r
+              LEFT JOIN role_permissions rp ON rp.role = r.name
+              GROUP BY r.name, r.description
+              ORDER BY r.name`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*entities.RoleDefinition
+	for rows.Next() {
+		role, err := scanRoleDefinition(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+func (r *PostgresRoleRepository) FindRole(ctx context.Context, name entities.Role) (*entities.RoleDefinition, error) {
+	query := `SELECT r.name, r.description,
+                     COALESCE(array_agg(rp.permission ORDER BY rp.permission) FILTER (WHERE rp.permission IS NOT NULL), '{}')
+              FROM roles r
+              LEFT JOIN role_permissions rp ON rp.role = r.name
+              WHERE r.name = $1
+              GROUP BY r.name, r.description`
+	role, err := scanRoleDefinition(r.db.QueryRowContext(ctx, query, string(name)))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return role, err
+}
+
+// SetRolePermissions mengganti seluruh permission milik role dalam satu transaksi
+func (r *PostgresRoleRepository) SetRolePermissions(ctx context.Context, name entities.Role, permissions []entities.Permission) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_permissions WHERE role = $1`, string(name)); err != nil {
+		return err
+	}
+
+	for _, p := range permissions {
+		// Permission baru otomatis terdaftar di tabel permissions
+		if _, err := tx.ExecContext(ctx, `INSERT INTO permissions (name) VALUES ($1) ON CONFLICT DO NOTHING`, string(p)); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO role_permissions (role, permission) VALUES ($1, $2)`, string(name), string(p)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRoleRepository) GetUserRoles(ctx context.Context, userID string) ([]entities.Role, error) {
+	var names []string
+	query := `SELECT COALESCE(array_agg(role ORDER BY role), '{}') FROM user_roles WHERE user_id = $1`
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(pq.Array(&names)); err != nil {
+		return nil, err
+	}
+	return toRoles(names), nil
+}
+
+func (r *PostgresRoleRepository) AssignRole(ctx context.Context, userID string, role entities.Role) error {
+	query := `INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := r.db.ExecContext(ctx, query, userID, string(role))
+	return err
+}
+
+func (r *PostgresRoleRepository) RevokeRole(ctx context.Context, userID string, role entities.Role) error {
+	query := `DELETE FROM user_roles WHERE user_id = $1 AND role = $2`
+	_, err := r.db.ExecContext(ctx, query, userID, string(role))
+	return err
+}
+
+// ListRoleHolders cukup membaca user_roles karena role utama juga dicatat di sana (UpdateRole)
+func (r *PostgresRoleRepository) ListRoleHolders(ctx context.Context, role entities.Role) ([]string, error) {
+	var ids []string
+	query := `SELECT COALESCE(array_agg(user_id ORDER BY user_id), '{}') FROM user_roles WHERE role = $1`
+	if err := r.db.QueryRowContext(ctx, query, string(role)).Scan(pq.Array(&ids)); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *PostgresRoleRepository) GetPermissions(ctx context.Context, roles []entities.Role) ([]entities.Permission, error) {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+
+	var perms []string
+	query := `SELECT COALESCE(array_agg(DISTINCT permission ORDER BY permission), '{}')
+              FROM role_permissions WHERE role = ANY($1)`
+	if err := r.db.QueryRowContext(ctx, query, pq.Array(names)).Scan(pq.Array(&perms)); err != nil {
+		return nil, err
+	}
+
+	permissions := make([]entities.Permission, len(perms))
+	for i, p := range perms {
+		permissions[i] = entities.Permission(p)
+	}
+	return permissions, nil
+}
+
+func scanRoleDefinition(row rowScanner) (*entities.RoleDefinition, error) {
+	var role entities.RoleDefinition
+	var name string
+	var perms []string
+	if err := row.Scan(&name, &role.Description, pq.Array(&perms)); err != nil {
+		return nil, err
+	}
+	role.Name = entities.Role(name)
+	for _, p := range perms {
+		role.Permissions = append(role.Permissions, entities.Permission(p))
+	}
+	return &role, nil
+}
+
+func toRoles(names []string) []entities.Role {
+	roles := make([]entities.Role, len(names))
+	for i, n := range names {
+		roles[i] = entities.Role(n)
+	}
+	return roles
+}
@@ -3,52 +3,287 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+// PostgresUserRepository menyimpan email, phone dan date_of_birth terenkripsi (lihat PIICipher).
+// Baris lama yang masih plaintext tetap terbaca sampai dienkripsi oleh PostgresPIIRepository.
 type PostgresUserRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	cipher *PIICipher
+	keys   *PostgresUserKeyRepository
 }
 
-// FindByID implements repositories.UserRepository.
-func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*entities.User, error) {
-	panic("unimplemented")
+func NewPostgresUserRepository(db *sql.DB, cipher *PIICipher) *PostgresUserRepository {
+	return &PostgresUserRepository{db: db, cipher: cipher, keys: NewPostgresUserKeyRepository(db, cipher)}
 }
 
-func NewPostgresUserRepository(db *sql.DB) *PostgresUserRepository {
-	return &PostgresUserRepository{db: db}
-}
+// Kolom users beserta seluruh role dari user_roles dan data key user
+var userColumns = `id, email, email_enc, password_hash, role, created_at,
+              status, status_reason, status_changed_at, password_reset_required,
+              phone, phone_enc, phone_verified_at, mfa_channel, date_of_birth, date_of_birth_enc,
+              email_verified_at, deletion_scheduled_at,
+              ARRAY(SELECT ur.role FROM user_roles ur WHERE ur.user_id = users.id ORDER BY ur.role),
+              ` + userKeyColumns("users")
 
+// CreateUser juga membuat data key user dan mencatat role utama ke user_roles dalam satu statement
 func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *entities.User) error {
-	query := `INSERT INTO users (id, email, password_hash, role, created_at) 
-              VALUES ($1, $2, $3, $4, $5)`
-	_, err := r.db.ExecContext(ctx, query,
+	key, err := auth.GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	keyID, wrapped, err := r.cipher.kms.WrapKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	pii, err := r.cipher.sealPII(key, user.Email, user.Phone, user.DateOfBirth)
+	if err != nil {
+		return err
+	}
+
+	query := `WITH u AS (
+                  INSERT INTO users (id, email_hash, email_enc, password_hash, role, created_at, status,
+                                     phone_enc, date_of_birth_enc, email_verified_at, email_prefix_index)
+                  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $13)
+                  RETURNING id, role
+              ), k AS (
+                  INSERT INTO user_keys (user_id, data_key, master_key_id) SELECT id, $11, $12 FROM u
+              )
+              INSERT INTO user_roles (user_id, role) SELECT id, role FROM u`
+	_, err = executor(ctx, r.db).ExecContext(ctx, query,
 		user.ID,
-		user.Email,
+		pii.emailHash,
+		pii.email,
 		user.PasswordHash,
 		string(user.Role),
 		user.CreatedAt,
+		string(user.Status),
+		pii.phone,
+		pii.dateOfBirth,
+		user.EmailVerifiedAt,
+		wrapped,
+		keyID,
+		pq.Array(pii.emailPrefix),
 	)
 	return err
 }
 
+// FindByEmail tidak membedakan huruf besar: lewat blind index, atau email plaintext untuk
+// baris yang belum dienkripsi
 func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
-	query := `SELECT id, email, password_hash, role, created_at 
-              FROM users WHERE email = $1`
-	row := r.db.QueryRowContext(ctx, query, email)
-	return scanUser(row)
+	cond, args := r.emailCond(email, 1)
+	query := `SELECT ` + userColumns + `
+              FROM users WHERE ` + cond + `
+              ORDER BY email_hash = $1 DESC NULLS LAST
+              LIMIT 1`
+	row := executor(ctx, r.db).QueryRowContext(ctx, query, args...)
+	return r.scanUser(ctx, row)
+}
+
+// emailCond : kondisi exact match email dengan parameter mulai dari $first. Baris yang belum
+// diindeks ulang (email_prefix_index NULL) masih menyimpan blind index tanpa normalisasi.
+func (r *PostgresUserRepository) emailCond(email string, first int) (string, []interface{}) {
+	cond := fmt.Sprintf(`(email_hash = $%[1]d
+               OR (email_prefix_index IS NULL AND email_hash = $%[2]d)
+               OR (email IS NOT NULL AND lower(email) = $%[3]d))`, first, first+1, first+2)
+	return cond, []interface{}{r.cipher.EmailIndex(email), r.cipher.legacyEmailIndex(email), auth.NormalizeIndexValue(email)}
+}
+
+func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*entities.User, error) {
+	query := `SELECT ` + userColumns + `
+              FROM users WHERE id = $1`
+	row := executor(ctx, r.db).QueryRowContext(ctx, query, id)
+	return r.scanUser(ctx, row)
+}
+
+// SearchUsers memfilter user untuk kebutuhan admin, hasil diurutkan dari yang terbaru
+func (r *PostgresUserRepository) SearchUsers(ctx context.Context, filter entities.UserFilter) ([]*entities.User, int, error) {
+	var conds []string
+	var args []interface{}
+	addCond := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.Email != "" {
+		cond, emailArgs := r.emailCond(filter.Email, len(args)+1)
+		args = append(args, emailArgs...)
+		conds = append(conds, cond)
+	}
+	// Prefix email terenkripsi dicari lewat token prefix (lihat PIICipher.EmailPrefixIndex)
+	if filter.EmailPrefix != "" {
+		args = append(args, r.cipher.EmailPrefixToken(filter.EmailPrefix), escapeLike(auth.NormalizeIndexValue(filter.EmailPrefix))+"%")
+		conds = append(conds, fmt.Sprintf("(email_prefix_index @> ARRAY[$%d]::text[] OR (email IS NOT NULL AND lower(email) LIKE $%d))", len(args)-1, len(args)))
+	}
+	if filter.Role != "" {
+		addCond("EXISTS (SELECT 1 FROM user_roles ur WHERE ur.user_id = users.id AND ur.role = $%d)", string(filter.Role))
+	}
+	if filter.Status != "" {
+		addCond("status = $%d", string(filter.Status))
+	}
+	if filter.CreatedAfter != nil {
+		addCond("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		addCond("created_at < $%d", *filter.CreatedBefore)
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var total int
+	if err := executor(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM users`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(`SELECT `+userColumns+`
+              FROM users%s
+              ORDER BY created_at DESC, id
+              LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		user, err := r.scanUser(ctx, rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+	return users, total, rows.Err()
+}
+
+func (r *PostgresUserRepository) SetStatus(ctx context.Context, id string, change entities.StatusChange) error {
+	query := `UPDATE users SET status = $2, status_reason = $3, status_changed_at = $4, updated_at = NOW() WHERE id = $1`
+	return r.execUpdate(ctx, query, id, string(change.Status), change.Reason, change.ChangedAt)
+}
+
+func (r *PostgresUserRepository) SetPasswordResetRequired(ctx context.Context, id string, required bool) error {
+	query := `UPDATE users SET password_reset_required = $2, updated_at = NOW() WHERE id = $1`
+	return r.execUpdate(ctx, query, id, required)
+}
+
+func (r *PostgresUserRepository) UpdatePassword(ctx context.Context, id, passwordHash string) error {
+	query := `UPDATE users SET password_hash = $2, updated_at = NOW() WHERE id = $1`
+	return r.execUpdate(ctx, query, id, passwordHash)
 }
 
-// Helper untuk scan row SQL ke struct User
-func scanUser(row *sql.Row) (*entities.User, error) {
+// UpdateRole mengganti role utama. Role utama lama dihapus dari user_roles dalam transaksi yang
+// sama; tanpa itu demosi tidak mencabut permission role lama.
+func (r *PostgresUserRepository) UpdateRole(ctx context.Context, id string, role entities.Role) error {
+	return NewPostgresTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		tx := executor(ctx, r.db)
+		var previous string
+		err := tx.QueryRowContext(ctx, `SELECT role FROM users WHERE id = $1 FOR UPDATE`, id).Scan(&previous)
+		if err == sql.ErrNoRows {
+			return entities.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE users SET role = $2, updated_at = NOW() WHERE id = $1`, id, string(role)); err != nil {
+			return err
+		}
+		if previous != string(role) {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM user_roles WHERE user_id = $1 AND role = $2`, id, previous); err != nil {
+				return err
+			}
+		}
+		_, err = tx.ExecContext(ctx, `INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING`, id, string(role))
+		return err
+	})
+}
+
+func (r *PostgresUserRepository) SetPhone(ctx context.Context, id, phone string, verifiedAt time.Time) error {
+	key, err := r.keys.EnsureKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	sealed, err := sealField(key, phone)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE users SET phone = '', phone_enc = $2, phone_verified_at = $3, updated_at = NOW() WHERE id = $1`
+	return r.execUpdate(ctx, query, id, sealed, verifiedAt)
+}
+
+func (r *PostgresUserRepository) MarkEmailVerified(ctx context.Context, id string, verifiedAt time.Time) error {
+	query := `UPDATE users SET email_verified_at = $2,
+	              status_changed_at = CASE WHEN status = $3 THEN $2 ELSE status_changed_at END,
+	              status = CASE WHEN status = $3 THEN $4 ELSE status END,
+	              updated_at = NOW()
+	          WHERE id = $1`
+	return r.execUpdate(ctx, query, id, verifiedAt, string(entities.StatusPendingVerification), string(entities.StatusActive))
+}
+
+func (r *PostgresUserRepository) SetMFAChannel(ctx context.Context, id string, channel entities.OTPChannel) error {
+	query := `UPDATE users SET mfa_channel = $2, updated_at = NOW() WHERE id = $1`
+	return r.execUpdate(ctx, query, id, string(channel))
+}
+
+func (r *PostgresUserRepository) SetDeletionSchedule(ctx context.Context, id string, at *time.Time) error {
+	query := `UPDATE users SET deletion_scheduled_at = $2, updated_at = NOW() WHERE id = $1`
+	return r.execUpdate(ctx, query, id, at)
+}
+
+func (r *PostgresUserRepository) execUpdate(ctx context.Context, query string, args ...interface{}) error {
+	res, err := executor(ctx, r.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrUserNotFound
+	}
+	return nil
+}
+
+// escapeLike meng-escape karakter wildcard LIKE agar prefix dicari secara literal
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// Helper untuk scan row SQL ke struct User, kolom PII terenkripsi dibuka dengan data key user
+func (r *PostgresUserRepository) scanUser(ctx context.Context, row rowScanner) (*entities.User, error) {
 	var user entities.User
-	var roleStr string
+	var roleStr, statusStr, mfaChannel, phone string
+	var email sql.NullString
+	var emailEnc, phoneEnc, dobEnc []byte
+	var roles []string
+	var key wrappedKey
 	err := row.Scan(
 		&user.ID,
-		&user.Email,
+		&email,
+		&emailEnc,
 		&user.PasswordHash,
 		&roleStr,
 		&user.CreatedAt,
+		&statusStr,
+		&user.StatusReason,
+		&user.StatusChangedAt,
+		&user.PasswordResetRequired,
+		&phone,
+		&phoneEnc,
+		&user.PhoneVerifiedAt,
+		&mfaChannel,
+		&user.DateOfBirth,
+		&dobEnc,
+		&user.EmailVerifiedAt,
+		&user.DeletionScheduledAt,
+		pq.Array(&roles),
+		&key.key,
+		&key.masterKeyID,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -57,5 +292,34 @@ func scanUser(row *sql.Row) (*entities.User, error) {
 		return nil, err
 	}
 	user.Role = entities.Role(roleStr)
+	user.Status = entities.UserStatus(statusStr)
+	user.Roles = toRoles(roles)
+	user.MFAChannel = entities.OTPChannel(mfaChannel)
+
+	user.Email, user.Phone = email.String, phone
+	if emailEnc == nil && phoneEnc == nil && dobEnc == nil {
+		return &user, nil
+	}
+	dataKey, err := r.cipher.unwrapKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if user.Email, err = openField(dataKey, emailEnc, user.Email); err != nil {
+		return nil, err
+	}
+	if user.Phone, err = openField(dataKey, phoneEnc, user.Phone); err != nil {
+		return nil, err
+	}
+	dob, err := openField(dataKey, dobEnc, "")
+	if err != nil {
+		return nil, err
+	}
+	if dob != "" {
+		t, err := time.Parse(dateLayout, dob)
+		if err != nil {
+			return nil, err
+		}
+		user.DateOfBirth = &t
+	}
 	return &user, nil
 }
@@ -0,0 +1,97 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/persistence"
+)
+
+// recordingConn mencatat statement yang dijalankan repository; SELECT role mengembalikan role tetap
+type recordingConn struct {
+	role       string
+	statements []string
+	args       [][]driver.NamedValue
+	committed  bool
+}
+
+func (c *recordingConn) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *recordingConn) Driver() driver.Driver                        { return nil }
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *recordingConn) Close() error              { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *recordingConn) Commit() error             { c.committed = true; return nil }
+func (c *recordingConn) Rollback() error           { return nil }
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.statements = append(c.statements, query)
+	c.args = append(c.args, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.statements = append(c.statements, query)
+	c.args = append(c.args, args)
+	return &singleValueRows{value: c.role}, nil
+}
+
+type singleValueRows struct {
+	value string
+	done  bool
+}
+
+func (r *singleValueRows) Columns() []string { return []string{"role"} }
+func (r *singleValueRows) Close() error      { return nil }
+func (r *singleValueRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+func TestPostgresUserRepository_UpdateRole_DemotionRemovesPreviousRole(t *testing.T) {
+	conn := &recordingConn{role: string(entities.PsychologistRole)}
+	db := sql.OpenDB(conn)
+	defer db.Close()
+	repo := persistence.NewPostgresUserRepository(db, nil)
+
+	require.NoError(t, repo.UpdateRole(context.Background(), "psy-1", entities.ClientRole))
+
+	assert.True(t, conn.committed)
+	var deleted bool
+	for i, query := range conn.statements {
+		if strings.HasPrefix(strings.TrimSpace(query), "DELETE FROM user_roles") {
+			deleted = true
+			assert.Equal(t, "psy-1", conn.args[i][0].Value)
+			assert.Equal(t, string(entities.PsychologistRole), conn.args[i][1].Value)
+		}
+	}
+	assert.True(t, deleted, "previous primary role must be removed from user_roles")
+}
+
+func TestPostgresUserRepository_UpdateRole_SameRoleKeepsRow(t *testing.T) {
+	conn := &recordingConn{role: string(entities.ClientRole)}
+	db := sql.OpenDB(conn)
+	defer db.Close()
+	repo := persistence.NewPostgresUserRepository(db, nil)
+
+	require.NoError(t, repo.UpdateRole(context.Background(), "client-1", entities.ClientRole))
+
+	for _, query := range conn.statements {
+		assert.NotContains(t, query, "DELETE FROM user_roles")
+	}
+}
@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"microservices/auth-service/domain/entities"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// incrementWithinWindow : TTL hanya dipasang pada permintaan pertama sehingga window tidak bergeser
+var incrementWithinWindow = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return n`)
+
+type RedisMagicLinkRepository struct {
+	client     *redis.Client
+	prefix     string
+	ratePrefix string
+}
+
+func NewRedisMagicLinkRepository(client *redis.Client) *RedisMagicLinkRepository {
+	return &RedisMagicLinkRepository{
+		client:     client,
+		prefix:     "magic_link:",
+		ratePrefix: "magic_link_rate:",
+	}
+}
+
+func (r *RedisMagicLinkRepository) StoreMagicLink(ctx context.Context, link *entities.MagicLink, ttl time.Duration) error {
+	data, err := json.Marshal(link)
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, r.prefix+link.TokenHash, data, ttl).Err()
+}
+
+// ConsumeMagicLink memakai GETDEL agar link tidak bisa dipakai dua kali
+func (r *RedisMagicLinkRepository) ConsumeMagicLink(ctx context.Context, tokenHash string) (*entities.MagicLink, error) {
+	data, err := r.client.GetDel(ctx, r.prefix+tokenHash).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var link entities.MagicLink
+	if err := json.Unmarshal(data, &link); err != nil {
+		return nil, err
+	}
+	link.TokenHash = tokenHash
+	return &link, nil
+}
+
+func (r *RedisMagicLinkRepository) CountRequest(ctx context.Context, recipientHash string, window time.Duration) (int, error) {
+	return incrementWithinWindow.Run(ctx, r.client, []string{r.ratePrefix + recipientHash}, window.Milliseconds()).Int()
+}
@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"microservices/auth-service/domain/entities"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// incrementIfExists mencegah HINCRBY membuat ulang key yang sudah expired tanpa TTL
+var incrementIfExists = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("HINCRBY", KEYS[1], "attempts", 1)
+end
+return 0`)
+
+type RedisOTPRepository struct {
+	client     *redis.Client
+	prefix     string
+	ratePrefix string
+}
+
+func NewRedisOTPRepository(client *redis.Client) *RedisOTPRepository {
+	return &RedisOTPRepository{
+		client:     client,
+		prefix:     "otp:",
+		ratePrefix: "otp_rate:",
+	}
+}
+
+// StoreOTP menyimpan challenge sebagai hash {data, attempts} dengan TTL
+func (r *RedisOTPRepository) StoreOTP(ctx context.Context, challenge *entities.OTPChallenge, ttl time.Duration) error {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+	key := r.prefix + challenge.ID
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, "data", data, "attempts", 0)
+	pipe.Expire(ctx, key, ttl)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisOTPRepository) FindOTP(ctx context.Context, id string) (*entities.OTPChallenge, error) {
+	data, err := r.client.HGet(ctx, r.prefix+id, "data").Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var challenge entities.OTPChallenge
+	if err := json.Unmarshal(data, &challenge); err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+func (r *RedisOTPRepository) IncrementOTPAttempts(ctx context.Context, id string) (int, error) {
+	return incrementIfExists.Run(ctx, r.client, []string{r.prefix + id}).Int()
+}
+
+func (r *RedisOTPRepository) DeleteOTP(ctx context.Context, id string) (bool, error) {
+	n, err := r.client.Del(ctx, r.prefix+id).Result()
+	return n == 1, err
+}
+
+func (r *RedisOTPRepository) CountIssue(ctx context.Context, key string, window time.Duration) (int, error) {
+	return incrementWithinWindow.Run(ctx, r.client, []string{r.ratePrefix + key}, window.Milliseconds()).Int()
+}
@@ -0,0 +1,33 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type RedisPasswordResetRepository struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedisPasswordResetRepository(client *redis.Client) *RedisPasswordResetRepository {
+	return &RedisPasswordResetRepository{
+		client: client,
+		prefix: "password_reset:",
+	}
+}
+
+func (r *RedisPasswordResetRepository) StoreResetToken(ctx context.Context, tokenHash, userID string, ttl time.Duration) error {
+	return r.client.Set(ctx, r.prefix+tokenHash, userID, ttl).Err()
+}
+
+// ConsumeResetToken memakai GETDEL agar token tidak bisa dipakai dua kali
+func (r *RedisPasswordResetRepository) ConsumeResetToken(ctx context.Context, tokenHash string) (string, error) {
+	userID, err := r.client.GetDel(ctx, r.prefix+tokenHash).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return userID, err
+}
@@ -2,28 +2,88 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
+	"microservices/auth-service/domain/entities"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 )
 
 type RedisTokenRepository struct {
-	client *redis.Client
-	prefix string
+	client          *redis.Client
+	prefix          string
+	userPrefix      string
+	denyPrefix      string
+	watermarkPrefix string
+	sessionPrefix   string
 }
 
 var refreshTokenExpiry = 7 * 24 * time.Hour // Set token expiry to 7 days
 
+// Watermark cukup disimpan selama umur access token terpanjang
+var accessTokenExpiry = 15 * time.Minute
+
 func NewRedisTokenRepository(client *redis.Client) *RedisTokenRepository {
 	return &RedisTokenRepository{
-		client: client,
-		prefix: "refresh_token:",
+		client:          client,
+		prefix:          "refresh_token:",
+		userPrefix:      "user_tokens:",
+		denyPrefix:      "denied_access_token:",
+		watermarkPrefix: "token_watermark:",
+		sessionPrefix:   "session:",
 	}
 }
 
-func (r *RedisTokenRepository) StoreToken(ctx context.Context, tokenID, userID string) error {
+// StoreToken juga mencatat tokenID ke set milik user agar bisa dicabut sekaligus.
+// Metadata sesi disimpan di key terpisah agar format refresh_token:<id> tetap sama.
+func (r *RedisTokenRepository) StoreToken(ctx context.Context, session *entities.Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
 	expiration := refreshTokenExpiry
-	return r.client.Set(ctx, r.prefix+tokenID, userID, expiration).Err()
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, r.prefix+session.TokenID, session.UserID, expiration)
+	pipe.Set(ctx, r.sessionPrefix+session.TokenID, data, expiration)
+	pipe.SAdd(ctx, r.userPrefix+session.UserID, session.TokenID)
+	pipe.Expire(ctx, r.userPrefix+session.UserID, expiration)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisTokenRepository) GetSession(ctx context.Context, tokenID string) (*entities.Session, error) {
+	data, err := r.client.Get(ctx, r.sessionPrefix+tokenID).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var session entities.Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListUserSessions melewati token yang metadata sesinya sudah tidak ada (refresh token lama)
+func (r *RedisTokenRepository) ListUserSessions(ctx context.Context, userID string) ([]*entities.Session, error) {
+	tokenIDs, err := r.client.SMembers(ctx, r.userPrefix+userID).Result()
+	if err != nil {
+		return nil, err
+	}
+	var sessions []*entities.Session
+	for _, id := range tokenIDs {
+		session, err := r.GetSession(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if session != nil {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
 }
 
 func (r *RedisTokenRepository) IsTokenRevoked(ctx context.Context, tokenID string) bool {
@@ -32,7 +92,19 @@ func (r *RedisTokenRepository) IsTokenRevoked(ctx context.Context, tokenID strin
 }
 
 func (r *RedisTokenRepository) RevokeToken(ctx context.Context, tokenID string) error {
-	return r.client.Del(ctx, r.prefix+tokenID).Err()
+	userID, err := r.client.Get(ctx, r.prefix+tokenID).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, r.prefix+tokenID, r.sessionPrefix+tokenID)
+	pipe.SRem(ctx, r.userPrefix+userID, tokenID)
+	_, err = pipe.Exec(ctx)
+	return err
 }
 
 // Fungsi baru untuk mendapatkan UserID berdasarkan TokenID
@@ -40,6 +112,54 @@ func (r *RedisTokenRepository) GetUserIDByTokenID(ctx context.Context, tokenID s
 	return r.client.Get(ctx, r.prefix+tokenID).Result()
 }
 
+func (r *RedisTokenRepository) RevokeAllUserTokens(ctx context.Context, userID string) error {
+	tokenIDs, err := r.client.SMembers(ctx, r.userPrefix+userID).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, 2*len(tokenIDs)+1)
+	for _, id := range tokenIDs {
+		keys = append(keys, r.prefix+id, r.sessionPrefix+id)
+	}
+	keys = append(keys, r.userPrefix+userID)
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, keys...)
+	pipe.Set(ctx, r.watermarkPrefix+userID, entities.RevocationWatermark(time.Now()).Unix(), accessTokenExpiry)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (r *RedisTokenRepository) InvalidateAccessTokens(ctx context.Context, userID string) error {
+	return r.client.Set(ctx, r.watermarkPrefix+userID, entities.RevocationWatermark(time.Now()).Unix(), accessTokenExpiry).Err()
+}
+
+func (r *RedisTokenRepository) DenyAccessToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return r.client.Set(ctx, r.denyPrefix+tokenID, 1, ttl).Err()
+}
+
+func (r *RedisTokenRepository) IsAccessTokenRevoked(ctx context.Context, tokenID, userID string, issuedAt time.Time) (bool, error) {
+	pipe := r.client.Pipeline()
+	denied := pipe.Exists(ctx, r.denyPrefix+tokenID)
+	watermark := pipe.Get(ctx, r.watermarkPrefix+userID)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return false, err
+	}
+
+	if denied.Val() > 0 {
+		return true, nil
+	}
+	if ts, err := watermark.Int64(); err == nil && issuedAt.Unix() < ts {
+		return true, nil
+	}
+	return false, nil
+}
+
 func (r *RedisTokenRepository) CheckHealth(ctx context.Context) error {
 	_, err := r.client.Ping(ctx).Result()
 	return err
@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+type claimsKey struct{}
+
+// AccessRule mendefinisikan syarat akses untuk satu full method gRPC.
+// Akses diberikan jika token memiliki semua Scopes (service token)
+// atau semua Permissions (token user dengan role yang sesuai).
+// Rule kosong berarti cukup access token yang valid.
+type AccessRule struct {
+	Scopes      []string
+	Permissions []string
+}
+
+func (r AccessRule) allows(claims *auth.CustomClaims) bool {
+	if len(r.Scopes) == 0 && len(r.Permissions) == 0 {
+		return true
+	}
+	if len(r.Scopes) > 0 && containsAll(claims.Scopes, r.Scopes) {
+		return true
+	}
+	return len(r.Permissions) > 0 && containsAll(claims.Permissions, r.Permissions)
+}
+
+// TokenValidator memvalidasi access token termasuk pemeriksaan revocation (AuthUseCase)
+type TokenValidator interface {
+	ValidateAccessToken(ctx context.Context, token string) (*auth.CustomClaims, error)
+}
+
+// AuthInterceptor memvalidasi bearer token untuk method yang terdaftar di rules.
+// Method yang tidak terdaftar dianggap publik (Register, Login, ...).
+type AuthInterceptor struct {
+	validator TokenValidator
+	rules     map[string]AccessRule
+}
+
+func NewAuthInterceptor(validator TokenValidator, rules map[string]AccessRule) *AuthInterceptor {
+	return &AuthInterceptor{
+		validator: validator,
+		rules:     rules,
+	}
+}
+
+// ClaimsFromContext mengambil claims access token yang sudah divalidasi interceptor
+func ClaimsFromContext(ctx context.Context) (*auth.CustomClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*auth.CustomClaims)
+	return claims, ok
+}
+
+func (ai *AuthInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := ai.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (ai *AuthInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := ai.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func (ai *AuthInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	rule, protected := ai.rules[fullMethod]
+
+	token := bearerToken(ctx)
+	if token == "" {
+		if protected {
+			return ctx, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		return ctx, nil
+	}
+
+	claims, err := ai.validator.ValidateAccessToken(ctx, token)
+	if err != nil {
+		if !protected {
+			return ctx, nil
+		}
+		if errors.Is(err, entities.ErrInvalidToken) {
+			return ctx, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		return ctx, status.Error(codes.Unavailable, "token verification unavailable")
+	}
+
+	if protected && !rule.allows(claims) {
+		return ctx, status.Error(codes.PermissionDenied, "insufficient permission")
+	}
+
+	// Pemilik token dicatat sebagai actor di audit log
+	info := entities.RequestInfoFromContext(ctx)
+	info.ActorID = claims.UserID
+	ctx = entities.ContextWithRequestInfo(ctx, info)
+
+	// Token tenant-scoped membatasi repository ke organisasi aktif
+	if claims.OrgID != "" {
+		ctx = entities.ContextWithTenant(ctx, claims.OrgID)
+	}
+
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+			return strings.TrimSpace(v[7:])
+		}
+	}
+	return ""
+}
+
+func containsAll(granted, required []string) bool {
+	set := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		set[s] = true
+	}
+	for _, s := range required {
+		if !set[s] {
+			return false
+		}
+	}
+	return true
+}
+
+// wrappedStream mengganti context stream agar claims ikut terbawa ke handler
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
@@ -2,26 +2,46 @@ package middleware
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"microservices/auth-service/domain/entities"
 )
 
+// limiterIdleTTL menentukan kapan limiter client yang tidak aktif dibuang dari memori
+const limiterIdleTTL = 10 * time.Minute
+
+// RateLimiter membatasi request per client IP (dari RequestInfoInterceptor),
+// sehingga satu client tidak bisa menghabiskan kuota client lain.
 type RateLimiter struct {
-	limiter *rate.Limiter
+	rps int
+
+	mu          sync.Mutex
+	limiters    map[string]*clientLimiter
+	lastCleanup time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
 }
 
 func NewRateLimiter(rps int) *RateLimiter {
 	return &RateLimiter{
-		limiter: rate.NewLimiter(rate.Limit(rps), rps),
+		rps:         rps,
+		limiters:    make(map[string]*clientLimiter),
+		lastCleanup: time.Now(),
 	}
 }
 
 func (rl *RateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if !rl.limiter.Allow() {
+		if !rl.allow(ctx) {
 			return nil, status.Errorf(codes.ResourceExhausted, "too many requests")
 		}
 		return handler(ctx, req)
@@ -30,9 +50,37 @@ func (rl *RateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
 
 func (rl *RateLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if !rl.limiter.Allow() {
+		if !rl.allow(stream.Context()) {
 			return status.Errorf(codes.ResourceExhausted, "too many requests")
 		}
 		return handler(srv, stream)
 	}
 }
+
+func (rl *RateLimiter) allow(ctx context.Context) bool {
+	key := entities.RequestInfoFromContext(ctx).IP
+	if key == "" {
+		key = peerIP(ctx)
+	}
+
+	now := time.Now()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if now.Sub(rl.lastCleanup) > limiterIdleTTL {
+		for k, cl := range rl.limiters {
+			if now.Sub(cl.lastSeen) > limiterIdleTTL {
+				delete(rl.limiters, k)
+			}
+		}
+		rl.lastCleanup = now
+	}
+
+	cl, ok := rl.limiters[key]
+	if !ok {
+		cl = &clientLimiter{limiter: rate.NewLimiter(rate.Limit(rl.rps), rl.rps)}
+		rl.limiters[key] = cl
+	}
+	cl.lastSeen = now
+	return cl.limiter.AllowN(now, 1)
+}
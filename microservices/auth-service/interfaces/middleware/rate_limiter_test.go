@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"microservices/auth-service/domain/entities"
+)
+
+func clientContext(ip string) context.Context {
+	return entities.ContextWithRequestInfo(context.Background(), entities.RequestInfo{IP: ip})
+}
+
+func TestRateLimiter_PerClient(t *testing.T) {
+	rl := NewRateLimiter(2)
+
+	assert.True(t, rl.allow(clientContext("203.0.113.5")))
+	assert.True(t, rl.allow(clientContext("203.0.113.5")))
+	assert.False(t, rl.allow(clientContext("203.0.113.5")))
+	// Kuota client lain tidak terpengaruh
+	assert.True(t, rl.allow(clientContext("198.51.100.7")))
+}
+
+func TestRateLimiter_PrunesIdleClients(t *testing.T) {
+	rl := NewRateLimiter(10)
+	rl.allow(clientContext("203.0.113.5"))
+	rl.allow(clientContext("198.51.100.7"))
+
+	// Belum lewat limiterIdleTTL sejak pembersihan terakhir: limiter idle belum dibuang
+	rl.limiters["203.0.113.5"].lastSeen = time.Now().Add(-2 * limiterIdleTTL)
+	rl.allow(clientContext("192.0.2.1"))
+	assert.Len(t, rl.limiters, 3)
+
+	rl.lastCleanup = time.Now().Add(-2 * limiterIdleTTL)
+	rl.allow(clientContext("192.0.2.1"))
+	assert.Len(t, rl.limiters, 2)
+	assert.NotContains(t, rl.limiters, "203.0.113.5")
+	assert.Contains(t, rl.limiters, "198.51.100.7")
+}
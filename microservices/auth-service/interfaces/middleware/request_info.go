@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"microservices/auth-service/domain/entities"
+	"microservices/auth-service/infrastructure/auth"
+)
+
+const (
+	requestIDHeader  = "x-request-id"
+	appVersionHeader = "x-app-version"
+	deviceIDHeader   = "x-device-id"
+
+	maxUserAgentLength = 512
+	maxClientIDLength  = 128
+)
+
+// RequestInfoInterceptor mengisi entities.RequestInfo (IP, user agent, versi app, device ID,
+// request ID) ke context untuk session, audit log dan rate limiter. Request ID dari client
+// dipakai jika ada, dan dikembalikan di response header.
+// Harus dipasang sebelum RateLimiter dan AuthInterceptor.
+type RequestInfoInterceptor struct {
+	trustedProxies []*net.IPNet
+}
+
+// NewRequestInfoInterceptor menerima daftar CIDR atau IP proxy tepercaya. x-forwarded-for
+// hanya dipakai jika koneksi datang dari salah satu proxy tersebut.
+func NewRequestInfoInterceptor(trustedProxies []string) (*RequestInfoInterceptor, error) {
+	ri := &RequestInfoInterceptor{}
+	for _, p := range trustedProxies {
+		if !strings.Contains(p, "/") {
+			if ip := net.ParseIP(p); ip != nil && ip.To4() != nil {
+				p += "/32"
+			} else {
+				p += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		ri.trustedProxies = append(ri.trustedProxies, network)
+	}
+	return ri, nil
+}
+
+func (ri *RequestInfoInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(ri.withRequestInfo(ctx), req)
+	}
+}
+
+func (ri *RequestInfoInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ServerStream: stream, ctx: ri.withRequestInfo(stream.Context())})
+	}
+}
+
+func (ri *RequestInfoInterceptor) withRequestInfo(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	info := entities.RequestInfo{
+		IP:         ri.clientIP(peerIP(ctx), md.Get("x-forwarded-for")),
+		UserAgent:  headerValue(md, "user-agent", maxUserAgentLength),
+		AppVersion: headerValue(md, appVersionHeader, maxClientIDLength),
+		DeviceID:   headerValue(md, deviceIDHeader, maxClientIDLength),
+		Locale:     preferredLanguage(headerValue(md, "accept-language", maxClientIDLength)),
+	}
+	if v := md.Get(requestIDHeader); len(v) > 0 && len(v[0]) <= 100 {
+		info.RequestID = v[0]
+	}
+	if info.RequestID == "" {
+		info.RequestID = auth.GenerateUUID()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, info.RequestID))
+	return entities.ContextWithRequestInfo(ctx, info)
+}
+
+// clientIP menelusuri x-forwarded-for dari kanan selama hop sebelumnya adalah proxy tepercaya.
+// Entry paling kiri bisa diisi bebas oleh client, jadi tidak pernah dipercaya begitu saja.
+func (ri *RequestInfoInterceptor) clientIP(remote string, forwardedFor []string) string {
+	if !ri.trusted(remote) {
+		return remote
+	}
+
+	var hops []string
+	for _, v := range forwardedFor {
+		for _, hop := range strings.Split(v, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	ip := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		if net.ParseIP(hops[i]) == nil {
+			break
+		}
+		ip = hops[i]
+		if !ri.trusted(ip) {
+			break
+		}
+	}
+	return ip
+}
+
+func (ri *RequestInfoInterceptor) trusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range ri.trustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// headerValue mengambil nilai pertama metadata, dipotong agar tidak membengkakkan session / audit log
+func headerValue(md metadata.MD, key string, maxLength int) string {
+	v := md.Get(key)
+	if len(v) == 0 {
+		return ""
+	}
+	value := strings.TrimSpace(v[0])
+	if len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	return value
+}
+
+// preferredLanguage mengambil primary subtag bahasa pertama, "en-US,en;q=0.9" -> "en"
+func preferredLanguage(acceptLanguage string) string {
+	tag := strings.TrimSpace(strings.SplitN(acceptLanguage, ",", 2)[0])
+	tag = strings.SplitN(tag, ";", 2)[0]
+	tag = strings.SplitN(tag, "-", 2)[0]
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "*" || len(tag) > 8 {
+		return ""
+	}
+	return tag
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"microservices/auth-service/domain/entities"
+)
+
+func TestRequestInfoInterceptor_ClientIP(t *testing.T) {
+	ri, err := NewRequestInfoInterceptor([]string{"10.0.0.0/8", "192.168.1.1", "fd00::/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		remote       string
+		forwardedFor []string
+		want         string
+	}{
+		{"tanpa proxy", "203.0.113.5", nil, "203.0.113.5"},
+		{"remote tidak tepercaya, xff diabaikan", "198.51.100.7", []string{"203.0.113.5"}, "198.51.100.7"},
+		{"satu proxy tepercaya", "10.0.0.1", []string{"203.0.113.5"}, "203.0.113.5"},
+		{"dua proxy, dibaca dari kanan", "10.0.0.1", []string{"203.0.113.5, 10.0.0.2"}, "203.0.113.5"},
+		{"entry kiri dipalsukan client", "10.0.0.1", []string{"1.2.3.4, 203.0.113.5, 10.0.0.2"}, "203.0.113.5"},
+		{"beberapa header xff digabung", "10.0.0.1", []string{"1.2.3.4", "203.0.113.5, 10.0.0.2"}, "203.0.113.5"},
+		{"semua hop tepercaya, ambil paling kiri", "10.0.0.1", []string{"10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+		{"xff kosong", "10.0.0.1", []string{""}, "10.0.0.1"},
+		{"hop rusak menghentikan penelusuran", "10.0.0.1", []string{"1.2.3.4, not-an-ip, 10.0.0.2"}, "10.0.0.2"},
+		{"hop terakhir rusak", "10.0.0.1", []string{"1.2.3.4, 203.0.113.5:443"}, "10.0.0.1"},
+		{"proxy ip tunggal dipercaya", "192.168.1.1", []string{"203.0.113.5"}, "203.0.113.5"},
+		{"ip lain di subnet proxy tunggal tidak dipercaya", "192.168.1.2", []string{"203.0.113.5"}, "192.168.1.2"},
+		{"proxy ipv6", "fd00::1", []string{"2001:db8::5"}, "2001:db8::5"},
+		{"remote rusak", "unix-socket", []string{"203.0.113.5"}, "unix-socket"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ri.clientIP(tt.remote, tt.forwardedFor))
+		})
+	}
+}
+
+func TestNewRequestInfoInterceptor_InvalidProxy(t *testing.T) {
+	for _, proxy := range []string{"not-an-ip", "10.0.0.0/33", "10.0.0.1/"} {
+		_, err := NewRequestInfoInterceptor([]string{proxy})
+		assert.Error(t, err, proxy)
+	}
+}
+
+func TestRequestInfoInterceptor_WithRequestInfo(t *testing.T) {
+	ri, err := NewRequestInfoInterceptor([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"x-forwarded-for", "1.2.3.4, 203.0.113.5",
+		"user-agent", "app/2.4",
+		"x-device-id", "device-1",
+		"accept-language", "en-US,en;q=0.9",
+	))
+
+	info := entities.RequestInfoFromContext(ri.withRequestInfo(ctx))
+	assert.Equal(t, "203.0.113.5", info.IP)
+	assert.Equal(t, "app/2.4", info.UserAgent)
+	assert.Equal(t, "device-1", info.DeviceID)
+	assert.Equal(t, "en", info.Locale)
+	assert.NotEmpty(t, info.RequestID)
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AccountHandler struct {
+	v1.UnimplementedAccountServiceServer
+	deletionUC *usecases.AccountDeletionUseCase
+}
+
+func NewAccountHandler(deletionUC *usecases.AccountDeletionUseCase) *AccountHandler {
+	return &AccountHandler{deletionUC: deletionUC}
+}
+
+func (h *AccountHandler) DeleteAccount(ctx context.Context, req *v1.DeleteAccountRequest) (*v1.DeleteAccountResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	scheduledAt, err := h.deletionUC.DeleteAccount(ctx, claims.UserID, claims.AuthTimeValue())
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &v1.DeleteAccountResponse{ScheduledAt: scheduledAt.Unix()}, nil
+}
+
+func (h *AccountHandler) CancelAccountDeletion(ctx context.Context, req *v1.CancelAccountDeletionRequest) (*v1.CancelAccountDeletionResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.deletionUC.CancelAccountDeletion(ctx, claims.UserID); err != nil {
+		return nil, accountError(err)
+	}
+	return &v1.CancelAccountDeletionResponse{}, nil
+}
+
+func accountError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrStepUpRequired), errors.Is(err, entities.ErrDeletionNotScheduled):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, entities.ErrUnauthorized):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *AdminHandler) QueryAuditEvents(ctx context.Context, req *v1.QueryAuditEventsRequest) (*v1.QueryAuditEventsResponse, error) {
+	filter := toAuditFilter(req.Filter)
+	filter.Limit = int(req.PageSize)
+	filter.Offset = int(req.Offset)
+
+	events, total, err := h.auditUC.Query(ctx, filter)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	resp := &v1.QueryAuditEventsResponse{Total: int32(total)}
+	for _, e := range events {
+		resp.Events = append(resp.Events, toProtoAuditEvent(e))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) ExportAuditEvents(req *v1.ExportAuditEventsRequest, stream v1.AdminService_ExportAuditEventsServer) error {
+	err := h.auditUC.Export(stream.Context(), toAuditFilter(req.Filter), func(e *entities.AuditEvent) error {
+		return stream.Send(toProtoAuditEvent(e))
+	})
+	if err != nil {
+		return adminError(err)
+	}
+	return nil
+}
+
+func (h *AdminHandler) VerifyAuditLog(ctx context.Context, req *v1.VerifyAuditLogRequest) (*v1.VerifyAuditLogResponse, error) {
+	checked, brokenSeq, err := h.auditUC.Verify(ctx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.VerifyAuditLogResponse{
+		Valid:     brokenSeq == 0,
+		Checked:   int32(checked),
+		BrokenSeq: brokenSeq,
+	}, nil
+}
+
+func toAuditFilter(f *v1.AuditFilter) entities.AuditFilter {
+	if f == nil {
+		return entities.AuditFilter{}
+	}
+	filter := entities.AuditFilter{
+		ActorID:   f.ActorId,
+		SubjectID: f.SubjectId,
+		Action:    entities.AuditAction(f.Action),
+	}
+	if f.From != nil {
+		t := f.From.AsTime()
+		filter.From = &t
+	}
+	if f.To != nil {
+		t := f.To.AsTime()
+		filter.To = &t
+	}
+	return filter
+}
+
+func toProtoAuditEvent(e *entities.AuditEvent) *v1.AuditEvent {
+	return &v1.AuditEvent{
+		Seq:        e.Seq,
+		Action:     string(e.Action),
+		ActorId:    e.ActorID,
+		SubjectId:  e.SubjectID,
+		Ip:         e.IP,
+		UserAgent:  e.UserAgent,
+		AppVersion: e.AppVersion,
+		DeviceId:   e.DeviceID,
+		RequestId:  e.RequestID,
+		Metadata:   e.Metadata,
+		OccurredAt: timestamppb.New(e.OccurredAt),
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+		Redacted:   e.Redacted,
+	}
+}
@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminHandler struct {
+	v1.UnimplementedAdminServiceServer
+	roleUC  *usecases.RoleUseCase
+	adminUC *usecases.AdminUseCase
+	auditUC *usecases.AuditUseCase
+}
+
+func NewAdminHandler(roleUC *usecases.RoleUseCase, adminUC *usecases.AdminUseCase, auditUC *usecases.AuditUseCase) *AdminHandler {
+	return &AdminHandler{roleUC: roleUC, adminUC: adminUC, auditUC: auditUC}
+}
+
+func (h *AdminHandler) ListRoles(ctx context.Context, req *v1.ListRolesRequest) (*v1.ListRolesResponse, error) {
+	roles, err := h.roleUC.ListRoles(ctx)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	resp := &v1.ListRolesResponse{}
+	for _, role := range roles {
+		def := &v1.RoleDefinition{Name: string(role.Name), Description: role.Description}
+		for _, p := range role.Permissions {
+			def.Permissions = append(def.Permissions, string(p))
+		}
+		resp.Roles = append(resp.Roles, def)
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) SetRolePermissions(ctx context.Context, req *v1.SetRolePermissionsRequest) (*v1.SetRolePermissionsResponse, error) {
+	perms := make([]entities.Permission, len(req.Permissions))
+	for i, p := range req.Permissions {
+		perms[i] = entities.Permission(p)
+	}
+	if err := h.roleUC.SetRolePermissions(ctx, entities.Role(req.Role), perms); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.SetRolePermissionsResponse{}, nil
+}
+
+func (h *AdminHandler) ListUserRoles(ctx context.Context, req *v1.ListUserRolesRequest) (*v1.ListUserRolesResponse, error) {
+	roles, err := h.roleUC.ListUserRoles(ctx, req.UserId)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	resp := &v1.ListUserRolesResponse{}
+	for _, r := range roles {
+		resp.Roles = append(resp.Roles, string(r))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) AssignRole(ctx context.Context, req *v1.AssignRoleRequest) (*v1.AssignRoleResponse, error) {
+	if err := h.roleUC.AssignRole(ctx, req.UserId, entities.Role(req.Role)); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.AssignRoleResponse{}, nil
+}
+
+func (h *AdminHandler) RevokeRole(ctx context.Context, req *v1.RevokeRoleRequest) (*v1.RevokeRoleResponse, error) {
+	if err := h.roleUC.RevokeRole(ctx, req.UserId, entities.Role(req.Role)); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.RevokeRoleResponse{}, nil
+}
+
+func (h *AdminHandler) SearchUsers(ctx context.Context, req *v1.SearchUsersRequest) (*v1.SearchUsersResponse, error) {
+	filter := entities.UserFilter{
+		Email:       req.Email,
+		EmailPrefix: req.EmailPrefix,
+		Role:        entities.Role(req.Role),
+		Status:      entities.UserStatus(req.Status),
+		Limit:       int(req.PageSize),
+		Offset:      int(req.Offset),
+	}
+	if req.CreatedAfter != nil {
+		t := req.CreatedAfter.AsTime()
+		filter.CreatedAfter = &t
+	}
+	if req.CreatedBefore != nil {
+		t := req.CreatedBefore.AsTime()
+		filter.CreatedBefore = &t
+	}
+
+	users, total, err := h.adminUC.SearchUsers(ctx, filter)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	resp := &v1.SearchUsersResponse{Total: int32(total)}
+	for _, u := range users {
+		resp.Users = append(resp.Users, toProtoUser(u))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) GetUser(ctx context.Context, req *v1.GetUserRequest) (*v1.GetUserResponse, error) {
+	user, err := h.adminUC.GetUser(ctx, req.UserId)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.GetUserResponse{User: toProtoUser(user)}, nil
+}
+
+func (h *AdminHandler) SetUserStatus(ctx context.Context, req *v1.SetUserStatusRequest) (*v1.SetUserStatusResponse, error) {
+	if err := h.adminUC.SetUserStatus(ctx, req.UserId, entities.UserStatus(req.Status), req.Reason); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.SetUserStatusResponse{}, nil
+}
+
+func (h *AdminHandler) DisableUser(ctx context.Context, req *v1.DisableUserRequest) (*v1.DisableUserResponse, error) {
+	if err := h.adminUC.DisableUser(ctx, req.UserId, req.Reason); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.DisableUserResponse{}, nil
+}
+
+func (h *AdminHandler) EnableUser(ctx context.Context, req *v1.EnableUserRequest) (*v1.EnableUserResponse, error) {
+	if err := h.adminUC.EnableUser(ctx, req.UserId); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.EnableUserResponse{}, nil
+}
+
+func (h *AdminHandler) ForceLogout(ctx context.Context, req *v1.ForceLogoutRequest) (*v1.ForceLogoutResponse, error) {
+	if err := h.adminUC.ForceLogout(ctx, req.UserId); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.ForceLogoutResponse{}, nil
+}
+
+func (h *AdminHandler) ForcePasswordReset(ctx context.Context, req *v1.ForcePasswordResetRequest) (*v1.ForcePasswordResetResponse, error) {
+	expiresAt, err := h.adminUC.ForcePasswordReset(ctx, req.UserId)
+	if err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.ForcePasswordResetResponse{ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
+
+func (h *AdminHandler) SetRole(ctx context.Context, req *v1.SetRoleRequest) (*v1.SetRoleResponse, error) {
+	if err := h.adminUC.SetRole(ctx, req.UserId, entities.Role(req.Role)); err != nil {
+		return nil, adminError(err)
+	}
+	return &v1.SetRoleResponse{}, nil
+}
+
+func toProtoUser(u *entities.User) *v1.User {
+	user := &v1.User{
+		Id:                    u.ID,
+		Email:                 u.Email,
+		Role:                  string(u.Role),
+		CreatedAt:             timestamppb.New(u.CreatedAt),
+		Status:                string(u.Status),
+		StatusReason:          u.StatusReason,
+		StatusChangedAt:       toProtoTime(u.StatusChangedAt),
+		PasswordResetRequired: u.PasswordResetRequired,
+	}
+	for _, r := range u.Roles {
+		user.Roles = append(user.Roles, string(r))
+	}
+	return user
+}
+
+func adminError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrUserNotFound), errors.Is(err, entities.ErrRoleNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, entities.ErrPrimaryRole), errors.Is(err, entities.ErrInvalidRole),
+		errors.Is(err, entities.ErrInvalidStatusTransition):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, entities.ErrEmailPrefixTooShort):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "admin operation failed: %v", err)
+}
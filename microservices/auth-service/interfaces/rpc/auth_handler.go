@@ -2,34 +2,97 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"microservices/auth-service/application/usecases"
 	"microservices/auth-service/domain/entities"
 	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+	"strings"
+	"time"
 
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type AuthHandler struct {
 	v1.UnimplementedAuthServiceServer
-	authUC *usecases.AuthUseCase
+	authUC           *usecases.AuthUseCase
+	socialUC         *usecases.SocialAuthUseCase
+	serviceAccountUC *usecases.ServiceAccountUseCase
+	magicLinkUC      *usecases.MagicLinkUseCase
+	guardianUC       *usecases.GuardianUseCase
+	invitationUC     *usecases.ClientInvitationUseCase
 }
 
-func NewAuthHandler(authUC *usecases.AuthUseCase) *AuthHandler {
-	return &AuthHandler{authUC: authUC}
+func NewAuthHandler(authUC *usecases.AuthUseCase, socialUC *usecases.SocialAuthUseCase, serviceAccountUC *usecases.ServiceAccountUseCase, magicLinkUC *usecases.MagicLinkUseCase, guardianUC *usecases.GuardianUseCase, invitationUC *usecases.ClientInvitationUseCase) *AuthHandler {
+	return &AuthHandler{authUC: authUC, socialUC: socialUC, serviceAccountUC: serviceAccountUC, magicLinkUC: magicLinkUC, guardianUC: guardianUC, invitationUC: invitationUC}
 }
 
+// dateLayout : format tanggal lahir di API
+const dateLayout = "2006-01-02"
+
 func (h *AuthHandler) Register(ctx context.Context, req *v1.RegisterRequest) (*v1.RegisterResponse, error) {
-	user, err := h.authUC.Register(ctx, req.Email, req.Password, entities.Role(req.Role))
+	var (
+		user *entities.User
+		err  error
+	)
+	switch {
+	case req.InvitationToken != "" && req.DateOfBirth != "":
+		return nil, status.Error(codes.InvalidArgument, "invitation_token cannot be combined with date_of_birth")
+	case req.InvitationToken != "":
+		user, err = h.invitationUC.Register(ctx, req.InvitationToken, req.Email, req.Password)
+	case req.DateOfBirth != "":
+		dob, parseErr := time.Parse(dateLayout, req.DateOfBirth)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "registration failed: %v", entities.ErrInvalidDateOfBirth)
+		}
+		user, err = h.guardianUC.Register(ctx, req.Email, req.Password, entities.Role(req.Role), dob, req.GuardianEmail, entities.GuardianRelationship(req.GuardianRelationship))
+	default:
+		user, err = h.authUC.Register(ctx, req.Email, req.Password, entities.Role(req.Role))
+	}
 	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidRole), errors.Is(err, entities.ErrInvalidDateOfBirth), errors.Is(err, entities.ErrGuardianRequired),
+			errors.Is(err, entities.ErrInvalidToken):
+			return nil, status.Errorf(codes.InvalidArgument, "registration failed: %v", err)
+		case errors.Is(err, entities.ErrInvitationEmailMismatch):
+			return nil, status.Errorf(codes.PermissionDenied, "registration failed: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "registration failed: %v", err)
 	}
-	return &v1.RegisterResponse{UserId: user.ID}, nil
+	resp := &v1.RegisterResponse{UserId: user.ID, Status: string(user.Status)}
+	if h.authUC.RequiresEmailVerification(user) {
+		// Akun sudah dibuat; jika kode gagal terkirim, user bisa login untuk meminta kode baru
+		if resp.EmailVerificationChallengeId, err = h.authUC.SendEmailVerification(ctx, user); err != nil {
+			zap.L().Warn("failed to send email verification", zap.String("user_id", user.ID), zap.Error(err))
+		}
+	}
+	return resp, nil
 }
 
 func (h *AuthHandler) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResponse, error) {
 	accessToken, refreshToken, err := h.authUC.Login(ctx, req.Email, req.Password)
+	if mfa, ok := mfaRequired(err); ok {
+		return &v1.LoginResponse{MfaChallengeId: mfa.ChallengeID, MfaChannel: string(mfa.Channel)}, nil
+	}
+	var verification *entities.EmailVerificationRequiredError
+	if errors.As(err, &verification) {
+		return &v1.LoginResponse{EmailVerificationChallengeId: verification.ChallengeID}, nil
+	}
+	if st := consentRequiredStatus(err, "login failed"); st != nil {
+		return nil, st
+	}
 	if err != nil {
+		switch {
+		case isAccountStatusError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "login failed: %v", err)
+		case errors.Is(err, entities.ErrPasswordResetRequired), errors.Is(err, entities.ErrStepUpRequired):
+			return nil, status.Errorf(codes.FailedPrecondition, "login failed: %v", err)
+		case errors.Is(err, entities.ErrOTPRateLimited):
+			return nil, status.Errorf(codes.ResourceExhausted, "login failed: %v", err)
+		}
 		return nil, status.Errorf(codes.Unauthenticated, "login failed: %v", err)
 	}
 	return &v1.LoginResponse{
@@ -37,3 +100,363 @@ func (h *AuthHandler) Login(ctx context.Context, req *v1.LoginRequest) (*v1.Logi
 		RefreshToken: refreshToken,
 	}, nil
 }
+
+func (h *AuthHandler) RefreshToken(ctx context.Context, req *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error) {
+	accessToken, refreshToken, err := h.authUC.RefreshToken(ctx, req.RefreshToken)
+	if st := consentRequiredStatus(err, "refresh failed"); st != nil {
+		return nil, st
+	}
+	if err != nil {
+		if isAccountStatusError(err) {
+			return nil, status.Errorf(codes.PermissionDenied, "refresh failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "refresh failed: %v", err)
+	}
+	return &v1.RefreshTokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (h *AuthHandler) Logout(ctx context.Context, req *v1.LogoutRequest) (*v1.LogoutResponse, error) {
+	if err := h.authUC.Logout(ctx, req.RefreshToken); err != nil {
+		if errors.Is(err, entities.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "logout failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "logout failed: %v", err)
+	}
+
+	// Access token yang dipakai memanggil Logout tidak boleh tetap berlaku
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		if err := h.authUC.RevokeAccessToken(ctx, claims); err != nil {
+			return nil, status.Errorf(codes.Internal, "logout failed: %v", err)
+		}
+	}
+	return &v1.LogoutResponse{}, nil
+}
+
+func (h *AuthHandler) GetSocialLoginURL(ctx context.Context, req *v1.GetSocialLoginURLRequest) (*v1.GetSocialLoginURLResponse, error) {
+	url, err := h.socialUC.AuthCodeURL(ctx, req.Provider, req.State, req.Nonce)
+	if err != nil {
+		if errors.Is(err, entities.ErrUnknownProvider) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		// Discovery provider gagal diambil, bisa dicoba lagi
+		return nil, status.Errorf(codes.Unavailable, "identity provider unavailable: %v", err)
+	}
+	return &v1.GetSocialLoginURLResponse{Url: url}, nil
+}
+
+func (h *AuthHandler) SocialLogin(ctx context.Context, req *v1.SocialLoginRequest) (*v1.SocialLoginResponse, error) {
+	accessToken, refreshToken, err := h.socialUC.Login(ctx, req.Provider, req.Code, req.Nonce)
+	if mfa, ok := mfaRequired(err); ok {
+		return &v1.SocialLoginResponse{MfaChallengeId: mfa.ChallengeID, MfaChannel: string(mfa.Channel)}, nil
+	}
+	if st := consentRequiredStatus(err, "social login failed"); st != nil {
+		return nil, st
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrUnknownProvider):
+			return nil, status.Errorf(codes.InvalidArgument, "social login failed: %v", err)
+		case errors.Is(err, entities.ErrEmailNotVerified), errors.Is(err, entities.ErrStepUpRequired):
+			return nil, status.Errorf(codes.FailedPrecondition, "social login failed: %v", err)
+		case isAccountStatusError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "social login failed: %v", err)
+		case errors.Is(err, entities.ErrOTPRateLimited):
+			return nil, status.Errorf(codes.ResourceExhausted, "social login failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "social login failed: %v", err)
+	}
+	return &v1.SocialLoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (h *AuthHandler) ClientCredentialsToken(ctx context.Context, req *v1.ClientCredentialsTokenRequest) (*v1.ClientCredentialsTokenResponse, error) {
+	if req.GrantType != "client_credentials" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported grant_type %q", req.GrantType)
+	}
+
+	token, expiresIn, scopes, err := h.serviceAccountUC.ExchangeClientCredentials(ctx, req.ClientId, req.ClientSecret, req.Scopes)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidScope) {
+			return nil, status.Errorf(codes.PermissionDenied, "token exchange failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "token exchange failed: %v", err)
+	}
+	return &v1.ClientCredentialsTokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(expiresIn.Seconds()),
+		Scopes:      scopes,
+	}, nil
+}
+
+func (h *AuthHandler) IntrospectToken(ctx context.Context, req *v1.IntrospectTokenRequest) (*v1.IntrospectTokenResponse, error) {
+	claims, err := h.authUC.IntrospectToken(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidToken) {
+			return &v1.IntrospectTokenResponse{Active: false}, nil
+		}
+		return nil, status.Errorf(codes.Unavailable, "introspection unavailable: %v", err)
+	}
+
+	resp := &v1.IntrospectTokenResponse{
+		Active:      true,
+		UserId:      claims.UserID,
+		Role:        claims.Role,
+		Scopes:      claims.Scopes,
+		TokenId:     claims.ID,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+	}
+	if claims.ExpiresAt != nil {
+		resp.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	if issuedAt := claims.IssuedAtTime(); !issuedAt.IsZero() {
+		resp.IssuedAt = issuedAt.Unix()
+	}
+	if claims.AuthTime != nil {
+		resp.AuthTime = claims.AuthTime.Unix()
+		resp.Acr = claims.ACR
+		resp.Amr = claims.AMR
+	}
+	resp.GuardianOf = claims.GuardianOf
+	resp.Minor = claims.Minor
+	resp.OrgId = claims.OrgID
+	resp.OrgRole = claims.OrgRole
+	return resp, nil
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error) {
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+	if err := h.authUC.ResetPassword(ctx, req.ResetToken, req.NewPassword); err != nil {
+		if errors.Is(err, entities.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "password reset failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "password reset failed: %v", err)
+	}
+	return &v1.ResetPasswordResponse{}, nil
+}
+
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *v1.ChangePasswordRequest) (*v1.ChangePasswordResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+
+	if err := h.authUC.ChangePassword(ctx, claims.UserID, req.CurrentPassword, req.NewPassword); err != nil {
+		if errors.Is(err, entities.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.PermissionDenied, "change password failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "change password failed: %v", err)
+	}
+	return &v1.ChangePasswordResponse{}, nil
+}
+
+func (h *AuthHandler) Reauthenticate(ctx context.Context, req *v1.ReauthenticateRequest) (*v1.ReauthenticateResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	var token string
+	var expiresIn time.Duration
+	var err error
+	if req.MfaChallengeId != "" {
+		token, expiresIn, err = h.authUC.ReauthenticateWithOTP(ctx, claims.UserID, claims.OrgID, req.MfaChallengeId, req.Code)
+	} else {
+		token, expiresIn, err = h.authUC.Reauthenticate(ctx, claims.UserID, claims.OrgID, req.Password)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidCredentials), isOTPError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "reauthentication failed: %v", err)
+		case isAccountStatusError(err), errors.Is(err, entities.ErrNotOrgMember):
+			return nil, status.Errorf(codes.PermissionDenied, "reauthentication failed: %v", err)
+		case errors.Is(err, entities.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unauthenticated, "reauthentication failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "reauthentication failed: %v", err)
+	}
+	return &v1.ReauthenticateResponse{
+		AccessToken: token,
+		ExpiresIn:   int64(expiresIn.Seconds()),
+	}, nil
+}
+
+func (h *AuthHandler) RequestMagicLink(ctx context.Context, req *v1.RequestMagicLinkRequest) (*v1.RequestMagicLinkResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if err := h.magicLinkUC.RequestMagicLink(ctx, req.Email); err != nil {
+		if errors.Is(err, entities.ErrMagicLinkRateLimited) {
+			return nil, status.Errorf(codes.ResourceExhausted, "magic link request failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "magic link request failed: %v", err)
+	}
+	return &v1.RequestMagicLinkResponse{}, nil
+}
+
+func (h *AuthHandler) ConsumeMagicLink(ctx context.Context, req *v1.ConsumeMagicLinkRequest) (*v1.ConsumeMagicLinkResponse, error) {
+	accessToken, refreshToken, err := h.magicLinkUC.ConsumeMagicLink(ctx, req.Token)
+	if mfa, ok := mfaRequired(err); ok {
+		return &v1.ConsumeMagicLinkResponse{MfaChallengeId: mfa.ChallengeID, MfaChannel: string(mfa.Channel)}, nil
+	}
+	if st := consentRequiredStatus(err, "magic link login failed"); st != nil {
+		return nil, st
+	}
+	if err != nil {
+		switch {
+		case isAccountStatusError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "magic link login failed: %v", err)
+		case errors.Is(err, entities.ErrPasswordResetRequired), errors.Is(err, entities.ErrStepUpRequired):
+			return nil, status.Errorf(codes.FailedPrecondition, "magic link login failed: %v", err)
+		case errors.Is(err, entities.ErrOTPRateLimited):
+			return nil, status.Errorf(codes.ResourceExhausted, "magic link login failed: %v", err)
+		case errors.Is(err, entities.ErrInvalidToken):
+			return nil, status.Errorf(codes.Unauthenticated, "magic link login failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "magic link login failed: %v", err)
+	}
+	return &v1.ConsumeMagicLinkResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *v1.VerifyMFARequest) (*v1.VerifyMFAResponse, error) {
+	if req.MfaChallengeId == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa_challenge_id and code are required")
+	}
+	accessToken, refreshToken, err := h.authUC.VerifyMFA(ctx, req.MfaChallengeId, req.Code)
+	if st := consentRequiredStatus(err, "mfa verification failed"); st != nil {
+		return nil, st
+	}
+	if err != nil {
+		switch {
+		case isOTPError(err):
+			return nil, status.Errorf(codes.Unauthenticated, "mfa verification failed: %v", err)
+		case isAccountStatusError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "mfa verification failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "mfa verification failed: %v", err)
+	}
+	return &v1.VerifyMFAResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *v1.VerifyEmailRequest) (*v1.VerifyEmailResponse, error) {
+	if req.ChallengeId == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "challenge_id and code are required")
+	}
+	if err := h.authUC.VerifyEmail(ctx, req.ChallengeId, req.Code); err != nil {
+		if isOTPError(err) {
+			return nil, status.Errorf(codes.Unauthenticated, "email verification failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "email verification failed: %v", err)
+	}
+	return &v1.VerifyEmailResponse{}, nil
+}
+
+func (h *AuthHandler) SendStepUpCode(ctx context.Context, req *v1.SendStepUpCodeRequest) (*v1.SendStepUpCodeResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	challengeID, channel, err := h.authUC.SendStepUpCode(ctx, claims.UserID)
+	if err != nil {
+		switch {
+		case isAccountStatusError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "send step-up code failed: %v", err)
+		case errors.Is(err, entities.ErrPhoneNotVerified), errors.Is(err, entities.ErrInvalidOTPChannel):
+			return nil, status.Errorf(codes.FailedPrecondition, "send step-up code failed: %v", err)
+		case errors.Is(err, entities.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unauthenticated, "send step-up code failed: %v", err)
+		case errors.Is(err, entities.ErrOTPRateLimited):
+			return nil, status.Errorf(codes.ResourceExhausted, "send step-up code failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "send step-up code failed: %v", err)
+	}
+	return &v1.SendStepUpCodeResponse{MfaChallengeId: challengeID, MfaChannel: string(channel)}, nil
+}
+
+func (h *AuthHandler) SwitchOrganization(ctx context.Context, req *v1.SwitchOrganizationRequest) (*v1.SwitchOrganizationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	accessToken, refreshToken, err := h.authUC.SwitchOrganization(ctx, claims.UserID, req.RefreshToken, req.OrgId)
+	if st := consentRequiredStatus(err, "switch organization failed"); st != nil {
+		return nil, st
+	}
+	if err != nil {
+		switch {
+		case isAccountStatusError(err):
+			return nil, status.Errorf(codes.PermissionDenied, "switch organization failed: %v", err)
+		case errors.Is(err, entities.ErrNotOrgMember), errors.Is(err, entities.ErrOrganizationNotFound):
+			return nil, status.Errorf(codes.PermissionDenied, "switch organization failed: %v", err)
+		case errors.Is(err, entities.ErrInvalidToken), errors.Is(err, entities.ErrTokenRevoked):
+			return nil, status.Errorf(codes.InvalidArgument, "switch organization failed: %v", err)
+		case errors.Is(err, entities.ErrUserNotFound):
+			return nil, status.Errorf(codes.Unauthenticated, "switch organization failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "switch organization failed: %v", err)
+	}
+	return &v1.SwitchOrganizationResponse{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// mfaRequired : login yang tertahan menunggu faktor kedua bukan error bagi client
+func mfaRequired(err error) (*entities.MFARequiredError, bool) {
+	var mfa *entities.MFARequiredError
+	if errors.As(err, &mfa) {
+		return mfa, true
+	}
+	return nil, false
+}
+
+// consentRequiredStatus mengubah *ConsentRequiredError menjadi FailedPrecondition dengan ErrorInfo
+// berisi consent_token dan dokumen yang harus disetujui ("kind:version", dipisah koma).
+// Mengembalikan nil untuk error lain.
+func consentRequiredStatus(err error, prefix string) error {
+	var consent *entities.ConsentRequiredError
+	if !errors.As(err, &consent) {
+		return nil
+	}
+	docs := make([]string, len(consent.Missing))
+	for i, d := range consent.Missing {
+		docs[i] = string(d.Kind) + ":" + d.Version
+	}
+	st, detailErr := status.New(codes.FailedPrecondition, prefix+": "+err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: consentRequiredReason,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"consent_token": consent.Token,
+			"documents":     strings.Join(docs, ","),
+		},
+	})
+	if detailErr != nil {
+		return status.Errorf(codes.Internal, "%s: %v", prefix, detailErr)
+	}
+	return st.Err()
+}
+
+func isOTPError(err error) bool {
+	return errors.Is(err, entities.ErrInvalidOTP) || errors.Is(err, entities.ErrOTPAttemptsExceeded)
+}
+
+func isAccountStatusError(err error) bool {
+	return errors.Is(err, entities.ErrAccountPendingVerification) ||
+		errors.Is(err, entities.ErrGuardianConsentRequired) ||
+		errors.Is(err, entities.ErrAccountSuspended) ||
+		errors.Is(err, entities.ErrAccountDeactivated)
+}
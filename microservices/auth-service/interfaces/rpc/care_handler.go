@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accessCacheTTL : lama hasil CheckAccess boleh di-cache service lain. Hubungan yang diakhiri
+// paling lama selama ini masih dianggap aktif oleh cache pemanggil.
+const accessCacheTTL = 30 * time.Second
+
+type CareHandler struct {
+	v1.UnimplementedCareServiceServer
+	careUC *usecases.CareUseCase
+}
+
+func NewCareHandler(careUC *usecases.CareUseCase) *CareHandler {
+	return &CareHandler{careUC: careUC}
+}
+
+func (h *CareHandler) RequestCare(ctx context.Context, req *v1.RequestCareRequest) (*v1.RequestCareResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	rel, err := h.careUC.RequestCare(ctx, claims.UserID, req.CounterpartyId)
+	if err != nil {
+		return nil, careError(err)
+	}
+	return &v1.RequestCareResponse{Relationship: toProtoCareRelationship(rel)}, nil
+}
+
+func (h *CareHandler) AcceptCare(ctx context.Context, req *v1.AcceptCareRequest) (*v1.AcceptCareResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	rel, err := h.careUC.AcceptCare(ctx, claims.UserID, req.RelationshipId)
+	if err != nil {
+		return nil, careError(err)
+	}
+	return &v1.AcceptCareResponse{Relationship: toProtoCareRelationship(rel)}, nil
+}
+
+func (h *CareHandler) EndCare(ctx context.Context, req *v1.EndCareRequest) (*v1.EndCareResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	rel, err := h.careUC.EndCare(ctx, claims.UserID, req.RelationshipId, req.Reason)
+	if err != nil {
+		return nil, careError(err)
+	}
+	return &v1.EndCareResponse{Relationship: toProtoCareRelationship(rel)}, nil
+}
+
+func (h *CareHandler) ListCareRelationships(ctx context.Context, req *v1.ListCareRelationshipsRequest) (*v1.ListCareRelationshipsResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	rels, err := h.careUC.ListCareRelationships(ctx, claims.UserID)
+	if err != nil {
+		return nil, careError(err)
+	}
+	resp := &v1.ListCareRelationshipsResponse{Relationships: make([]*v1.CareRelationship, len(rels))}
+	for i, rel := range rels {
+		resp.Relationships[i] = toProtoCareRelationship(rel)
+	}
+	return resp, nil
+}
+
+func (h *CareHandler) CheckAccess(ctx context.Context, req *v1.CheckAccessRequest) (*v1.CheckAccessResponse, error) {
+	decision, err := h.careUC.CheckAccess(ctx, req.Subject, req.ResourceOwner, entities.AccessAction(req.Action))
+	if err != nil {
+		return nil, careError(err)
+	}
+	return &v1.CheckAccessResponse{
+		Allowed:         decision.Allowed,
+		Grant:           string(decision.Grant),
+		RelationshipId:  decision.RelationshipID,
+		CacheTtlSeconds: int64(accessCacheTTL.Seconds()),
+	}, nil
+}
+
+func toProtoCareRelationship(rel *entities.CareRelationship) *v1.CareRelationship {
+	result := &v1.CareRelationship{
+		RelationshipId: rel.ID,
+		ClientId:       rel.ClientID,
+		PsychologistId: rel.PsychologistID,
+		Status:         string(rel.Status),
+		RequestedBy:    rel.RequestedBy,
+		CreatedAt:      rel.CreatedAt.Unix(),
+		EndedBy:        rel.EndedBy,
+		EndReason:      rel.EndReason,
+	}
+	if rel.ClientConsentedAt != nil {
+		result.ClientConsentedAt = rel.ClientConsentedAt.Unix()
+	}
+	if rel.PsychologistConsentedAt != nil {
+		result.PsychologistConsentedAt = rel.PsychologistConsentedAt.Unix()
+	}
+	if rel.EndedAt != nil {
+		result.EndedAt = rel.EndedAt.Unix()
+	}
+	return result
+}
+
+func careError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidCareParty), errors.Is(err, entities.ErrInvalidAccessAction):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entities.ErrInvalidStatusTransition):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, entities.ErrNotPsychologist):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrCareRelationshipExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, entities.ErrCareRelationshipNotFound), errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ClientInvitationHandler struct {
+	v1.UnimplementedClientInvitationServiceServer
+	invitationUC *usecases.ClientInvitationUseCase
+}
+
+func NewClientInvitationHandler(invitationUC *usecases.ClientInvitationUseCase) *ClientInvitationHandler {
+	return &ClientInvitationHandler{invitationUC: invitationUC}
+}
+
+func (h *ClientInvitationHandler) CreateClientInvitation(ctx context.Context, req *v1.CreateClientInvitationRequest) (*v1.CreateClientInvitationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if strings.TrimSpace(req.Email) == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	var expiresAt time.Time
+	if req.ExpiresAt > 0 {
+		expiresAt = time.Unix(req.ExpiresAt, 0)
+	}
+	inv, err := h.invitationUC.CreateInvitation(ctx, claims.UserID, req.Email, expiresAt, req.PreLinkCare)
+	if err != nil {
+		return nil, clientInvitationError(err)
+	}
+	return &v1.CreateClientInvitationResponse{Invitation: toProtoClientInvitation(inv)}, nil
+}
+
+func (h *ClientInvitationHandler) ListClientInvitations(ctx context.Context, req *v1.ListClientInvitationsRequest) (*v1.ListClientInvitationsResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	invitations, err := h.invitationUC.ListInvitations(ctx, claims.UserID)
+	if err != nil {
+		return nil, clientInvitationError(err)
+	}
+	resp := &v1.ListClientInvitationsResponse{Invitations: make([]*v1.ClientInvitation, len(invitations))}
+	for i, inv := range invitations {
+		resp.Invitations[i] = toProtoClientInvitation(inv)
+	}
+	return resp, nil
+}
+
+func (h *ClientInvitationHandler) RevokeClientInvitation(ctx context.Context, req *v1.RevokeClientInvitationRequest) (*v1.RevokeClientInvitationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.invitationUC.RevokeInvitation(ctx, claims.UserID, req.InvitationId); err != nil {
+		return nil, clientInvitationError(err)
+	}
+	return &v1.RevokeClientInvitationResponse{}, nil
+}
+
+func toProtoClientInvitation(inv *entities.ClientInvitation) *v1.ClientInvitation {
+	result := &v1.ClientInvitation{
+		InvitationId: inv.ID,
+		Email:        inv.Email,
+		PreLinkCare:  inv.PreLinkCare,
+		CreatedAt:    inv.CreatedAt.Unix(),
+		ExpiresAt:    inv.ExpiresAt.Unix(),
+		ClientId:     inv.ClientID,
+	}
+	if inv.AcceptedAt != nil {
+		result.AcceptedAt = inv.AcceptedAt.Unix()
+	}
+	if inv.RevokedAt != nil {
+		result.RevokedAt = inv.RevokedAt.Unix()
+	}
+	return result
+}
+
+func clientInvitationError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidInvitationExpiry):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entities.ErrNotPsychologist):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrEmailExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, entities.ErrInvitationNotFound), errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
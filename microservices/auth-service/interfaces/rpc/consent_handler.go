@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// errorDomain : domain ErrorInfo untuk error yang membawa detail terstruktur
+	errorDomain = "auth.v1"
+	// consentRequiredReason : login tertahan sampai dokumen wajib disetujui
+	consentRequiredReason = "CONSENT_REQUIRED"
+)
+
+type ConsentHandler struct {
+	v1.UnimplementedConsentServiceServer
+	consentUC *usecases.ConsentUseCase
+}
+
+func NewConsentHandler(consentUC *usecases.ConsentUseCase) *ConsentHandler {
+	return &ConsentHandler{consentUC: consentUC}
+}
+
+func (h *ConsentHandler) ListConsentDocuments(ctx context.Context, req *v1.ListConsentDocumentsRequest) (*v1.ListConsentDocumentsResponse, error) {
+	docs, err := h.consentUC.ListDocuments(ctx)
+	if err != nil {
+		return nil, consentError(err)
+	}
+	resp := &v1.ListConsentDocumentsResponse{Documents: make([]*v1.ConsentDocument, len(docs))}
+	for i, doc := range docs {
+		resp.Documents[i] = toProtoConsentDocument(doc)
+	}
+	return resp, nil
+}
+
+// RecordConsent : method publik, user diambil dari access token jika ada, selain itu dari consent_token
+func (h *ConsentHandler) RecordConsent(ctx context.Context, req *v1.RecordConsentRequest) (*v1.RecordConsentResponse, error) {
+	kind := entities.ConsentKind(req.Kind)
+	var record *entities.ConsentRecord
+	var err error
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		record, err = h.consentUC.RecordConsent(ctx, claims.UserID, kind, req.Version)
+	} else if req.ConsentToken != "" {
+		record, err = h.consentUC.RecordConsentWithToken(ctx, req.ConsentToken, kind, req.Version)
+	} else {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err != nil {
+		return nil, consentError(err)
+	}
+	return &v1.RecordConsentResponse{Consent: toProtoConsentRecord(record)}, nil
+}
+
+func (h *ConsentHandler) ListConsents(ctx context.Context, req *v1.ListConsentsRequest) (*v1.ListConsentsResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	records, err := h.consentUC.ListConsents(ctx, claims.UserID)
+	if err != nil {
+		return nil, consentError(err)
+	}
+	resp := &v1.ListConsentsResponse{Consents: make([]*v1.ConsentRecord, len(records))}
+	for i, record := range records {
+		resp.Consents[i] = toProtoConsentRecord(record)
+	}
+	return resp, nil
+}
+
+func (h *ConsentHandler) WithdrawConsent(ctx context.Context, req *v1.WithdrawConsentRequest) (*v1.WithdrawConsentResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.consentUC.WithdrawConsent(ctx, claims.UserID, entities.ConsentKind(req.Kind)); err != nil {
+		return nil, consentError(err)
+	}
+	return &v1.WithdrawConsentResponse{}, nil
+}
+
+func (h *ConsentHandler) PublishConsentDocument(ctx context.Context, req *v1.PublishConsentDocumentRequest) (*v1.PublishConsentDocumentResponse, error) {
+	if req.Document == nil {
+		return nil, status.Error(codes.InvalidArgument, "document is required")
+	}
+	doc := &entities.ConsentDocument{
+		Kind:     entities.ConsentKind(req.Document.Kind),
+		Version:  req.Document.Version,
+		Title:    req.Document.Title,
+		URL:      req.Document.Url,
+		Required: req.Document.Required,
+	}
+	for _, role := range req.Document.Roles {
+		doc.Roles = append(doc.Roles, entities.Role(role))
+	}
+	if err := h.consentUC.PublishDocument(ctx, doc); err != nil {
+		return nil, consentError(err)
+	}
+	return &v1.PublishConsentDocumentResponse{Document: toProtoConsentDocument(doc)}, nil
+}
+
+func toProtoConsentDocument(doc *entities.ConsentDocument) *v1.ConsentDocument {
+	result := &v1.ConsentDocument{
+		Kind:        string(doc.Kind),
+		Version:     doc.Version,
+		Title:       doc.Title,
+		Url:         doc.URL,
+		Required:    doc.Required,
+		PublishedAt: doc.PublishedAt.Unix(),
+	}
+	for _, role := range doc.Roles {
+		result.Roles = append(result.Roles, string(role))
+	}
+	return result
+}
+
+func toProtoConsentRecord(record *entities.ConsentRecord) *v1.ConsentRecord {
+	result := &v1.ConsentRecord{
+		ConsentId:  record.ID,
+		Kind:       string(record.Kind),
+		Version:    record.Version,
+		AcceptedAt: record.AcceptedAt.Unix(),
+		Ip:         record.IP,
+		UserAgent:  record.UserAgent,
+	}
+	if record.WithdrawnAt != nil {
+		result.WithdrawnAt = record.WithdrawnAt.Unix()
+	}
+	return result
+}
+
+func consentError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidConsentDocument):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entities.ErrConsentVersionOutdated):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, entities.ErrConsentVersionExists):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, entities.ErrConsentDocumentNotFound), errors.Is(err, entities.ErrConsentNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, entities.ErrInvalidToken):
+		return status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
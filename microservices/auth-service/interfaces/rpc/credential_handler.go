@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CredentialHandler struct {
+	v1.UnimplementedCredentialServiceServer
+	credentialUC *usecases.CredentialUseCase
+}
+
+func NewCredentialHandler(credentialUC *usecases.CredentialUseCase) *CredentialHandler {
+	return &CredentialHandler{credentialUC: credentialUC}
+}
+
+func (h *CredentialHandler) SubmitCredential(ctx context.Context, req *v1.SubmitCredentialRequest) (*v1.SubmitCredentialResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	cred, err := h.credentialUC.SubmitCredential(ctx, claims.UserID, req.LicenseNumber, req.Jurisdiction, req.DocumentRefs)
+	if err != nil {
+		return nil, credentialError(err)
+	}
+	return &v1.SubmitCredentialResponse{Credential: toProtoCredential(cred)}, nil
+}
+
+func (h *CredentialHandler) ListMyCredentials(ctx context.Context, req *v1.ListMyCredentialsRequest) (*v1.ListMyCredentialsResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+
+	creds, err := h.credentialUC.ListUserCredentials(ctx, claims.UserID)
+	if err != nil {
+		return nil, credentialError(err)
+	}
+
+	resp := &v1.ListMyCredentialsResponse{}
+	for _, c := range creds {
+		resp.Credentials = append(resp.Credentials, toProtoCredential(c))
+	}
+	return resp, nil
+}
+
+func (h *CredentialHandler) ListCredentials(ctx context.Context, req *v1.ListCredentialsRequest) (*v1.ListCredentialsResponse, error) {
+	creds, err := h.credentialUC.ListCredentials(ctx, entities.CredentialStatus(req.Status), int(req.PageSize), int(req.Offset))
+	if err != nil {
+		return nil, credentialError(err)
+	}
+
+	resp := &v1.ListCredentialsResponse{}
+	for _, c := range creds {
+		resp.Credentials = append(resp.Credentials, toProtoCredential(c))
+	}
+	return resp, nil
+}
+
+func (h *CredentialHandler) ApproveCredential(ctx context.Context, req *v1.ApproveCredentialRequest) (*v1.ApproveCredentialResponse, error) {
+	var expiresAt time.Time
+	if req.LicenseExpiresAt != nil {
+		expiresAt = req.LicenseExpiresAt.AsTime()
+	}
+
+	cred, err := h.credentialUC.ApproveCredential(ctx, req.CredentialId, reviewerID(ctx), expiresAt, req.Note)
+	if err != nil {
+		return nil, credentialError(err)
+	}
+	return &v1.ApproveCredentialResponse{Credential: toProtoCredential(cred)}, nil
+}
+
+func (h *CredentialHandler) RejectCredential(ctx context.Context, req *v1.RejectCredentialRequest) (*v1.RejectCredentialResponse, error) {
+	cred, err := h.credentialUC.RejectCredential(ctx, req.CredentialId, reviewerID(ctx), req.Note)
+	if err != nil {
+		return nil, credentialError(err)
+	}
+	return &v1.RejectCredentialResponse{Credential: toProtoCredential(cred)}, nil
+}
+
+// reviewerID : user admin yang memanggil, kosong jika dipanggil dengan service token
+func reviewerID(ctx context.Context) string {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok || claims.Role == string(entities.ServiceRole) {
+		return ""
+	}
+	return claims.UserID
+}
+
+func toProtoCredential(c *entities.PsychologistCredential) *v1.PsychologistCredential {
+	return &v1.PsychologistCredential{
+		Id:            c.ID,
+		UserId:        c.UserID,
+		LicenseNumber: c.LicenseNumber,
+		Jurisdiction:  c.Jurisdiction,
+		DocumentRefs:  c.DocumentRefs,
+		Status:        string(c.Status),
+		ReviewedBy:    c.ReviewedBy,
+		ReviewNote:    c.ReviewNote,
+		SubmittedAt:   timestamppb.New(c.SubmittedAt),
+		ReviewedAt:    toProtoTime(c.ReviewedAt),
+		ExpiresAt:     toProtoTime(c.ExpiresAt),
+	}
+}
+
+func credentialError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrCredentialNotFound), errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, entities.ErrInvalidCredential):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entities.ErrNotPsychologist), errors.Is(err, entities.ErrCredentialSelfReview):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrCredentialPending), errors.Is(err, entities.ErrCredentialNotPending):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "credential operation failed: %v", err)
+}
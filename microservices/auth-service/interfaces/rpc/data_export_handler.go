@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DataExportHandler struct {
+	v1.UnimplementedDataExportServiceServer
+	exportUC *usecases.DataExportUseCase
+}
+
+func NewDataExportHandler(exportUC *usecases.DataExportUseCase) *DataExportHandler {
+	return &DataExportHandler{exportUC: exportUC}
+}
+
+func (h *DataExportHandler) ExportMyData(ctx context.Context, req *v1.ExportMyDataRequest) (*v1.ExportMyDataResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	export, err := h.exportUC.RequestExport(ctx, claims.UserID)
+	if err != nil {
+		return nil, dataExportError(err)
+	}
+	return &v1.ExportMyDataResponse{Job: toProtoDataExportJob(export)}, nil
+}
+
+func (h *DataExportHandler) GetDataExport(ctx context.Context, req *v1.GetDataExportRequest) (*v1.GetDataExportResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	export, err := h.exportUC.GetExport(ctx, claims.UserID, req.ExportId)
+	if err != nil {
+		return nil, dataExportError(err)
+	}
+	return &v1.GetDataExportResponse{Job: toProtoDataExportJob(export)}, nil
+}
+
+func (h *DataExportHandler) DownloadDataExport(ctx context.Context, req *v1.DownloadDataExportRequest) (*v1.DownloadDataExportResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	export, err := h.exportUC.DownloadExport(ctx, claims.UserID, req.ExportId)
+	if err != nil {
+		return nil, dataExportError(err)
+	}
+	return &v1.DownloadDataExportResponse{
+		Archive:  export.Archive,
+		Filename: "data-export-" + export.ID + ".zip",
+		Sha256:   export.SHA256,
+	}, nil
+}
+
+func (h *DataExportHandler) ListExportSigningKeys(ctx context.Context, req *v1.ListExportSigningKeysRequest) (*v1.ListExportSigningKeysResponse, error) {
+	keys, err := h.exportUC.ListSigningKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	resp := &v1.ListExportSigningKeysResponse{}
+	for _, k := range keys {
+		resp.Keys = append(resp.Keys, &v1.ExportSigningKey{
+			KeyId:     k.ID,
+			Algorithm: k.Algorithm,
+			PublicKey: k.PublicKey,
+			CreatedAt: k.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func toProtoDataExportJob(export *entities.DataExport) *v1.DataExportJob {
+	result := &v1.DataExportJob{
+		ExportId:    export.ID,
+		Status:      string(export.Status),
+		RequestedAt: export.RequestedAt.Unix(),
+		Sha256:      export.SHA256,
+	}
+	if export.CompletedAt != nil {
+		result.CompletedAt = export.CompletedAt.Unix()
+	}
+	if export.ExpiresAt != nil {
+		result.ExpiresAt = export.ExpiresAt.Unix()
+	}
+	return result
+}
+
+func dataExportError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrDataExportNotFound), errors.Is(err, entities.ErrUserKeyDestroyed):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, entities.ErrDataExportNotReady):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
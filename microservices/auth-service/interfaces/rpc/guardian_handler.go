@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GuardianHandler struct {
+	v1.UnimplementedGuardianServiceServer
+	guardianUC *usecases.GuardianUseCase
+}
+
+func NewGuardianHandler(guardianUC *usecases.GuardianUseCase) *GuardianHandler {
+	return &GuardianHandler{guardianUC: guardianUC}
+}
+
+func (h *GuardianHandler) AcceptGuardianship(ctx context.Context, req *v1.AcceptGuardianshipRequest) (*v1.AcceptGuardianshipResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	ward, err := h.guardianUC.AcceptGuardianship(ctx, claims.UserID, req.Token)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+	return &v1.AcceptGuardianshipResponse{Ward: toProtoWard(ward)}, nil
+}
+
+func (h *GuardianHandler) ListWards(ctx context.Context, req *v1.ListWardsRequest) (*v1.ListWardsResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	wards, err := h.guardianUC.ListWards(ctx, claims.UserID)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+	resp := &v1.ListWardsResponse{}
+	for _, w := range wards {
+		resp.Wards = append(resp.Wards, toProtoWard(w))
+	}
+	return resp, nil
+}
+
+func (h *GuardianHandler) GetWard(ctx context.Context, req *v1.GetWardRequest) (*v1.GetWardResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	ward, err := h.guardianUC.GetWard(ctx, claims.UserID, req.UserId)
+	if err != nil {
+		return nil, guardianError(err)
+	}
+	return &v1.GetWardResponse{Ward: toProtoWard(ward)}, nil
+}
+
+func (h *GuardianHandler) SetWardStatus(ctx context.Context, req *v1.SetWardStatusRequest) (*v1.SetWardStatusResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.guardianUC.SetWardStatus(ctx, claims.UserID, req.UserId, entities.UserStatus(req.Status), req.Reason); err != nil {
+		return nil, guardianError(err)
+	}
+	return &v1.SetWardStatusResponse{}, nil
+}
+
+func (h *GuardianHandler) LogoutWard(ctx context.Context, req *v1.LogoutWardRequest) (*v1.LogoutWardResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.guardianUC.LogoutWard(ctx, claims.UserID, req.UserId); err != nil {
+		return nil, guardianError(err)
+	}
+	return &v1.LogoutWardResponse{}, nil
+}
+
+// toProtoWard sengaja hanya memuat data akun, tidak ada data klinis
+func toProtoWard(w *entities.Ward) *v1.Ward {
+	ward := &v1.Ward{
+		UserId:       w.User.ID,
+		Email:        w.User.Email,
+		Status:       string(w.User.Status),
+		Relationship: string(w.Guardianship.Relationship),
+	}
+	if w.User.DateOfBirth != nil {
+		ward.DateOfBirth = w.User.DateOfBirth.Format(dateLayout)
+	}
+	if w.Guardianship.ConsentedAt != nil {
+		ward.LinkedAt = w.Guardianship.ConsentedAt.Unix()
+	}
+	return ward
+}
+
+func guardianError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidToken), errors.Is(err, entities.ErrInvalidStatusTransition):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entities.ErrGuardianEmailMismatch), errors.Is(err, entities.ErrGuardianNotAdult):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrGuardianshipNotFound), errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
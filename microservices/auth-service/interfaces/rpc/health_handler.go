@@ -51,6 +51,6 @@ func (h *HealthHandler) Watch(req *grpc_health_v1.HealthCheckRequest, stream grp
 }
 
 func (h *HealthHandler) List(ctx context.Context, req *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
-    // TODO: implement the correct logic for HealthListResponse
-    return &grpc_health_v1.HealthListResponse{}, nil
+	// TODO: implement the correct logic for HealthListResponse
+	return &grpc_health_v1.HealthListResponse{}, nil
 }
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MFAHandler struct {
+	v1.UnimplementedMFAServiceServer
+	mfaUC *usecases.MFAUseCase
+}
+
+func NewMFAHandler(mfaUC *usecases.MFAUseCase) *MFAHandler {
+	return &MFAHandler{mfaUC: mfaUC}
+}
+
+func (h *MFAHandler) StartPhoneVerification(ctx context.Context, req *v1.StartPhoneVerificationRequest) (*v1.StartPhoneVerificationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	id, err := h.mfaUC.StartPhoneVerification(ctx, claims.UserID, req.Phone, claims.AuthTimeValue())
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return &v1.StartPhoneVerificationResponse{VerificationId: id}, nil
+}
+
+func (h *MFAHandler) ConfirmPhoneVerification(ctx context.Context, req *v1.ConfirmPhoneVerificationRequest) (*v1.ConfirmPhoneVerificationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.mfaUC.ConfirmPhoneVerification(ctx, claims.UserID, req.VerificationId, req.Code, claims.AuthTimeValue()); err != nil {
+		return nil, mfaError(err)
+	}
+	return &v1.ConfirmPhoneVerificationResponse{}, nil
+}
+
+func (h *MFAHandler) SetMFAChannel(ctx context.Context, req *v1.SetMFAChannelRequest) (*v1.SetMFAChannelResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.mfaUC.SetMFAChannel(ctx, claims.UserID, entities.OTPChannel(req.Channel), claims.AuthTimeValue()); err != nil {
+		return nil, mfaError(err)
+	}
+	return &v1.SetMFAChannelResponse{}, nil
+}
+
+func mfaError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidPhone), errors.Is(err, entities.ErrInvalidOTPChannel):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case isOTPError(err):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrPhoneNotVerified), errors.Is(err, entities.ErrStepUpRequired):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, entities.ErrOTPRateLimited):
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
@@ -0,0 +1,140 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"microservices/auth-service/interfaces/middleware"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type OrganizationHandler struct {
+	v1.UnimplementedOrganizationServiceServer
+	orgUC *usecases.OrganizationUseCase
+}
+
+func NewOrganizationHandler(orgUC *usecases.OrganizationUseCase) *OrganizationHandler {
+	return &OrganizationHandler{orgUC: orgUC}
+}
+
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *v1.CreateOrganizationRequest) (*v1.CreateOrganizationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	org, err := h.orgUC.CreateOrganization(ctx, claims.UserID, req.Name)
+	if err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.CreateOrganizationResponse{OrgId: org.ID}, nil
+}
+
+func (h *OrganizationHandler) ListMyOrganizations(ctx context.Context, req *v1.ListMyOrganizationsRequest) (*v1.ListMyOrganizationsResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	memberships, err := h.orgUC.ListMyOrganizations(ctx, claims.UserID)
+	if err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.ListMyOrganizationsResponse{Memberships: toProtoMemberships(memberships)}, nil
+}
+
+func (h *OrganizationHandler) AcceptInvitation(ctx context.Context, req *v1.AcceptInvitationRequest) (*v1.AcceptInvitationResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	membership, err := h.orgUC.AcceptInvitation(ctx, claims.UserID, req.Token)
+	if err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.AcceptInvitationResponse{Membership: toProtoMembership(membership)}, nil
+}
+
+func (h *OrganizationHandler) InviteMember(ctx context.Context, req *v1.InviteMemberRequest) (*v1.InviteMemberResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	inv, err := h.orgUC.InviteMember(ctx, claims.UserID, req.Email, entities.OrgRole(req.Role))
+	if err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.InviteMemberResponse{InvitationId: inv.ID, ExpiresAt: inv.ExpiresAt.Unix()}, nil
+}
+
+func (h *OrganizationHandler) ListMembers(ctx context.Context, req *v1.ListMembersRequest) (*v1.ListMembersResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	members, err := h.orgUC.ListMembers(ctx, claims.UserID)
+	if err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.ListMembersResponse{Members: toProtoMemberships(members)}, nil
+}
+
+func (h *OrganizationHandler) SetMemberRole(ctx context.Context, req *v1.SetMemberRoleRequest) (*v1.SetMemberRoleResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.orgUC.SetMemberRole(ctx, claims.UserID, req.UserId, entities.OrgRole(req.Role)); err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.SetMemberRoleResponse{}, nil
+}
+
+func (h *OrganizationHandler) RemoveMember(ctx context.Context, req *v1.RemoveMemberRequest) (*v1.RemoveMemberResponse, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	if err := h.orgUC.RemoveMember(ctx, claims.UserID, req.UserId); err != nil {
+		return nil, organizationError(err)
+	}
+	return &v1.RemoveMemberResponse{}, nil
+}
+
+func toProtoMemberships(memberships []*entities.Membership) []*v1.Membership {
+	result := make([]*v1.Membership, len(memberships))
+	for i, m := range memberships {
+		result[i] = toProtoMembership(m)
+	}
+	return result
+}
+
+func toProtoMembership(m *entities.Membership) *v1.Membership {
+	return &v1.Membership{
+		OrgId:    m.OrgID,
+		OrgName:  m.OrgName,
+		UserId:   m.UserID,
+		Email:    m.Email,
+		Role:     string(m.Role),
+		JoinedAt: m.CreatedAt.Unix(),
+	}
+}
+
+func organizationError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidOrganizationName), errors.Is(err, entities.ErrInvalidOrgRole), errors.Is(err, entities.ErrInvalidToken):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, entities.ErrTenantRequired), errors.Is(err, entities.ErrLastOrgOwner):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, entities.ErrUnauthorized), errors.Is(err, entities.ErrNotOrgMember),
+		errors.Is(err, entities.ErrInvitationEmailMismatch), errors.Is(err, entities.ErrNotPsychologist):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, entities.ErrAlreadyOrgMember):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, entities.ErrOrganizationNotFound), errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"microservices/auth-service/application/usecases"
+	"microservices/auth-service/domain/entities"
+	v1 "microservices/auth-service/gen/auth/v1"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ServiceAccountHandler struct {
+	v1.UnimplementedServiceAccountServiceServer
+	serviceAccountUC *usecases.ServiceAccountUseCase
+}
+
+func NewServiceAccountHandler(serviceAccountUC *usecases.ServiceAccountUseCase) *ServiceAccountHandler {
+	return &ServiceAccountHandler{serviceAccountUC: serviceAccountUC}
+}
+
+func (h *ServiceAccountHandler) CreateServiceAccount(ctx context.Context, req *v1.CreateServiceAccountRequest) (*v1.CreateServiceAccountResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	account, err := h.serviceAccountUC.CreateServiceAccount(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, entities.ErrEmailExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "service account already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "create service account failed: %v", err)
+	}
+	return &v1.CreateServiceAccountResponse{ServiceAccountId: account.ID}, nil
+}
+
+func (h *ServiceAccountHandler) CreateAPIKey(ctx context.Context, req *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	plain, key, err := h.serviceAccountUC.CreateAPIKey(ctx, req.ServiceAccountId, req.Scopes, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+	return &v1.CreateAPIKeyResponse{ApiKey: plain, Key: toProtoAPIKey(key)}, nil
+}
+
+func (h *ServiceAccountHandler) ListAPIKeys(ctx context.Context, req *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error) {
+	keys, err := h.serviceAccountUC.ListAPIKeys(ctx, req.ServiceAccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list api keys failed: %v", err)
+	}
+	resp := &v1.ListAPIKeysResponse{}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, toProtoAPIKey(key))
+	}
+	return resp, nil
+}
+
+func (h *ServiceAccountHandler) RotateAPIKey(ctx context.Context, req *v1.RotateAPIKeyRequest) (*v1.RotateAPIKeyResponse, error) {
+	plain, key, err := h.serviceAccountUC.RotateAPIKey(ctx, req.KeyId, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+	return &v1.RotateAPIKeyResponse{ApiKey: plain, Key: toProtoAPIKey(key)}, nil
+}
+
+func (h *ServiceAccountHandler) RevokeAPIKey(ctx context.Context, req *v1.RevokeAPIKeyRequest) (*v1.RevokeAPIKeyResponse, error) {
+	if err := h.serviceAccountUC.RevokeAPIKey(ctx, req.KeyId); err != nil {
+		return nil, apiKeyError(err)
+	}
+	return &v1.RevokeAPIKeyResponse{}, nil
+}
+
+func apiKeyError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrAPIKeyNotFound), errors.Is(err, entities.ErrUserNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "api key operation failed: %v", err)
+}
+
+func toProtoAPIKey(key *entities.APIKey) *v1.APIKey {
+	return &v1.APIKey{
+		Id:               key.ID,
+		ServiceAccountId: key.ServiceAccountID,
+		Prefix:           key.Prefix,
+		Scopes:           key.Scopes,
+		CreatedAt:        timestamppb.New(key.CreatedAt),
+		ExpiresAt:        toProtoTime(key.ExpiresAt),
+		RevokedAt:        toProtoTime(key.RevokedAt),
+		LastUsedAt:       toProtoTime(key.LastUsedAt),
+	}
+}
+
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS api_keys;
+
+DELETE FROM users WHERE role = 'service';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('client', 'psychologist'));
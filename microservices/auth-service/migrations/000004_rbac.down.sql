@@ -0,0 +1,16 @@
+-- Role di luar CHECK lama (admin, support, clinic_manager) dikembalikan ke role lama
+-- yang juga dimiliki user, atau client jika tidak ada
+UPDATE users u SET role = COALESCE(
+    (SELECT ur.role FROM user_roles ur
+     WHERE ur.user_id = u.id AND ur.role IN ('psychologist', 'client')
+     ORDER BY ur.role DESC LIMIT 1),
+    'client')
+WHERE u.role NOT IN ('client', 'psychologist', 'service');
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_fkey;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('client', 'psychologist', 'service'));
+
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS permissions;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,54 @@
+CREATE TABLE roles (
+    name VARCHAR(50) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE permissions (
+    name VARCHAR(100) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE role_permissions (
+    role VARCHAR(50) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission VARCHAR(100) NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+CREATE TABLE user_roles (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL REFERENCES roles(name),
+    granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
+
+CREATE INDEX idx_user_roles_role ON user_roles(role);
+
+INSERT INTO roles (name, description) VALUES
+    ('client', 'Klien yang menerima layanan'),
+    ('psychologist', 'Psikolog'),
+    ('service', 'Akun mesin untuk komunikasi antar service'),
+    ('admin', 'Administrator platform'),
+    ('clinic_manager', 'Pengelola klinik'),
+    ('support', 'Staf support');
+
+INSERT INTO permissions (name, description) VALUES
+    ('users:read', 'Melihat data user'),
+    ('users:manage', 'Mengubah status dan data user'),
+    ('roles:manage', 'Mengelola role dan permission'),
+    ('service_accounts:manage', 'Mengelola service account dan API key');
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'users:read'),
+    ('admin', 'users:manage'),
+    ('admin', 'roles:manage'),
+    ('admin', 'service_accounts:manage'),
+    ('support', 'users:read'),
+    ('clinic_manager', 'users:read');
+
+-- Role dikelola lewat tabel roles, bukan CHECK constraint
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_fkey FOREIGN KEY (role) REFERENCES roles(name);
+
+INSERT INTO user_roles (user_id, role)
+SELECT id, role FROM users;
@@ -0,0 +1,8 @@
+ALTER TABLE users
+    ADD COLUMN disabled_at TIMESTAMPTZ,
+    ADD COLUMN disabled_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN password_reset_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Pencarian admin berdasarkan prefix email dan rentang tanggal daftar
+CREATE INDEX idx_users_email_prefix ON users (lower(email) text_pattern_ops);
+CREATE INDEX idx_users_created_at ON users (created_at);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_users_status;
+
+ALTER TABLE users
+    ADD COLUMN disabled_at TIMESTAMPTZ,
+    ADD COLUMN disabled_reason TEXT NOT NULL DEFAULT '';
+
+UPDATE users
+SET disabled_at = COALESCE(status_changed_at, NOW()), disabled_reason = status_reason
+WHERE status <> 'active';
+
+ALTER TABLE users
+    DROP COLUMN status_changed_at,
+    DROP COLUMN status_reason,
+    DROP COLUMN status;
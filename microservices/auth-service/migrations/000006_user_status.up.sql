@@ -0,0 +1,16 @@
+ALTER TABLE users
+    ADD COLUMN status VARCHAR(30) NOT NULL DEFAULT 'active'
+        CHECK (status IN ('pending_verification', 'active', 'suspended', 'deactivated', 'deleted')),
+    ADD COLUMN status_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN status_changed_at TIMESTAMPTZ;
+
+-- Akun yang sebelumnya dinonaktifkan admin menjadi suspended
+UPDATE users
+SET status = 'suspended', status_reason = disabled_reason, status_changed_at = disabled_at
+WHERE disabled_at IS NOT NULL;
+
+ALTER TABLE users
+    DROP COLUMN disabled_at,
+    DROP COLUMN disabled_reason;
+
+CREATE INDEX idx_users_status ON users(status);
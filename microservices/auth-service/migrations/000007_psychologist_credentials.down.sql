@@ -0,0 +1,4 @@
+DELETE FROM role_permissions WHERE permission = 'credentials:review';
+DELETE FROM permissions WHERE name = 'credentials:review';
+
+DROP TABLE IF EXISTS psychologist_credentials;
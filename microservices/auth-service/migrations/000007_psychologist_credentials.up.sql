@@ -0,0 +1,27 @@
+CREATE TABLE psychologist_credentials (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    license_number VARCHAR(100) NOT NULL,
+    jurisdiction VARCHAR(100) NOT NULL,
+    document_refs TEXT[] NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'verified', 'rejected', 'expired')),
+    reviewed_by UUID REFERENCES users(id),
+    review_note TEXT NOT NULL DEFAULT '',
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_psychologist_credentials_user_id ON psychologist_credentials(user_id, submitted_at DESC);
+CREATE INDEX idx_psychologist_credentials_status ON psychologist_credentials(status);
+
+-- Hanya satu pengajuan pending per psikolog
+CREATE UNIQUE INDEX idx_psychologist_credentials_one_pending
+    ON psychologist_credentials(user_id) WHERE status = 'pending';
+
+INSERT INTO permissions (name, description) VALUES
+    ('credentials:review', 'Mereview lisensi psikolog');
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'credentials:review');
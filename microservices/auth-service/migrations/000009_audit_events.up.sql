@@ -0,0 +1,38 @@
+CREATE TABLE audit_events (
+    seq BIGSERIAL PRIMARY KEY,
+    action VARCHAR(100) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL DEFAULT '',
+    subject_id VARCHAR(255) NOT NULL DEFAULT '',
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    request_id VARCHAR(100) NOT NULL DEFAULT '',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    prev_hash CHAR(64) NOT NULL DEFAULT '',
+    hash CHAR(64) NOT NULL
+);
+
+CREATE INDEX idx_audit_events_actor ON audit_events(actor_id, seq);
+CREATE INDEX idx_audit_events_subject ON audit_events(subject_id, seq);
+CREATE INDEX idx_audit_events_occurred_at ON audit_events(occurred_at);
+
+-- Append-only: UPDATE/DELETE ditolak, perubahan langsung di database tetap terdeteksi lewat hash chain
+CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_no_update
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
+
+INSERT INTO permissions (name, description) VALUES
+    ('audit:read', 'Melihat dan mengekspor audit log');
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'audit:read');
@@ -0,0 +1,3 @@
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS app_version,
+    DROP COLUMN IF EXISTS device_id;
@@ -0,0 +1,3 @@
+ALTER TABLE audit_events
+    ADD COLUMN app_version VARCHAR(128) NOT NULL DEFAULT '',
+    ADD COLUMN device_id VARCHAR(128) NOT NULL DEFAULT '';
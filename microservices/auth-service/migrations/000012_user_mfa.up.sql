@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN phone VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN phone_verified_at TIMESTAMPTZ,
+    ADD COLUMN mfa_channel VARCHAR(10) NOT NULL DEFAULT ''
+        CHECK (mfa_channel IN ('', 'sms', 'email'));
@@ -0,0 +1,20 @@
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY,
+    kind VARCHAR(50) NOT NULL,
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    recipient VARCHAR(255) NOT NULL,
+    locale VARCHAR(8) NOT NULL DEFAULT 'id',
+    data JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(10) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sent', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+-- Dispatcher hanya membaca notifikasi yang belum terkirim
+CREATE INDEX idx_notifications_pending ON notifications(next_attempt_at) WHERE status = 'pending';
+-- Dead letter untuk investigasi / kirim ulang manual
+CREATE INDEX idx_notifications_dead ON notifications(created_at) WHERE status = 'dead';
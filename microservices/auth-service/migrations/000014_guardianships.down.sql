@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS guardianships;
+
+UPDATE users SET status = 'pending_verification' WHERE status = 'pending_guardian_consent';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check
+    CHECK (status IN ('pending_verification', 'active', 'suspended', 'deactivated', 'deleted'));
+
+ALTER TABLE users DROP COLUMN IF EXISTS date_of_birth;
@@ -0,0 +1,23 @@
+ALTER TABLE users ADD COLUMN date_of_birth DATE;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check
+    CHECK (status IN ('pending_verification', 'pending_guardian_consent', 'active', 'suspended', 'deactivated', 'deleted'));
+
+CREATE TABLE guardianships (
+    id UUID PRIMARY KEY,
+    minor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    guardian_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    guardian_email VARCHAR(255) NOT NULL,
+    relationship VARCHAR(20) NOT NULL CHECK (relationship IN ('parent', 'legal_guardian')),
+    status VARCHAR(10) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'active', 'revoked')),
+    consent_token_hash VARCHAR(64) UNIQUE,
+    consent_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    consented_at TIMESTAMPTZ,
+    CHECK (status <> 'active' OR guardian_id IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX idx_guardianships_active ON guardianships(guardian_id, minor_id) WHERE status = 'active';
+CREATE INDEX idx_guardianships_minor ON guardianships(minor_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS care_relationships;
+DROP TABLE IF EXISTS client_invitations;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
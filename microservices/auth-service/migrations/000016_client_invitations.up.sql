@@ -0,0 +1,29 @@
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMPTZ;
+
+CREATE TABLE client_invitations (
+    id UUID PRIMARY KEY,
+    psychologist_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    pre_link_care BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    client_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_client_invitations_psychologist ON client_invitations(psychologist_id, created_at DESC);
+
+CREATE TABLE care_relationships (
+    id UUID PRIMARY KEY,
+    client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    psychologist_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('requested', 'active', 'ended')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    client_consented_at TIMESTAMPTZ
+);
+
+-- Satu hubungan berjalan per pasangan klien - psikolog
+CREATE UNIQUE INDEX idx_care_relationships_open ON care_relationships(client_id, psychologist_id)
+    WHERE status IN ('requested', 'active');
+CREATE INDEX idx_care_relationships_psychologist ON care_relationships(psychologist_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_care_relationships_client;
+ALTER TABLE care_relationships DROP CONSTRAINT IF EXISTS care_relationships_active_check;
+ALTER TABLE care_relationships
+    DROP COLUMN IF EXISTS end_reason,
+    DROP COLUMN IF EXISTS ended_by,
+    DROP COLUMN IF EXISTS ended_at,
+    DROP COLUMN IF EXISTS psychologist_consented_at,
+    DROP COLUMN IF EXISTS requested_by;
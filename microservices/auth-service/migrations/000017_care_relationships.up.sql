@@ -0,0 +1,15 @@
+ALTER TABLE care_relationships
+    ADD COLUMN requested_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN psychologist_consented_at TIMESTAMPTZ,
+    ADD COLUMN ended_at TIMESTAMPTZ,
+    ADD COLUMN ended_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN end_reason TEXT NOT NULL DEFAULT '';
+
+-- Hubungan dari undangan klien (000016) dimulai oleh psikolog yang mengundang
+UPDATE care_relationships SET requested_by = psychologist_id, psychologist_consented_at = created_at
+    WHERE requested_by IS NULL;
+
+ALTER TABLE care_relationships ADD CONSTRAINT care_relationships_active_check
+    CHECK (status <> 'active' OR (client_consented_at IS NOT NULL AND psychologist_consented_at IS NOT NULL));
+
+CREATE INDEX idx_care_relationships_client ON care_relationships(client_id);
@@ -0,0 +1,5 @@
+DELETE FROM role_permissions WHERE permission = 'consents:manage';
+DELETE FROM permissions WHERE name = 'consents:manage';
+
+DROP TABLE IF EXISTS consent_records;
+DROP TABLE IF EXISTS consent_documents;
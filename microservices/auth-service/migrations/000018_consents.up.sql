@@ -0,0 +1,34 @@
+CREATE TABLE consent_documents (
+    kind VARCHAR(40) NOT NULL CHECK (kind IN ('terms', 'privacy_policy', 'clinical_data_processing')),
+    version VARCHAR(40) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL DEFAULT '',
+    required BOOLEAN NOT NULL DEFAULT TRUE,
+    roles TEXT[] NOT NULL DEFAULT '{}',
+    published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (kind, version)
+);
+
+CREATE INDEX idx_consent_documents_latest ON consent_documents(kind, published_at DESC);
+
+-- Bukti persetujuan, tidak pernah dihapus (penarikan hanya mengisi withdrawn_at)
+CREATE TABLE consent_records (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(40) NOT NULL,
+    version VARCHAR(40) NOT NULL,
+    accepted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    withdrawn_at TIMESTAMPTZ,
+    FOREIGN KEY (kind, version) REFERENCES consent_documents(kind, version)
+);
+
+CREATE INDEX idx_consent_records_user ON consent_records(user_id, accepted_at DESC);
+CREATE UNIQUE INDEX idx_consent_records_active ON consent_records(user_id, kind, version) WHERE withdrawn_at IS NULL;
+
+INSERT INTO permissions (name, description) VALUES
+    ('consents:manage', 'Menerbitkan versi baru dokumen persetujuan');
+
+INSERT INTO role_permissions (role, permission) VALUES
+    ('admin', 'consents:manage');
@@ -0,0 +1,20 @@
+-- Job export data user (hak akses data); arsip disimpan sampai expires_at lalu dihapus
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'expired')),
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    lease_until TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    archive BYTEA,
+    sha256 VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_data_exports_user ON data_exports(user_id, requested_at DESC);
+CREATE INDEX idx_data_exports_queue ON data_exports(requested_at) WHERE status IN ('pending', 'processing');
+-- Satu job aktif per user
+CREATE UNIQUE INDEX idx_data_exports_active ON data_exports(user_id) WHERE status IN ('pending', 'processing');
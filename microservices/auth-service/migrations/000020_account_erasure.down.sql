@@ -0,0 +1,10 @@
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE audit_events DROP COLUMN IF EXISTS redacted;
+DROP TABLE IF EXISTS user_keys;
+DROP INDEX IF EXISTS idx_users_deletion_due;
+ALTER TABLE users DROP COLUMN IF EXISTS deletion_scheduled_at;
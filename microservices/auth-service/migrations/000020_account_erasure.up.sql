@@ -0,0 +1,34 @@
+-- Penghapusan akun dijadwalkan dengan masa tenggang, NULL berarti tidak ada jadwal
+ALTER TABLE users ADD COLUMN deletion_scheduled_at TIMESTAMPTZ;
+
+CREATE INDEX idx_users_deletion_due ON users(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
+-- Kunci enkripsi per user (crypto-shredding): data yang dienkripsi dengan kunci ini
+-- tidak bisa dibaca lagi setelah barisnya dihapus
+CREATE TABLE user_keys (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    data_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Event milik user yang dihapus dipseudonimkan; hash lama dipertahankan agar rantai tetap
+-- tersambung, dan event bertanda redacted tidak dihitung ulang hash-nya saat verifikasi
+ALTER TABLE audit_events ADD COLUMN redacted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- UPDATE hanya diizinkan untuk pseudonimisasi: dalam transaksi dengan
+-- SET LOCAL audit.allow_redaction = 'on', tanpa mengubah urutan maupun hash
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND current_setting('audit.allow_redaction', true) = 'on'
+        AND NEW.redacted
+        AND NEW.seq = OLD.seq
+        AND NEW.action = OLD.action
+        AND NEW.occurred_at = OLD.occurred_at
+        AND NEW.prev_hash = OLD.prev_hash
+        AND NEW.hash = OLD.hash THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
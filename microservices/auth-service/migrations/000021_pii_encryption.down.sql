@@ -0,0 +1,17 @@
+UPDATE users SET email = 'erased+' || id || '@erased.invalid'
+WHERE email IS NULL AND email_enc IS NULL;
+
+-- Gagal jika sudah ada email yang dienkripsi: data terenkripsi tidak bisa dikembalikan lewat SQL
+ALTER TABLE users ALTER COLUMN email SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_user_keys_master_key;
+ALTER TABLE user_keys DROP COLUMN IF EXISTS master_key_id;
+
+CREATE INDEX IF NOT EXISTS idx_users_email_prefix ON users (lower(email) text_pattern_ops);
+DROP INDEX IF EXISTS idx_users_plaintext_pii;
+DROP INDEX IF EXISTS idx_users_email_hash;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS date_of_birth_enc,
+    DROP COLUMN IF EXISTS phone_enc,
+    DROP COLUMN IF EXISTS email_enc,
+    DROP COLUMN IF EXISTS email_hash;
@@ -0,0 +1,25 @@
+-- PII user dienkripsi per field dengan data key milik user (user_keys). Email dicari lewat
+-- blind index HMAC-SHA256 sehingga hanya pencarian exact match yang didukung.
+-- Kolom plaintext lama dikosongkan oleh job enkripsi di auth-service secara bertahap.
+ALTER TABLE users
+    ADD COLUMN email_hash CHAR(64),
+    ADD COLUMN email_enc BYTEA,
+    ADD COLUMN phone_enc BYTEA,
+    ADD COLUMN date_of_birth_enc BYTEA,
+    ALTER COLUMN email DROP NOT NULL;
+
+-- Akun yang sudah dihapus permanen tidak lagi menyimpan email pengganti
+UPDATE users SET email = NULL
+WHERE status = 'deleted' AND status_reason = 'erased' AND email LIKE 'erased+%@erased.invalid';
+
+CREATE UNIQUE INDEX idx_users_email_hash ON users(email_hash);
+-- Baris yang masih menyimpan email plaintext (belum dienkripsi)
+CREATE INDEX idx_users_plaintext_pii ON users(id) WHERE email IS NOT NULL;
+-- Pencarian prefix email tidak didukung lagi
+DROP INDEX IF EXISTS idx_users_email_prefix;
+
+-- ID master key KMS yang membungkus data_key; '' berarti data_key tersimpan tanpa dibungkus
+-- (dibuat sebelum migrasi ini) dan akan dibungkus oleh job re-wrap
+ALTER TABLE user_keys ADD COLUMN master_key_id VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_user_keys_master_key ON user_keys(master_key_id);
@@ -0,0 +1,21 @@
+-- Role audit_redactor berlaku untuk seluruh cluster dan tidak dihapus di sini
+REVOKE SELECT, UPDATE ON audit_events FROM audit_redactor;
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND current_setting('audit.allow_redaction', true) = 'on'
+        AND NEW.redacted
+        AND NEW.seq = OLD.seq
+        AND NEW.action = OLD.action
+        AND NEW.occurred_at = OLD.occurred_at
+        AND NEW.prev_hash = OLD.prev_hash
+        AND NEW.hash = OLD.hash THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE audit_events DROP COLUMN IF EXISTS nonces;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS commitments;
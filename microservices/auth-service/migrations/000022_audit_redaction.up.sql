@@ -0,0 +1,39 @@
+-- Field audit yang bisa dipseudonimkan di-hash lewat commitment per field (AuditEvent.Commit),
+-- sehingga hash tetap bisa dihitung ulang setelah pseudonimisasi. NULL untuk event lama
+-- yang hash-nya dihitung langsung dari nilai field; event tersebut tidak bisa dipseudonimkan.
+ALTER TABLE audit_events ADD COLUMN commitments JSONB;
+ALTER TABLE audit_events ADD COLUMN nonces JSONB;
+
+-- Pseudonimisasi hanya untuk login anggota audit_redactor (worker penghapusan akun lewat
+-- AUTH_ERASURE_DB_URL). Login aplikasi tidak boleh menjadi anggotanya, superuser, maupun
+-- pemilik tabel.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'audit_redactor') THEN
+        CREATE ROLE audit_redactor NOLOGIN;
+    END IF;
+END
+$$;
+
+GRANT SELECT, UPDATE ON audit_events TO audit_redactor;
+
+-- UPDATE hanya untuk pseudonimisasi: session_user (tidak berubah oleh SET ROLE) anggota
+-- audit_redactor, hash dan commitment tetap, nonce hanya boleh dihapus
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND pg_has_role(session_user, 'audit_redactor', 'MEMBER')
+        AND NEW.redacted
+        AND OLD.commitments IS NOT NULL
+        AND NEW.seq = OLD.seq
+        AND NEW.action = OLD.action
+        AND NEW.occurred_at = OLD.occurred_at
+        AND NEW.prev_hash = OLD.prev_hash
+        AND NEW.hash = OLD.hash
+        AND NEW.commitments = OLD.commitments
+        AND OLD.nonces @> NEW.nonces THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_plaintext_pii;
+CREATE INDEX idx_users_plaintext_pii ON users(id) WHERE email IS NOT NULL;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS pii_encryption_error,
+    DROP COLUMN IF EXISTS pii_encryption_attempts;
@@ -0,0 +1,8 @@
+-- Kegagalan enkripsi PII dicatat per baris agar satu baris bermasalah tidak menghentikan job;
+-- baris yang gagal dicoba lagi setelah baris lain dan dilewati setelah batas percobaan
+ALTER TABLE users
+    ADD COLUMN pii_encryption_attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN pii_encryption_error TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS idx_users_plaintext_pii;
+CREATE INDEX idx_users_plaintext_pii ON users(pii_encryption_attempts, id) WHERE email IS NOT NULL;
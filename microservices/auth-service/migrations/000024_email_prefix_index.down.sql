@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_plaintext_email;
+DROP INDEX IF EXISTS idx_users_pending_pii;
+CREATE INDEX idx_users_plaintext_pii ON users(pii_encryption_attempts, id) WHERE email IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_users_email_prefix_index;
+ALTER TABLE users DROP COLUMN IF EXISTS email_prefix_index;
@@ -0,0 +1,14 @@
+-- Blind index email kini dinormalisasi (trim, huruf kecil) dan disertai token prefix untuk
+-- pencarian admin. NULL berarti baris belum diindeks ulang; email_hash-nya masih tanpa
+-- normalisasi sampai diproses job enkripsi PII.
+ALTER TABLE users ADD COLUMN email_prefix_index TEXT[];
+
+CREATE INDEX idx_users_email_prefix_index ON users USING GIN (email_prefix_index);
+
+-- Baris yang masih plaintext atau belum diindeks ulang
+DROP INDEX IF EXISTS idx_users_plaintext_pii;
+CREATE INDEX idx_users_pending_pii ON users(pii_encryption_attempts, id)
+    WHERE email IS NOT NULL OR (email_enc IS NOT NULL AND email_prefix_index IS NULL);
+
+-- Pencarian email plaintext (exact dan prefix) untuk baris yang belum dienkripsi
+CREATE INDEX idx_users_plaintext_email ON users (lower(email) text_pattern_ops) WHERE email IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Event yang sudah terkirim dihapus relay setelah masa retensi
+CREATE INDEX idx_outbox_published ON outbox(published_at) WHERE published_at IS NOT NULL;
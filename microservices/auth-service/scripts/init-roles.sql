@@ -0,0 +1,4 @@
+-- Dijalankan image postgres sekali saat volume data masih kosong (docker-entrypoint-initdb.d).
+-- Login worker penghapusan akun yang boleh mempseudonimkan audit log (migrasi 000022).
+CREATE ROLE audit_redactor NOLOGIN;
+CREATE ROLE auth_erasure LOGIN PASSWORD 'auth_erasure_password' IN ROLE audit_redactor;
@@ -0,0 +1,273 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const defaultAccessTTL = 30 * time.Second
+
+// CheckAccessMethod : CareService.CheckAccess di auth-service
+const CheckAccessMethod = "/auth.v1.CareService/CheckAccess"
+
+// Action yang dikenal CareService.CheckAccess di auth-service
+const (
+	ActionProfileRead   = "profile:read"
+	ActionAccountManage = "account:manage"
+	ActionClinicalRead  = "clinical:read"
+	ActionClinicalWrite = "clinical:write"
+)
+
+// AccessChecker memanggil CareService.CheckAccess di auth-service (butuh service token
+// ber-scope "access:check"): bolehkah subject melakukan action terhadap data milik resourceOwner.
+type AccessChecker interface {
+	CheckAccess(ctx context.Context, subject, resourceOwner, action string) (bool, error)
+}
+
+type AccessCheckerFunc func(ctx context.Context, subject, resourceOwner, action string) (bool, error)
+
+func (f AccessCheckerFunc) CheckAccess(ctx context.Context, subject, resourceOwner, action string) (bool, error) {
+	return f(ctx, subject, resourceOwner, action)
+}
+
+// AccessDecision : hasil CheckAccess beserta lama hasil boleh di-cache menurut auth-service
+// (cache_ttl_seconds); CacheTTL nol berarti hasil tidak boleh di-cache
+type AccessDecision struct {
+	Allowed  bool
+	CacheTTL time.Duration
+}
+
+// AccessDecider diimplementasikan checker yang mengetahui batas cache dari auth-service,
+// e.g. GRPCAccessChecker. CachedAccessChecker memakainya jika tersedia.
+type AccessDecider interface {
+	DecideAccess(ctx context.Context, subject, resourceOwner, action string) (AccessDecision, error)
+}
+
+// GRPCAccessChecker memanggil CareService.CheckAccess lewat koneksi gRPC ke auth-service.
+// Pemanggil wajib membawa service token ber-scope "access:check", sama seperti GRPCIntrospector.
+type GRPCAccessChecker struct {
+	conn grpc.ClientConnInterface
+	opts []grpc.CallOption
+}
+
+func NewGRPCAccessChecker(conn grpc.ClientConnInterface, opts ...grpc.CallOption) *GRPCAccessChecker {
+	return &GRPCAccessChecker{conn: conn, opts: append([]grpc.CallOption{grpc.ForceCodec(accessCodec{})}, opts...)}
+}
+
+func (c *GRPCAccessChecker) CheckAccess(ctx context.Context, subject, resourceOwner, action string) (bool, error) {
+	decision, err := c.DecideAccess(ctx, subject, resourceOwner, action)
+	return decision.Allowed, err
+}
+
+// DecideAccess mengembalikan error gRPC apa adanya (auth-service tidak tersedia, action tidak dikenal)
+func (c *GRPCAccessChecker) DecideAccess(ctx context.Context, subject, resourceOwner, action string) (AccessDecision, error) {
+	resp := &checkAccessResponse{}
+	req := &checkAccessRequest{subject: subject, resourceOwner: resourceOwner, action: action}
+	if err := c.conn.Invoke(ctx, CheckAccessMethod, req, resp, c.opts...); err != nil {
+		return AccessDecision{}, err
+	}
+	return AccessDecision{Allowed: resp.allowed, CacheTTL: time.Duration(resp.cacheTTLSeconds) * time.Second}, nil
+}
+
+type accessEntry struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// CachedAccessChecker menyimpan hasil CheckAccess (izin maupun penolakan) selama ttl, atau
+// selama cache_ttl_seconds dari auth-service jika lebih pendek (checker berupa AccessDecider).
+// Care relationship yang diakhiri baru berlaku di service ini setelah entry-nya kedaluwarsa
+// atau dibuang lewat Invalidate (e.g. saat menerima event care.ended).
+type CachedAccessChecker struct {
+	checker AccessChecker
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu          sync.Mutex
+	cache       map[string]accessEntry
+	lastCleanup time.Time
+}
+
+func NewCachedAccessChecker(checker AccessChecker, ttl time.Duration) *CachedAccessChecker {
+	if ttl <= 0 {
+		ttl = defaultAccessTTL
+	}
+	return &CachedAccessChecker{
+		checker:     checker,
+		ttl:         ttl,
+		now:         time.Now,
+		cache:       make(map[string]accessEntry),
+		lastCleanup: time.Now(),
+	}
+}
+
+func (c *CachedAccessChecker) CheckAccess(ctx context.Context, subject, resourceOwner, action string) (bool, error) {
+	// Pemilik data selalu boleh, tidak perlu bertanya ke auth-service
+	if subject != "" && subject == resourceOwner {
+		return true, nil
+	}
+	key := accessKey(subject, resourceOwner, action)
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.allowed, nil
+	}
+
+	decision, err := c.decide(ctx, subject, resourceOwner, action)
+	if err != nil {
+		// Error jaringan tidak di-cache
+		return false, err
+	}
+	if decision.CacheTTL <= 0 {
+		return decision.Allowed, nil
+	}
+	c.store(key, accessEntry{allowed: decision.Allowed, expiresAt: now.Add(decision.CacheTTL)})
+	return decision.Allowed, nil
+}
+
+func (c *CachedAccessChecker) store(key string, entry accessEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.Sub(c.lastCleanup) > cachePruneInterval {
+		for k, e := range c.cache {
+			if now.After(e.expiresAt) {
+				delete(c.cache, k)
+			}
+		}
+		c.lastCleanup = now
+	}
+	c.cache[key] = entry
+}
+
+// decide : checker biasa memakai ttl CachedAccessChecker, AccessDecider dibatasi ttl itu juga
+func (c *CachedAccessChecker) decide(ctx context.Context, subject, resourceOwner, action string) (AccessDecision, error) {
+	decider, ok := c.checker.(AccessDecider)
+	if !ok {
+		allowed, err := c.checker.CheckAccess(ctx, subject, resourceOwner, action)
+		return AccessDecision{Allowed: allowed, CacheTTL: c.ttl}, err
+	}
+	decision, err := decider.DecideAccess(ctx, subject, resourceOwner, action)
+	if decision.CacheTTL > c.ttl {
+		decision.CacheTTL = c.ttl
+	}
+	return decision, err
+}
+
+// Invalidate membuang semua hasil cache untuk pasangan subject - resourceOwner
+func (c *CachedAccessChecker) Invalidate(subject, resourceOwner string) {
+	prefix := accessKey(subject, resourceOwner, "")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.cache {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.cache, k)
+		}
+	}
+}
+
+// RequireAccess memeriksa akses user di context ke data milik resourceOwner, untuk dipakai
+// langsung di handler setelah resource owner diketahui:
+//
+//	if err := authn.RequireAccess(ctx, checker, req.ClientId, authn.ActionClinicalRead); err != nil {
+//		return nil, err
+//	}
+func RequireAccess(ctx context.Context, checker AccessChecker, resourceOwner, action string) error {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	allowed, err := checker.CheckAccess(ctx, claims.UserID, resourceOwner, action)
+	if err != nil {
+		return status.Error(codes.Unavailable, "access check unavailable")
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "no %s access to this user's data", action)
+	}
+	return nil
+}
+
+func accessKey(subject, resourceOwner, action string) string {
+	return subject + "\x00" + resourceOwner + "\x00" + action
+}
+
+// checkAccessRequest : CheckAccessRequest
+type checkAccessRequest struct {
+	subject       string // 1
+	resourceOwner string // 2
+	action        string // 3
+}
+
+// checkAccessResponse : CheckAccessResponse
+type checkAccessResponse struct {
+	allowed         bool   // 1
+	grant           string // 2
+	relationshipID  string // 3
+	cacheTTLSeconds int64  // 4
+}
+
+// accessCodec meng-encode checkAccessRequest/checkAccessResponse, lihat introspectCodec
+type accessCodec struct{}
+
+func (accessCodec) Name() string { return "proto" }
+
+func (accessCodec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case *checkAccessRequest:
+		var b []byte
+		b = appendString(b, 1, m.subject)
+		b = appendString(b, 2, m.resourceOwner)
+		b = appendString(b, 3, m.action)
+		return b, nil
+	case *checkAccessResponse:
+		var b []byte
+		b = appendBool(b, 1, m.allowed)
+		b = appendString(b, 2, m.grant)
+		b = appendString(b, 3, m.relationshipID)
+		b = appendInt64(b, 4, m.cacheTTLSeconds)
+		return b, nil
+	}
+	return nil, fmt.Errorf("access codec: unsupported type %T", v)
+}
+
+func (accessCodec) Unmarshal(data []byte, v interface{}) error {
+	switch m := v.(type) {
+	case *checkAccessRequest:
+		return consumeFields(data, func(num protowire.Number, s string, n uint64) {
+			switch num {
+			case 1:
+				m.subject = s
+			case 2:
+				m.resourceOwner = s
+			case 3:
+				m.action = s
+			}
+		})
+	case *checkAccessResponse:
+		return consumeFields(data, func(num protowire.Number, s string, n uint64) {
+			switch num {
+			case 1:
+				m.allowed = n != 0
+			case 2:
+				m.grant = s
+			case 3:
+				m.relationshipID = s
+			case 4:
+				m.cacheTTLSeconds = int64(n)
+			}
+		})
+	}
+	return fmt.Errorf("access codec: unsupported type %T", v)
+}
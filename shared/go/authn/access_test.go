@@ -0,0 +1,93 @@
+package authn_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"shared/go/authn"
+	"shared/go/authn/authntest"
+)
+
+func TestCachedAccessChecker_CachesDecisions(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+	issuer.Grant("psy-1", "client-1", authn.ActionClinicalRead)
+	checker := authn.NewCachedAccessChecker(issuer, time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		allowed, err := checker.CheckAccess(ctx, "psy-1", "client-1", authn.ActionClinicalRead)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = checker.CheckAccess(ctx, "psy-1", "client-1", authn.ActionAccountManage)
+		require.NoError(t, err)
+		assert.False(t, allowed)
+	}
+	assert.Equal(t, 2, issuer.AccessCalls())
+
+	// Pemilik data tidak perlu ditanyakan
+	allowed, err := checker.CheckAccess(ctx, "client-1", "client-1", authn.ActionClinicalWrite)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, 2, issuer.AccessCalls())
+}
+
+func TestCachedAccessChecker_Invalidate(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+	issuer.Grant("psy-1", "client-1", authn.ActionClinicalRead)
+	checker := authn.NewCachedAccessChecker(issuer, time.Minute)
+	ctx := context.Background()
+
+	allowed, _ := checker.CheckAccess(ctx, "psy-1", "client-1", authn.ActionClinicalRead)
+	assert.True(t, allowed)
+
+	// Hubungan berakhir: hasil lama masih di cache sampai di-invalidate
+	issuer.RevokeGrants("psy-1", "client-1")
+	allowed, _ = checker.CheckAccess(ctx, "psy-1", "client-1", authn.ActionClinicalRead)
+	assert.True(t, allowed)
+
+	checker.Invalidate("psy-1", "client-1")
+	allowed, _ = checker.CheckAccess(ctx, "psy-1", "client-1", authn.ActionClinicalRead)
+	assert.False(t, allowed)
+}
+
+func TestCachedAccessChecker_ErrorsAreNotCached(t *testing.T) {
+	calls := 0
+	checker := authn.NewCachedAccessChecker(authn.AccessCheckerFunc(func(ctx context.Context, subject, resourceOwner, action string) (bool, error) {
+		calls++
+		if calls == 1 {
+			return false, errors.New("connection refused")
+		}
+		return true, nil
+	}), time.Minute)
+
+	_, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", authn.ActionProfileRead)
+	assert.Error(t, err)
+	allowed, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", authn.ActionProfileRead)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func TestRequireAccess(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+	issuer.Grant("psy-1", "client-1", authn.ActionClinicalRead)
+	ctx := authn.NewContext(context.Background(), &authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole})
+
+	assert.NoError(t, authn.RequireAccess(ctx, issuer, "client-1", authn.ActionClinicalRead))
+
+	err := authn.RequireAccess(ctx, issuer, "client-2", authn.ActionClinicalRead)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = authn.RequireAccess(context.Background(), issuer, "client-1", authn.ActionClinicalRead)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,216 @@
+// Package authntest menyediakan issuer palsu lokal untuk menguji service yang memakai authn.
+package authntest
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"shared/go/authn"
+)
+
+const issuerName = "authntest"
+
+// Issuer menerbitkan token dengan format auth-service (HS256, secret acak) dan memvalidasinya
+// lewat Introspect, seperti AuthService.IntrospectToken. Issuer juga mengimplementasikan
+// authn.AccessChecker.
+type Issuer struct {
+	secret []byte
+
+	mu          sync.Mutex
+	revoked     map[string]bool
+	calls       int
+	grants      map[string]bool
+	accessCalls int
+}
+
+func NewIssuer() *Issuer {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return &Issuer{secret: secret, revoked: make(map[string]bool), grants: make(map[string]bool)}
+}
+
+// Close dipertahankan agar pemakaian "defer issuer.Close()" tetap valid
+func (i *Issuer) Close() {}
+
+// Verifier memvalidasi token lewat Introspect dengan cache, seperti yang dipakai service lain
+func (i *Issuer) Verifier() authn.Verifier {
+	return authn.NewIntrospectionVerifier(i, 0)
+}
+
+// Issue menerbitkan access token untuk claims, TokenID, IssuedAt & ExpiresAt diisi otomatis jika kosong
+func (i *Issuer) Issue(claims authn.Claims) string {
+	if claims.TokenID == "" {
+		claims.TokenID = uuid.NewString()
+	}
+	if claims.IssuedAt.IsZero() {
+		claims.IssuedAt = time.Now()
+	}
+	if claims.ExpiresAt.IsZero() {
+		claims.ExpiresAt = time.Now().Add(15 * time.Minute)
+	}
+
+	mapClaims := jwt.MapClaims{
+		"iss":    issuerName,
+		"uid":    claims.UserID,
+		"role":   string(claims.Role),
+		"roles":  claims.Roles,
+		"perms":  claims.Permissions,
+		"scopes": claims.Scopes,
+		"jti":    claims.TokenID,
+		"iat":    claims.IssuedAt.Unix(),
+		"exp":    claims.ExpiresAt.Unix(),
+	}
+	if !claims.AuthTime.IsZero() {
+		mapClaims["auth_time"] = claims.AuthTime.Unix()
+		mapClaims["acr"] = claims.ACR
+		mapClaims["amr"] = claims.AMR
+	}
+	if len(claims.GuardianOf) > 0 {
+		mapClaims["guardian_of"] = claims.GuardianOf
+	}
+	if claims.Minor {
+		mapClaims["minor"] = true
+	}
+	if claims.OrgID != "" {
+		mapClaims["org_id"] = claims.OrgID
+		mapClaims["org_role"] = claims.OrgRole
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, mapClaims).SignedString(i.secret)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+// Revoke membuat token tidak lagi aktif menurut Introspect
+func (i *Issuer) Revoke(tokenID string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.revoked[tokenID] = true
+}
+
+// IntrospectCalls menghitung berapa kali Introspect dipanggil (untuk menguji cache)
+func (i *Issuer) IntrospectCalls() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.calls
+}
+
+func (i *Issuer) Introspect(ctx context.Context, token string) (*authn.Claims, error) {
+	i.mu.Lock()
+	i.calls++
+	i.mu.Unlock()
+
+	claims, err := i.parse(token)
+	if err != nil {
+		return nil, authn.ErrInvalidToken
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.revoked[claims.TokenID] {
+		return nil, authn.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// Grant mengizinkan subject melakukan actions terhadap data resourceOwner menurut CheckAccess
+func (i *Issuer) Grant(subject, resourceOwner string, actions ...string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, a := range actions {
+		i.grants[subject+"|"+resourceOwner+"|"+a] = true
+	}
+}
+
+// RevokeGrants mencabut semua izin subject terhadap data resourceOwner
+func (i *Issuer) RevokeGrants(subject, resourceOwner string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	prefix := subject + "|" + resourceOwner + "|"
+	for k := range i.grants {
+		if strings.HasPrefix(k, prefix) {
+			delete(i.grants, k)
+		}
+	}
+}
+
+// AccessCalls menghitung berapa kali CheckAccess dipanggil (untuk menguji cache)
+func (i *Issuer) AccessCalls() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.accessCalls
+}
+
+func (i *Issuer) CheckAccess(ctx context.Context, subject, resourceOwner, action string) (bool, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.accessCalls++
+	return subject == resourceOwner || i.grants[subject+"|"+resourceOwner+"|"+action], nil
+}
+
+// tokenClaims mengikuti format CustomClaims di auth-service
+type tokenClaims struct {
+	UserID      string           `json:"uid"`
+	Role        string           `json:"role"`
+	Roles       []string         `json:"roles,omitempty"`
+	Permissions []string         `json:"perms,omitempty"`
+	Scopes      []string         `json:"scopes,omitempty"`
+	AuthTime    *jwt.NumericDate `json:"auth_time,omitempty"`
+	ACR         string           `json:"acr,omitempty"`
+	AMR         []string         `json:"amr,omitempty"`
+	GuardianOf  []string         `json:"guardian_of,omitempty"`
+	Minor       bool             `json:"minor,omitempty"`
+	OrgID       string           `json:"org_id,omitempty"`
+	OrgRole     string           `json:"org_role,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func (i *Issuer) parse(token string) (*authn.Claims, error) {
+	tc := &tokenClaims{}
+	_, err := jwt.ParseWithClaims(token, tc, func(t *jwt.Token) (interface{}, error) {
+		return i.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuer(issuerName),
+	)
+	if err != nil || tc.UserID == "" {
+		return nil, authn.ErrInvalidToken
+	}
+
+	claims := &authn.Claims{
+		UserID:      tc.UserID,
+		Role:        authn.Role(tc.Role),
+		Permissions: tc.Permissions,
+		Scopes:      tc.Scopes,
+		TokenID:     tc.ID,
+		ACR:         tc.ACR,
+		AMR:         tc.AMR,
+		GuardianOf:  tc.GuardianOf,
+		Minor:       tc.Minor,
+		OrgID:       tc.OrgID,
+		OrgRole:     tc.OrgRole,
+	}
+	for _, r := range tc.Roles {
+		claims.Roles = append(claims.Roles, authn.Role(r))
+	}
+	if tc.IssuedAt != nil {
+		claims.IssuedAt = tc.IssuedAt.Time
+	}
+	if tc.ExpiresAt != nil {
+		claims.ExpiresAt = tc.ExpiresAt.Time
+	}
+	if tc.AuthTime != nil {
+		claims.AuthTime = tc.AuthTime.Time
+	}
+	return claims, nil
+}
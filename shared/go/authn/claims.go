@@ -0,0 +1,113 @@
+// Package authn menyediakan validasi access token dan gRPC interceptor
+// untuk microservice yang mengonsumsi token dari auth-service.
+package authn
+
+import (
+	"context"
+	"time"
+)
+
+// Role sama dengan entities.Role di auth-service
+type Role string
+
+const (
+	ClientRole        Role = "client"
+	PsychologistRole  Role = "psychologist"
+	ServiceRole       Role = "service"
+	AdminRole         Role = "admin"
+	ClinicManagerRole Role = "clinic_manager"
+	SupportRole       Role = "support"
+
+	// Psikolog yang lisensinya belum/tidak lagi terverifikasi
+	PendingPsychologistRole Role = "psychologist_pending"
+)
+
+// Assurance level (claim acr) yang diterbitkan auth-service, mengikuti NIST SP 800-63B
+const (
+	ACRSingleFactor = "aal1"
+	ACRMultiFactor  = "aal2"
+)
+
+// Claims adalah hasil validasi access token yang di-inject ke context
+type Claims struct {
+	UserID      string
+	Role        Role   // role utama
+	Roles       []Role // seluruh role user
+	Permissions []string
+	Scopes      []string
+	TokenID     string
+	IssuedAt    time.Time
+	ExpiresAt   time.Time
+
+	// AuthTime adalah waktu user terakhir kali benar-benar autentikasi (login atau Reauthenticate),
+	// tidak berubah saat token di-refresh. Zero jika token tidak membawa auth_time.
+	AuthTime time.Time
+	ACR      string   // assurance level, lihat ACRSingleFactor / ACRMultiFactor
+	AMR      []string // metode autentikasi (RFC 8176), e.g. "pwd", "otp", "mfa"
+
+	// GuardianOf berisi ID akun klien di bawah umur yang dikelola user ini.
+	// Minor true jika pemilik token sendiri di bawah umur.
+	GuardianOf []string
+	Minor      bool
+
+	// OrgID adalah organisasi (tenant) aktif, kosong untuk konteks pribadi. Data milik
+	// organisasi wajib difilter dengan OrgID ini. OrgRole : "owner", "manager", "psychologist", "staff".
+	OrgID   string
+	OrgRole string
+}
+
+// HasRole true jika user memiliki salah satu role (role utama atau tambahan)
+func (c *Claims) HasRole(roles ...Role) bool {
+	for _, r := range roles {
+		if c.Role == r {
+			return true
+		}
+		for _, own := range c.Roles {
+			if own == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsGuardianOf true jika user adalah wali aktif userID. Hanya memberi hak mengelola akun
+// (profil, status, sesi); jangan dipakai untuk membuka catatan sesi atau konten klinis anak.
+func (c *Claims) IsGuardianOf(userID string) bool {
+	for _, id := range c.GuardianOf {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Claims) HasPermission(permission string) bool {
+	for _, p := range c.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type claimsKey struct{}
+
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext mengambil claims yang di-inject oleh interceptor
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
@@ -0,0 +1,103 @@
+package authn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeCareService meniru CareService.CheckAccess: psy-1 boleh membaca data klinis client-1,
+// hasilnya boleh di-cache selama cacheTTL detik
+func fakeCareService(t *testing.T, cacheTTL int64, calls *int) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ForceServerCodec(accessCodec{}))
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "auth.v1.CareService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "CheckAccess",
+			Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := &checkAccessRequest{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				*calls++
+				allowed := req.subject == "psy-1" && req.resourceOwner == "client-1" && req.action == ActionClinicalRead
+				return &checkAccessResponse{allowed: allowed, cacheTTLSeconds: cacheTTL}, nil
+			},
+		}},
+	}, struct{}{})
+	return serveBufconn(t, srv, lis)
+}
+
+func TestGRPCAccessChecker_Decision(t *testing.T) {
+	var calls int
+	checker := NewGRPCAccessChecker(fakeCareService(t, 30, &calls))
+
+	decision, err := checker.DecideAccess(context.Background(), "psy-1", "client-1", ActionClinicalRead)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 30*time.Second, decision.CacheTTL)
+
+	allowed, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", ActionAccountManage)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestCachedAccessChecker_HonorsServerTTL(t *testing.T) {
+	var calls int
+	checker := NewCachedAccessChecker(NewGRPCAccessChecker(fakeCareService(t, 5, &calls)), time.Minute)
+	now := time.Now()
+	checker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		allowed, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", ActionClinicalRead)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	assert.Equal(t, 1, calls)
+
+	// Lewat cache_ttl_seconds auth-service walau ttl lokal masih lebih panjang
+	now = now.Add(6 * time.Second)
+	_, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", ActionClinicalRead)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedAccessChecker_ZeroServerTTLNotCached(t *testing.T) {
+	var calls int
+	checker := NewCachedAccessChecker(NewGRPCAccessChecker(fakeCareService(t, 0, &calls)), time.Minute)
+
+	for i := 0; i < 2; i++ {
+		_, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", ActionClinicalRead)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedAccessChecker_PrunesExpiredEntriesPerInterval(t *testing.T) {
+	checker := NewCachedAccessChecker(AccessCheckerFunc(func(context.Context, string, string, string) (bool, error) {
+		return true, nil
+	}), 5*time.Second)
+	now := time.Now()
+	checker.now = func() time.Time { return now }
+
+	_, err := checker.CheckAccess(context.Background(), "psy-1", "client-1", ActionClinicalRead)
+	require.NoError(t, err)
+
+	// Entry lama sudah kedaluwarsa tetapi interval pembersihan belum lewat
+	now = now.Add(10 * time.Second)
+	_, err = checker.CheckAccess(context.Background(), "psy-2", "client-1", ActionClinicalRead)
+	require.NoError(t, err)
+	assert.Len(t, checker.cache, 2)
+
+	now = now.Add(cachePruneInterval)
+	_, err = checker.CheckAccess(context.Background(), "psy-3", "client-1", ActionClinicalRead)
+	require.NoError(t, err)
+	assert.Len(t, checker.cache, 1)
+}
@@ -0,0 +1,243 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// IntrospectTokenMethod : AuthService.IntrospectToken di auth-service
+const IntrospectTokenMethod = "/auth.v1.AuthService/IntrospectToken"
+
+// GRPCIntrospector memanggil AuthService.IntrospectToken lewat koneksi gRPC ke auth-service.
+// Pemanggil wajib membawa service token ber-scope "tokens:introspect", e.g. dengan
+// grpc.WithPerRPCCredentials saat dial atau grpc.PerRPCCredentials di opts.
+//
+// Pesan di-encode langsung (protowire) mengikuti auth_service.proto agar shared/go tidak
+// bergantung pada kode generate auth-service; nomor field di sini harus ikut jika proto berubah.
+type GRPCIntrospector struct {
+	conn grpc.ClientConnInterface
+	opts []grpc.CallOption
+}
+
+func NewGRPCIntrospector(conn grpc.ClientConnInterface, opts ...grpc.CallOption) *GRPCIntrospector {
+	return &GRPCIntrospector{conn: conn, opts: append([]grpc.CallOption{grpc.ForceCodec(introspectCodec{})}, opts...)}
+}
+
+// Introspect mengembalikan ErrInvalidToken jika token tidak aktif; error gRPC (auth-service
+// tidak tersedia, credential service ditolak) dikembalikan apa adanya
+func (i *GRPCIntrospector) Introspect(ctx context.Context, token string) (*Claims, error) {
+	resp := &introspectResponse{}
+	if err := i.conn.Invoke(ctx, IntrospectTokenMethod, &introspectRequest{token: token}, resp, i.opts...); err != nil {
+		return nil, err
+	}
+	if !resp.active || resp.userID == "" {
+		return nil, ErrInvalidToken
+	}
+	return resp.toClaims(), nil
+}
+
+// introspectRequest : IntrospectTokenRequest
+type introspectRequest struct {
+	token string // 1
+}
+
+// introspectResponse : IntrospectTokenResponse
+type introspectResponse struct {
+	active      bool     // 1
+	userID      string   // 2
+	role        string   // 3
+	scopes      []string // 4
+	tokenID     string   // 5
+	expiresAt   int64    // 6, unix seconds
+	roles       []string // 7
+	permissions []string // 8
+	authTime    int64    // 9, unix seconds
+	acr         string   // 10
+	amr         []string // 11
+	guardianOf  []string // 12
+	minor       bool     // 13
+	orgID       string   // 14
+	orgRole     string   // 15
+	issuedAt    int64    // 16, unix seconds
+}
+
+func (r *introspectResponse) toClaims() *Claims {
+	claims := &Claims{
+		UserID:      r.userID,
+		Role:        Role(r.role),
+		Permissions: r.permissions,
+		Scopes:      r.scopes,
+		TokenID:     r.tokenID,
+		ACR:         r.acr,
+		AMR:         r.amr,
+		GuardianOf:  r.guardianOf,
+		Minor:       r.minor,
+		OrgID:       r.orgID,
+		OrgRole:     r.orgRole,
+	}
+	for _, role := range r.roles {
+		claims.Roles = append(claims.Roles, Role(role))
+	}
+	if r.expiresAt > 0 {
+		claims.ExpiresAt = time.Unix(r.expiresAt, 0)
+	}
+	if r.authTime > 0 {
+		claims.AuthTime = time.Unix(r.authTime, 0)
+	}
+	if r.issuedAt > 0 {
+		claims.IssuedAt = time.Unix(r.issuedAt, 0)
+	}
+	return claims
+}
+
+// introspectCodec meng-encode introspectRequest/introspectResponse dalam format wire protobuf
+type introspectCodec struct{}
+
+func (introspectCodec) Name() string { return "proto" }
+
+func (introspectCodec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case *introspectRequest:
+		return appendString(nil, 1, m.token), nil
+	case *introspectResponse:
+		var b []byte
+		b = appendBool(b, 1, m.active)
+		b = appendString(b, 2, m.userID)
+		b = appendString(b, 3, m.role)
+		b = appendStrings(b, 4, m.scopes)
+		b = appendString(b, 5, m.tokenID)
+		b = appendInt64(b, 6, m.expiresAt)
+		b = appendStrings(b, 7, m.roles)
+		b = appendStrings(b, 8, m.permissions)
+		b = appendInt64(b, 9, m.authTime)
+		b = appendString(b, 10, m.acr)
+		b = appendStrings(b, 11, m.amr)
+		b = appendStrings(b, 12, m.guardianOf)
+		b = appendBool(b, 13, m.minor)
+		b = appendString(b, 14, m.orgID)
+		b = appendString(b, 15, m.orgRole)
+		b = appendInt64(b, 16, m.issuedAt)
+		return b, nil
+	}
+	return nil, fmt.Errorf("introspect codec: unsupported type %T", v)
+}
+
+func (introspectCodec) Unmarshal(data []byte, v interface{}) error {
+	switch m := v.(type) {
+	case *introspectRequest:
+		return consumeFields(data, func(num protowire.Number, s string, n uint64) {
+			if num == 1 {
+				m.token = s
+			}
+		})
+	case *introspectResponse:
+		return consumeFields(data, func(num protowire.Number, s string, n uint64) {
+			switch num {
+			case 1:
+				m.active = n != 0
+			case 2:
+				m.userID = s
+			case 3:
+				m.role = s
+			case 4:
+				m.scopes = append(m.scopes, s)
+			case 5:
+				m.tokenID = s
+			case 6:
+				m.expiresAt = int64(n)
+			case 7:
+				m.roles = append(m.roles, s)
+			case 8:
+				m.permissions = append(m.permissions, s)
+			case 9:
+				m.authTime = int64(n)
+			case 10:
+				m.acr = s
+			case 11:
+				m.amr = append(m.amr, s)
+			case 12:
+				m.guardianOf = append(m.guardianOf, s)
+			case 13:
+				m.minor = n != 0
+			case 14:
+				m.orgID = s
+			case 15:
+				m.orgRole = s
+			case 16:
+				m.issuedAt = int64(n)
+			}
+		})
+	}
+	return fmt.Errorf("introspect codec: unsupported type %T", v)
+}
+
+// consumeFields memanggil fn untuk setiap field string (bytes) dan varint; tipe lain dilewati
+func consumeFields(data []byte, fn func(num protowire.Number, s string, n uint64)) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		switch typ {
+		case protowire.BytesType:
+			v, n := protowire.ConsumeString(data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			fn(num, v, 0)
+			data = data[n:]
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			fn(num, "", v)
+			data = data[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			data = data[n:]
+		}
+	}
+	return nil
+}
+
+// Nilai default tidak ditulis, sama seperti proto3
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+func appendStrings(b []byte, num protowire.Number, vs []string) []byte {
+	for _, v := range vs {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+	return b
+}
+
+func appendInt64(b []byte, num protowire.Number, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+func appendBool(b []byte, num protowire.Number, v bool) []byte {
+	if !v {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, 1)
+}
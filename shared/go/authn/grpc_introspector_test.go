@@ -0,0 +1,156 @@
+package authn
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeAuthService meniru AuthService.IntrospectToken: hanya token "good" dan "legacy" (tanpa iat)
+// yang aktif dan pemanggil wajib membawa service token
+func fakeAuthService(t *testing.T) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ForceServerCodec(introspectCodec{}))
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "auth.v1.AuthService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "IntrospectToken",
+			Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				md, _ := metadata.FromIncomingContext(ctx)
+				if auth := md.Get("authorization"); len(auth) == 0 || auth[0] != "Bearer service-token" {
+					return nil, status.Error(codes.Unauthenticated, "missing credentials")
+				}
+				req := &introspectRequest{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				if req.token != "good" && req.token != "legacy" {
+					return &introspectResponse{active: false}, nil
+				}
+				var issuedAt int64
+				if req.token == "good" {
+					issuedAt = 1700000000
+				}
+				return &introspectResponse{
+					active:    true,
+					userID:    "psy-1",
+					role:      string(PsychologistRole),
+					roles:     []string{string(PsychologistRole), string(AdminRole)},
+					scopes:    []string{"notes:read"},
+					tokenID:   "jti-1",
+					expiresAt: time.Now().Add(time.Hour).Unix(),
+					issuedAt:  issuedAt,
+					authTime:  1700000000,
+					amr:       []string{"pwd", "otp"},
+					orgID:     "org-1",
+					orgRole:   "owner",
+				}, nil
+			},
+		}},
+	}, struct{}{})
+	return serveBufconn(t, srv, lis)
+}
+
+// serveBufconn menjalankan srv di lis dan mengembalikan koneksi client ke srv
+func serveBufconn(t *testing.T, srv *grpc.Server, lis *bufconn.Listener) *grpc.ClientConn {
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func withServiceToken() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer service-token")
+}
+
+func TestGRPCIntrospector_ActiveToken(t *testing.T) {
+	introspector := NewGRPCIntrospector(fakeAuthService(t))
+
+	claims, err := introspector.Introspect(withServiceToken(), "good")
+
+	require.NoError(t, err)
+	assert.Equal(t, "psy-1", claims.UserID)
+	assert.Equal(t, PsychologistRole, claims.Role)
+	assert.True(t, claims.HasRole(AdminRole))
+	assert.True(t, claims.HasScope("notes:read"))
+	assert.Equal(t, "jti-1", claims.TokenID)
+	assert.Equal(t, time.Unix(1700000000, 0), claims.IssuedAt)
+	assert.Equal(t, time.Unix(1700000000, 0), claims.AuthTime)
+	assert.Equal(t, []string{"pwd", "otp"}, claims.AMR)
+	assert.Equal(t, "org-1", claims.OrgID)
+	assert.False(t, claims.ExpiresAt.IsZero())
+}
+
+func TestGRPCIntrospector_InactiveToken(t *testing.T) {
+	introspector := NewGRPCIntrospector(fakeAuthService(t))
+
+	_, err := introspector.Introspect(withServiceToken(), "revoked")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestGRPCIntrospector_CallerRejected(t *testing.T) {
+	introspector := NewGRPCIntrospector(fakeAuthService(t))
+
+	// Credential service ditolak bukan berarti token tidak valid: tidak boleh di-cache negatif
+	_, err := introspector.Introspect(context.Background(), "good")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NotErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestGRPCIntrospector_DenylistWatermark(t *testing.T) {
+	introspector := NewGRPCIntrospector(fakeAuthService(t))
+	denylist := NewDenylist(0)
+	v := WithDenylist(VerifierFunc(introspector.Introspect), denylist)
+
+	// Watermark sebelum iat token tidak mencabutnya
+	before := time.Unix(1700000000, 0).Add(-time.Minute)
+	denylist.Apply(&RevocationEvent{Type: RevocationRoleChanged, UserID: "psy-1", IssuedBefore: &before})
+	_, err := v.Verify(withServiceToken(), "good")
+	require.NoError(t, err)
+
+	after := time.Unix(1700000000, 0).Add(time.Minute)
+	denylist.Apply(&RevocationEvent{Type: RevocationUserSuspended, UserID: "psy-1", IssuedBefore: &after})
+	_, err = v.Verify(withServiceToken(), "good")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// iat tidak diketahui bukan berarti terbit sebelum watermark
+	claims, err := v.Verify(withServiceToken(), "legacy")
+	require.NoError(t, err)
+	assert.True(t, claims.IssuedAt.IsZero())
+}
+
+func TestIntrospectionVerifier_PrunesExpiredEntriesPerInterval(t *testing.T) {
+	v := NewIntrospectionVerifier(IntrospectorFunc(func(context.Context, string) (*Claims, error) {
+		return nil, ErrInvalidToken
+	}), 0)
+	now := time.Now()
+	v.now = func() time.Time { return now }
+
+	_, _ = v.Verify(context.Background(), "token-1")
+
+	// Hasil negatif token-1 sudah kedaluwarsa tetapi interval pembersihan belum lewat
+	now = now.Add(2 * negativeIntrospectionTTL)
+	_, _ = v.Verify(context.Background(), "token-2")
+	assert.Len(t, v.cache, 2)
+
+	now = now.Add(cachePruneInterval)
+	_, _ = v.Verify(context.Background(), "token-3")
+	assert.Len(t, v.cache, 1)
+}
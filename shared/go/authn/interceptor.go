@@ -0,0 +1,229 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Requirement adalah syarat otorisasi terhadap claims, mengembalikan status error gRPC jika gagal
+type Requirement func(claims *Claims) error
+
+// RequireRole mengizinkan salah satu dari role yang diberikan
+func RequireRole(roles ...Role) Requirement {
+	return func(claims *Claims) error {
+		if !claims.HasRole(roles...) {
+			return status.Error(codes.PermissionDenied, "role not allowed")
+		}
+		return nil
+	}
+}
+
+// RequireScope mewajibkan semua scope yang diberikan
+func RequireScope(scopes ...string) Requirement {
+	return func(claims *Claims) error {
+		for _, s := range scopes {
+			if !claims.HasScope(s) {
+				return status.Errorf(codes.PermissionDenied, "missing scope %q", s)
+			}
+		}
+		return nil
+	}
+}
+
+// RequirePermission mewajibkan semua permission yang diberikan (claim "perms")
+func RequirePermission(permissions ...string) Requirement {
+	return func(claims *Claims) error {
+		for _, p := range permissions {
+			if !claims.HasPermission(p) {
+				return status.Errorf(codes.PermissionDenied, "missing permission %q", p)
+			}
+		}
+		return nil
+	}
+}
+
+// RequireOrgRole mewajibkan token tenant-scoped (claim org_id) dengan salah satu role organisasi.
+// Tanpa argumen cukup memiliki organisasi aktif.
+func RequireOrgRole(roles ...string) Requirement {
+	return func(claims *Claims) error {
+		if claims.OrgID == "" {
+			return status.Error(codes.PermissionDenied, "an active organization is required")
+		}
+		if len(roles) == 0 {
+			return nil
+		}
+		for _, r := range roles {
+			if claims.OrgRole == r {
+				return nil
+			}
+		}
+		return status.Error(codes.PermissionDenied, "organization role not allowed")
+	}
+}
+
+// InsufficientUserAuthentication adalah kode error step-up (RFC 9470) di pesan status.
+// Client yang menerimanya memanggil AuthService.Reauthenticate lalu mengulang request
+// dengan token baru.
+const InsufficientUserAuthentication = "insufficient_user_authentication"
+
+// RequireMaxAuthAge mewajibkan user autentikasi dalam rentang maxAge terakhir,
+// e.g. WithRequirements("/session.v1.SessionService/GetNotes", RequireMaxAuthAge(10*time.Minute))
+func RequireMaxAuthAge(maxAge time.Duration) Requirement {
+	return func(claims *Claims) error {
+		if claims.AuthTime.IsZero() || time.Since(claims.AuthTime) > maxAge {
+			return status.Errorf(codes.Unauthenticated, "%s: authentication is older than %s", InsufficientUserAuthentication, maxAge)
+		}
+		return nil
+	}
+}
+
+// RequireMinACR mewajibkan assurance level minimal, e.g. RequireMinACR(ACRMultiFactor)
+func RequireMinACR(acr string) Requirement {
+	return func(claims *Claims) error {
+		if acrRank(claims.ACR) < acrRank(acr) {
+			return status.Errorf(codes.Unauthenticated, "%s: assurance level %q required", InsufficientUserAuthentication, acr)
+		}
+		return nil
+	}
+}
+
+// acrRank mengurutkan level yang dikenal, level lain dianggap paling rendah
+func acrRank(acr string) int {
+	switch acr {
+	case ACRSingleFactor:
+		return 1
+	case ACRMultiFactor:
+		return 2
+	}
+	return 0
+}
+
+// Require memeriksa requirement terhadap claims di context, untuk dipakai langsung di handler
+func Require(ctx context.Context, reqs ...Requirement) error {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	for _, req := range reqs {
+		if err := req(claims); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type options struct {
+	public       map[string]bool
+	requirements map[string][]Requirement
+}
+
+type Option func(*options)
+
+// WithPublicMethods menandai full method yang boleh dipanggil tanpa token
+func WithPublicMethods(methods ...string) Option {
+	return func(o *options) {
+		for _, m := range methods {
+			o.public[m] = true
+		}
+	}
+}
+
+// WithRequirements menambahkan requirement untuk full method tertentu,
+// e.g. WithRequirements("/booking.v1.BookingService/ListSessions", RequireRole(PsychologistRole))
+func WithRequirements(method string, reqs ...Requirement) Option {
+	return func(o *options) {
+		o.requirements[method] = append(o.requirements[method], reqs...)
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		public:       make(map[string]bool),
+		requirements: make(map[string][]Requirement),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// UnaryServerInterceptor memvalidasi bearer token dan meng-inject Claims ke context
+func UnaryServerInterceptor(v Verifier, opts ...Option) grpc.UnaryServerInterceptor {
+	o := newOptions(opts)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, v, o, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(v Verifier, opts ...Option) grpc.StreamServerInterceptor {
+	o := newOptions(opts)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), v, o, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, v Verifier, o *options, fullMethod string) (context.Context, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		if o.public[fullMethod] {
+			return ctx, nil
+		}
+		return ctx, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	claims, err := v.Verify(ctx, token)
+	if err != nil {
+		if o.public[fullMethod] {
+			return ctx, nil
+		}
+		if errors.Is(err, ErrInvalidToken) {
+			return ctx, status.Error(codes.Unauthenticated, "invalid access token")
+		}
+		return ctx, status.Error(codes.Unavailable, "token verification unavailable")
+	}
+
+	for _, req := range o.requirements[fullMethod] {
+		if err := req(claims); err != nil {
+			return ctx, err
+		}
+	}
+
+	return NewContext(ctx, claims), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+			return strings.TrimSpace(v[7:])
+		}
+	}
+	return ""
+}
+
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
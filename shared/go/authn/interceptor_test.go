@@ -0,0 +1,168 @@
+package authn_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"shared/go/authn"
+	"shared/go/authn/authntest"
+)
+
+const (
+	notesMethod  = "/session.v1.SessionService/GetNotes"
+	publicMethod = "/session.v1.SessionService/Ping"
+)
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func invoke(ctx context.Context, method string, interceptor grpc.UnaryServerInterceptor) (*authn.Claims, error) {
+	var got *authn.Claims
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = authn.FromContext(ctx)
+		return nil, nil
+	})
+	return got, err
+}
+
+func TestUnaryServerInterceptor_InjectsClaims(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	interceptor := authn.UnaryServerInterceptor(issuer.Verifier(),
+		authn.WithRequirements(notesMethod, authn.RequireRole(authn.PsychologistRole)),
+	)
+	token := issuer.Issue(authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole})
+
+	claims, err := invoke(withToken(token), notesMethod, interceptor)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "psy-1", claims.UserID)
+}
+
+func TestUnaryServerInterceptor_RequireRoleDenied(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	interceptor := authn.UnaryServerInterceptor(issuer.Verifier(),
+		authn.WithRequirements(notesMethod, authn.RequireRole(authn.PsychologistRole)),
+	)
+	token := issuer.Issue(authn.Claims{UserID: "client-1", Role: authn.ClientRole})
+
+	_, err := invoke(withToken(token), notesMethod, interceptor)
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUnaryServerInterceptor_MissingToken(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	interceptor := authn.UnaryServerInterceptor(issuer.Verifier(),
+		authn.WithPublicMethods(publicMethod),
+	)
+
+	_, err := invoke(context.Background(), notesMethod, interceptor)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	claims, err := invoke(context.Background(), publicMethod, interceptor)
+	assert.NoError(t, err)
+	assert.Nil(t, claims)
+}
+
+func TestRequire_Scope(t *testing.T) {
+	ctx := authn.NewContext(context.Background(), &authn.Claims{UserID: "svc-1", Role: authn.ServiceRole, Scopes: []string{"bookings:read"}})
+
+	assert.NoError(t, authn.Require(ctx, authn.RequireScope("bookings:read")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(authn.Require(ctx, authn.RequireScope("bookings:write"))))
+	assert.Equal(t, codes.Unauthenticated, status.Code(authn.Require(context.Background())))
+}
+
+func TestUnaryServerInterceptor_RequirePermission(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	interceptor := authn.UnaryServerInterceptor(issuer.Verifier(),
+		authn.WithRequirements(notesMethod, authn.RequirePermission("users:read")),
+	)
+
+	support := issuer.Issue(authn.Claims{UserID: "sup-1", Role: authn.SupportRole, Permissions: []string{"users:read"}})
+	_, err := invoke(withToken(support), notesMethod, interceptor)
+	assert.NoError(t, err)
+
+	client := issuer.Issue(authn.Claims{UserID: "client-1", Role: authn.ClientRole})
+	_, err = invoke(withToken(client), notesMethod, interceptor)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestClaims_HasRole_AdditionalRoles(t *testing.T) {
+	claims := &authn.Claims{Role: authn.PsychologistRole, Roles: []authn.Role{authn.PsychologistRole, authn.ClinicManagerRole}}
+
+	assert.True(t, claims.HasRole(authn.ClinicManagerRole))
+	assert.False(t, claims.HasRole(authn.AdminRole))
+}
+
+func TestUnaryServerInterceptor_RequireMaxAuthAge(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	interceptor := authn.UnaryServerInterceptor(issuer.Verifier(),
+		authn.WithRequirements(notesMethod, authn.RequireMaxAuthAge(10*time.Minute)),
+	)
+
+	recent := issuer.Issue(authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole, AuthTime: time.Now().Add(-time.Minute), ACR: authn.ACRSingleFactor, AMR: []string{"pwd"}})
+	claims, err := invoke(withToken(recent), notesMethod, interceptor)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pwd"}, claims.AMR)
+
+	// Token hasil refresh membawa auth_time login awal
+	stale := issuer.Issue(authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole, AuthTime: time.Now().Add(-time.Hour), ACR: authn.ACRSingleFactor})
+	_, err = invoke(withToken(stale), notesMethod, interceptor)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), authn.InsufficientUserAuthentication)
+
+	// Token tanpa auth_time tidak pernah memenuhi syarat
+	legacy := issuer.Issue(authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole})
+	_, err = invoke(withToken(legacy), notesMethod, interceptor)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestRequire_MinACR(t *testing.T) {
+	single := authn.NewContext(context.Background(), &authn.Claims{UserID: "psy-1", ACR: authn.ACRSingleFactor})
+	multi := authn.NewContext(context.Background(), &authn.Claims{UserID: "psy-1", ACR: authn.ACRMultiFactor})
+
+	assert.NoError(t, authn.Require(single, authn.RequireMinACR(authn.ACRSingleFactor)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(authn.Require(single, authn.RequireMinACR(authn.ACRMultiFactor))))
+	assert.NoError(t, authn.Require(multi, authn.RequireMinACR(authn.ACRMultiFactor)))
+}
+
+func TestUnaryServerInterceptor_RequireOrgRole(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	interceptor := authn.UnaryServerInterceptor(issuer.Verifier(),
+		authn.WithRequirements(notesMethod, authn.RequireOrgRole("owner", "psychologist")),
+	)
+
+	tenant := issuer.Issue(authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole, OrgID: "clinic-1", OrgRole: "psychologist"})
+	claims, err := invoke(withToken(tenant), notesMethod, interceptor)
+	assert.NoError(t, err)
+	assert.Equal(t, "clinic-1", claims.OrgID)
+
+	staff := issuer.Issue(authn.Claims{UserID: "staff-1", Role: authn.ClientRole, OrgID: "clinic-1", OrgRole: "staff"})
+	_, err = invoke(withToken(staff), notesMethod, interceptor)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Token konteks pribadi tidak boleh mengakses method tenant
+	personal := issuer.Issue(authn.Claims{UserID: "psy-1", Role: authn.PsychologistRole})
+	_, err = invoke(withToken(personal), notesMethod, interceptor)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,117 @@
+package authn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultIntrospectionTTL  = 30 * time.Second
+	negativeIntrospectionTTL = 5 * time.Second
+
+	// cachePruneInterval : jarak minimal antar pembersihan entry kedaluwarsa di cache
+	cachePruneInterval = time.Minute
+)
+
+// Introspector memanggil endpoint introspeksi auth-service (AuthService.IntrospectToken).
+// Implementasi harus mengembalikan ErrInvalidToken jika token tidak aktif.
+type Introspector interface {
+	Introspect(ctx context.Context, token string) (*Claims, error)
+}
+
+type IntrospectorFunc func(ctx context.Context, token string) (*Claims, error)
+
+func (f IntrospectorFunc) Introspect(ctx context.Context, token string) (*Claims, error) {
+	return f(ctx, token)
+}
+
+type introspectionEntry struct {
+	claims    *Claims
+	expiresAt time.Time
+}
+
+// IntrospectionVerifier memvalidasi token lewat introspeksi dengan cache di memori.
+// Hasil positif di-cache maksimal ttl (dan tidak melewati exp token), hasil negatif
+// di-cache sebentar supaya token palsu tidak membanjiri auth-service.
+type IntrospectionVerifier struct {
+	introspector Introspector
+	ttl          time.Duration
+	now          func() time.Time
+
+	mu          sync.Mutex
+	cache       map[string]introspectionEntry
+	lastCleanup time.Time
+}
+
+func NewIntrospectionVerifier(introspector Introspector, ttl time.Duration) *IntrospectionVerifier {
+	if ttl <= 0 {
+		ttl = defaultIntrospectionTTL
+	}
+	return &IntrospectionVerifier{
+		introspector: introspector,
+		ttl:          ttl,
+		now:          time.Now,
+		cache:        make(map[string]introspectionEntry),
+		lastCleanup:  time.Now(),
+	}
+}
+
+func (v *IntrospectionVerifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	key := cacheKey(token)
+	now := v.now()
+
+	v.mu.Lock()
+	entry, ok := v.cache[key]
+	v.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		if entry.claims == nil {
+			return nil, ErrInvalidToken
+		}
+		return entry.claims, nil
+	}
+
+	claims, err := v.introspector.Introspect(ctx, token)
+	if err != nil {
+		if !errors.Is(err, ErrInvalidToken) {
+			// Error jaringan tidak di-cache
+			return nil, err
+		}
+		v.store(key, introspectionEntry{expiresAt: now.Add(negativeIntrospectionTTL)})
+		return nil, ErrInvalidToken
+	}
+
+	expiresAt := now.Add(v.ttl)
+	if !claims.ExpiresAt.IsZero() && claims.ExpiresAt.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt
+	}
+	v.store(key, introspectionEntry{claims: claims, expiresAt: expiresAt})
+	return claims, nil
+}
+
+func (v *IntrospectionVerifier) store(key string, entry introspectionEntry) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// Entry kedaluwarsa dibersihkan paling sering sekali per cachePruneInterval,
+	// bukan di setiap cache miss
+	now := v.now()
+	if now.Sub(v.lastCleanup) > cachePruneInterval {
+		for k, e := range v.cache {
+			if now.After(e.expiresAt) {
+				delete(v.cache, k)
+			}
+		}
+		v.lastCleanup = now
+	}
+	v.cache[key] = entry
+}
+
+// Token tidak disimpan mentah di memori
+func cacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
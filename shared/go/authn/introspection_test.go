@@ -0,0 +1,56 @@
+package authn_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"shared/go/authn"
+	"shared/go/authn/authntest"
+)
+
+func TestIntrospectionVerifier_CachesActiveToken(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	token := issuer.Issue(authn.Claims{UserID: "user-123", Role: authn.ClientRole})
+	v := authn.NewIntrospectionVerifier(issuer, 0)
+
+	for i := 0; i < 3; i++ {
+		claims, err := v.Verify(context.Background(), token)
+		assert.NoError(t, err)
+		assert.Equal(t, "user-123", claims.UserID)
+	}
+	assert.Equal(t, 1, issuer.IntrospectCalls())
+}
+
+func TestIntrospectionVerifier_RevokedToken(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	claims := authn.Claims{UserID: "user-123", TokenID: "jti-1"}
+	token := issuer.Issue(claims)
+	issuer.Revoke("jti-1")
+	v := authn.NewIntrospectionVerifier(issuer, 0)
+
+	_, err := v.Verify(context.Background(), token)
+	assert.Equal(t, authn.ErrInvalidToken, err)
+
+	// Hasil negatif juga di-cache
+	_, err = v.Verify(context.Background(), token)
+	assert.Equal(t, authn.ErrInvalidToken, err)
+	assert.Equal(t, 1, issuer.IntrospectCalls())
+}
+
+func TestIntrospectionVerifier_ExpiredToken(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	token := issuer.Issue(authn.Claims{UserID: "user-123", ExpiresAt: time.Now().Add(-time.Minute)})
+	v := authn.NewIntrospectionVerifier(issuer, 0)
+
+	_, err := v.Verify(context.Background(), token)
+	assert.Equal(t, authn.ErrInvalidToken, err)
+}
@@ -0,0 +1,37 @@
+package authn
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// SubscribeRevocations mengisi denylist dari channel Redis pub/sub sampai ctx dibatalkan.
+// go-redis menyambung ulang otomatis, namun event selama terputus hilang: batas atasnya
+// tetap umur access token. Payload yang tidak valid diabaikan.
+func SubscribeRevocations(ctx context.Context, client *redis.Client, channel string, d *Denylist) error {
+	if channel == "" {
+		channel = DefaultRevocationChannel
+	}
+
+	pubsub := client.Subscribe(ctx, channel)
+	defer pubsub.Close()
+
+	// Pastikan subscribe berhasil sebelum mulai mendengarkan
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return err
+	}
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			_ = d.ApplyMessage([]byte(msg.Payload))
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// DefaultRevocationChannel : channel Redis tempat auth-service menyiarkan event revocation
+const DefaultRevocationChannel = "auth:revocations"
+
+// Umur access token terpanjang yang diterbitkan auth-service
+const defaultMaxTokenLifetime = 15 * time.Minute
+
+// Tipe event sama dengan entities.RevocationEventType di auth-service
+const (
+	RevocationUserLogout      = "user.logout"
+	RevocationSessionsRevoked = "session.revoked"
+	RevocationUserSuspended   = "user.suspended"
+	RevocationRoleChanged     = "role.changed"
+)
+
+// RevocationEvent mengikuti format JSON entities.RevocationEvent di auth-service.
+// TokenID diisi untuk pencabutan satu token, IssuedBefore untuk semua token user.
+type RevocationEvent struct {
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	UserID       string     `json:"user_id"`
+	TokenID      string     `json:"token_id,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	IssuedBefore *time.Time `json:"issued_before,omitempty"`
+	OccurredAt   time.Time  `json:"occurred_at"`
+}
+
+type userWatermark struct {
+	issuedBefore time.Time
+	keepUntil    time.Time
+}
+
+// Denylist menyimpan token dan watermark user yang dicabut di memori.
+// Entry dibuang setelah token yang terdampak pasti sudah expired.
+type Denylist struct {
+	maxTokenLifetime time.Duration
+	now              func() time.Time
+
+	mu     sync.RWMutex
+	tokens map[string]time.Time
+	users  map[string]userWatermark
+}
+
+// NewDenylist : maxTokenLifetime menentukan berapa lama watermark user disimpan (default 15 menit)
+func NewDenylist(maxTokenLifetime time.Duration) *Denylist {
+	if maxTokenLifetime <= 0 {
+		maxTokenLifetime = defaultMaxTokenLifetime
+	}
+	return &Denylist{
+		maxTokenLifetime: maxTokenLifetime,
+		now:              time.Now,
+		tokens:           make(map[string]time.Time),
+		users:            make(map[string]userWatermark),
+	}
+}
+
+func (d *Denylist) Apply(event *RevocationEvent) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.prune()
+	if event.TokenID != "" {
+		expiresAt := d.now().Add(d.maxTokenLifetime)
+		if event.ExpiresAt != nil {
+			expiresAt = *event.ExpiresAt
+		}
+		d.tokens[event.TokenID] = expiresAt
+	}
+	if event.UserID != "" && event.IssuedBefore != nil {
+		// Watermark hanya boleh maju, event yang datang terlambat tidak menurunkannya
+		if current, ok := d.users[event.UserID]; ok && !event.IssuedBefore.After(current.issuedBefore) {
+			return
+		}
+		d.users[event.UserID] = userWatermark{
+			issuedBefore: *event.IssuedBefore,
+			keepUntil:    event.IssuedBefore.Add(d.maxTokenLifetime),
+		}
+	}
+}
+
+// ApplyMessage mem-parse payload JSON dari channel revocation
+func (d *Denylist) ApplyMessage(payload []byte) error {
+	var event RevocationEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return err
+	}
+	d.Apply(&event)
+	return nil
+}
+
+// IsRevoked true jika token dicabut langsung atau terbit sebelum watermark user.
+// Token tanpa iat (IssuedAt zero) tidak bisa dibandingkan dengan watermark, jadi hanya
+// pencabutan per token yang berlaku.
+func (d *Denylist) IsRevoked(claims *Claims) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if _, ok := d.tokens[claims.TokenID]; ok && claims.TokenID != "" {
+		return true
+	}
+	if mark, ok := d.users[claims.UserID]; ok && !claims.IssuedAt.IsZero() {
+		return claims.IssuedAt.Before(mark.issuedBefore)
+	}
+	return false
+}
+
+// prune dipanggil dengan lock tertulis
+func (d *Denylist) prune() {
+	now := d.now()
+	for jti, expiresAt := range d.tokens {
+		if now.After(expiresAt) {
+			delete(d.tokens, jti)
+		}
+	}
+	for userID, mark := range d.users {
+		if now.After(mark.keepUntil) {
+			delete(d.users, userID)
+		}
+	}
+}
+
+// WithDenylist menolak token yang sudah dicabut walaupun signature-nya masih valid
+func WithDenylist(next Verifier, d *Denylist) Verifier {
+	return VerifierFunc(func(ctx context.Context, token string) (*Claims, error) {
+		claims, err := next.Verify(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if d.IsRevoked(claims) {
+			return nil, ErrInvalidToken
+		}
+		return claims, nil
+	})
+}
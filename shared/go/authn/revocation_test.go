@@ -0,0 +1,65 @@
+package authn_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"shared/go/authn"
+	"shared/go/authn/authntest"
+)
+
+func TestDenylist_RevokedTokenID(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	denylist := authn.NewDenylist(0)
+	v := authn.WithDenylist(issuer.Verifier(), denylist)
+
+	revoked := issuer.Issue(authn.Claims{UserID: "user-123", TokenID: "jti-1"})
+	other := issuer.Issue(authn.Claims{UserID: "user-123", TokenID: "jti-2"})
+	expiresAt := time.Now().Add(15 * time.Minute)
+	denylist.Apply(&authn.RevocationEvent{Type: authn.RevocationUserLogout, UserID: "user-123", TokenID: "jti-1", ExpiresAt: &expiresAt})
+
+	_, err := v.Verify(context.Background(), revoked)
+	assert.Equal(t, authn.ErrInvalidToken, err)
+
+	claims, err := v.Verify(context.Background(), other)
+	assert.NoError(t, err)
+	assert.Equal(t, "jti-2", claims.TokenID)
+}
+
+func TestDenylist_UserWatermarkFromMessage(t *testing.T) {
+	issuer := authntest.NewIssuer()
+	defer issuer.Close()
+
+	denylist := authn.NewDenylist(0)
+	v := authn.WithDenylist(issuer.Verifier(), denylist)
+
+	now := time.Now().Truncate(time.Second)
+	before := issuer.Issue(authn.Claims{UserID: "user-123", IssuedAt: now.Add(-time.Minute)})
+	after := issuer.Issue(authn.Claims{UserID: "user-123", IssuedAt: now.Add(time.Minute)})
+	otherUser := issuer.Issue(authn.Claims{UserID: "user-456", IssuedAt: now.Add(-time.Minute)})
+
+	payload := `{"id":"evt-1","type":"user.suspended","user_id":"user-123","issued_before":"` + now.UTC().Format(time.RFC3339) + `"}`
+	assert.NoError(t, denylist.ApplyMessage([]byte(payload)))
+
+	// Event lama yang datang terlambat tidak menurunkan watermark
+	stale := now.Add(-time.Hour)
+	denylist.Apply(&authn.RevocationEvent{Type: authn.RevocationRoleChanged, UserID: "user-123", IssuedBefore: &stale})
+
+	_, err := v.Verify(context.Background(), before)
+	assert.Equal(t, authn.ErrInvalidToken, err)
+
+	_, err = v.Verify(context.Background(), after)
+	assert.NoError(t, err)
+
+	_, err = v.Verify(context.Background(), otherUser)
+	assert.NoError(t, err)
+}
+
+func TestDenylist_ApplyMessage_InvalidPayload(t *testing.T) {
+	assert.Error(t, authn.NewDenylist(0).ApplyMessage([]byte("not-json")))
+}
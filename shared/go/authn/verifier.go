@@ -0,0 +1,20 @@
+package authn
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrInvalidToken = errors.New("invalid access token")
+
+// Verifier memvalidasi access token dan mengembalikan claims-nya
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+// VerifierFunc mengubah fungsi biasa menjadi Verifier
+type VerifierFunc func(ctx context.Context, token string) (*Claims, error)
+
+func (f VerifierFunc) Verify(ctx context.Context, token string) (*Claims, error) {
+	return f(ctx, token)
+}